@@ -0,0 +1,19 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/internal/cli"
+)
+
+func main() {
+	root := cli.NewRootCommand(&http.Client{Timeout: 10 * time.Second})
+
+	if err := root.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err.Error())
+		os.Exit(1)
+	}
+}
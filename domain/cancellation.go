@@ -0,0 +1,182 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+type CancellationReason string
+
+const CancellationReasonCustomerRequest CancellationReason = "customer_request"
+const CancellationReasonOutOfStock CancellationReason = "out_of_stock"
+const CancellationReasonKitchenError CancellationReason = "kitchen_error"
+const CancellationReasonDuplicate CancellationReason = "duplicate"
+const CancellationReasonPaymentFailed CancellationReason = "payment_failed"
+
+// Needs a note explaining it
+const CancellationReasonOther CancellationReason = "other"
+
+var CancellationReasons = []CancellationReason{
+	CancellationReasonCustomerRequest,
+	CancellationReasonOutOfStock,
+	CancellationReasonKitchenError,
+	CancellationReasonDuplicate,
+	CancellationReasonPaymentFailed,
+	CancellationReasonOther,
+}
+
+func (r CancellationReason) IsValid() bool {
+	for _, reason := range CancellationReasons {
+		if r == reason {
+			return true
+		}
+	}
+
+	return false
+}
+
+type RefundType string
+
+const RefundFull RefundType = "full"
+
+// Everything but the wasted dishes is refunded
+const RefundPartial RefundType = "partial"
+const RefundNone RefundType = "none"
+
+type CancelOrder struct {
+	Reason CancellationReason `json:"reason" binding:"required"`
+	Note   string             `json:"note,omitempty" binding:"max=500"`
+}
+
+func (c CancelOrder) IsValid() bool {
+	return c.Reason.IsValid() && (c.Reason != CancellationReasonOther || strings.TrimSpace(c.Note) != "")
+}
+
+// Refund tells the payment side what to give back for a cancelled order
+type Refund struct {
+	Type             RefundType `json:"type"`
+	DeliveryFeeCents uint       `json:"delivery_fee_cents"`
+}
+
+type Cancellation struct {
+	CancelOrder
+	CancelledBy    StaffRole   `json:"cancelled_by"`
+	PreviousStatus OrderStatus `json:"previous_status"`
+	CancelledAt    time.Time   `json:"cancelled_at"`
+	// Dishes thrown away because the kitchen had already started on them
+	Waste  []Dish `json:"waste"`
+	Refund Refund `json:"refund"`
+}
+
+// The kitchen has started on the dishes of the orders in these statuses
+var wasteStatuses = map[OrderStatus]bool{
+	OrderStatusPreparing:       true,
+	OrderStatusReady:           true,
+	OrderStatusAwaitingCourier: true,
+	OrderStatusOutForDelivery:  true,
+}
+
+// Reasons on the restaurant side, so the customer gets everything back
+var restaurantReasons = map[CancellationReason]bool{
+	CancellationReasonOutOfStock:   true,
+	CancellationReasonKitchenError: true,
+	CancellationReasonDuplicate:    true,
+}
+
+// NewCancellation works out the waste and the refund of cancelling the order in its current status
+func NewCancellation(order Order, request CancelOrder, role StaffRole, at time.Time) Cancellation {
+	cancellation := Cancellation{
+		CancelOrder:    request,
+		CancelledBy:    role,
+		PreviousStatus: order.Status,
+		CancelledAt:    at,
+		Waste:          []Dish{},
+	}
+
+	if wasteStatuses[order.Status] {
+		cancellation.Waste = append(cancellation.Waste, ActiveDishes(order.Dishes)...)
+	}
+
+	var deliveryFee uint
+	if order.Delivery != nil {
+		deliveryFee = order.Delivery.FeeCents
+	}
+
+	switch {
+	case request.Reason == CancellationReasonPaymentFailed:
+		cancellation.Refund = Refund{Type: RefundNone}
+	case restaurantReasons[request.Reason] || len(cancellation.Waste) == 0:
+		cancellation.Refund = Refund{Type: RefundFull, DeliveryFeeCents: deliveryFee}
+	case order.Status == OrderStatusOutForDelivery:
+		// The courier already made the trip
+		cancellation.Refund = Refund{Type: RefundPartial}
+	default:
+		cancellation.Refund = Refund{Type: RefundPartial, DeliveryFeeCents: deliveryFee}
+	}
+
+	return cancellation
+}
+
+// CancellationPolicy sets the lowest staff role allowed to cancel the orders in each status.
+// Orders in the statuses it leaves out can't be cancelled
+type CancellationPolicy map[OrderStatus]StaffRole
+
+func DefaultCancellationPolicy() CancellationPolicy {
+	return CancellationPolicy{
+		OrderStatusScheduled:       StaffRoleCook,
+		OrderStatusPending:         StaffRoleCook,
+		OrderStatusPreparing:       StaffRoleManager,
+		OrderStatusReady:           StaffRoleManager,
+		OrderStatusAwaitingCourier: StaffRoleManager,
+		OrderStatusOutForDelivery:  StaffRoleManager,
+	}
+}
+
+func (p CancellationPolicy) Allows(role StaffRole, status OrderStatus) bool {
+	required, ok := p[status]
+	return ok && role.Includes(required)
+}
+
+// Override returns a copy of the policy with the roles of a comma separated list of
+// status=role entries, e.g. "preparing=cook,ready=manager"
+func (p CancellationPolicy) Override(roles string) (CancellationPolicy, error) {
+	result := make(CancellationPolicy, len(p))
+
+	for status, role := range p {
+		result[status] = role
+	}
+
+	for _, entry := range strings.Split(roles, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		status, role, ok := strings.Cut(entry, "=")
+		if !ok {
+			return result, fmt.Errorf("invalid cancellation role %q", entry)
+		}
+
+		if !OrderStatus(status).IsValid() || OrderStatus(status).IsFinal() {
+			return result, fmt.Errorf("invalid cancellation role %q: unknown or final status", entry)
+		}
+
+		if !StaffRole(role).IsValid() {
+			return result, fmt.Errorf("invalid cancellation role %q: unknown role", entry)
+		}
+
+		result[OrderStatus(status)] = StaffRole(role)
+	}
+
+	return result, nil
+}
+
+// WasteRecord is a dish thrown away by a cancellation
+type WasteRecord struct {
+	ID         uint               `json:"id"`
+	OrderID    uint               `json:"order_id"`
+	Dish       string             `json:"dish"`
+	Reason     CancellationReason `json:"reason"`
+	RecordedAt time.Time          `json:"recorded_at"`
+}
@@ -0,0 +1,105 @@
+package domain_test
+
+import (
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cancellation", func() {
+	cancelledAt := time.Date(2025, 2, 10, 12, 0, 0, 0, time.UTC)
+
+	deliveryOrder := func(status domain.OrderStatus) domain.Order {
+		return domain.Order{
+			ID:     1,
+			Status: status,
+			NewOrder: domain.NewOrder{
+				Source:   domain.OrderSourceDelivery,
+				Dishes:   []domain.Dish{{Name: "Pizza"}, {Name: "Salad"}},
+				Delivery: &domain.DeliveryDetails{FeeCents: 500},
+			},
+		}
+	}
+
+	Describe("NewCancellation", func() {
+		DescribeTable("working out the waste and refund",
+			func(status domain.OrderStatus, reason domain.CancellationReason, wasted int, refund domain.Refund) {
+				request := domain.CancelOrder{Reason: reason}
+				cancellation := domain.NewCancellation(deliveryOrder(status), request, domain.StaffRoleManager, cancelledAt)
+
+				Expect(cancellation.Waste).To(HaveLen(wasted))
+				Expect(cancellation.Refund).To(Equal(refund))
+				Expect(cancellation.PreviousStatus).To(Equal(status))
+				Expect(cancellation.CancelledAt).To(Equal(cancelledAt))
+			},
+			Entry("refunds everything before preparation", domain.OrderStatusPending, domain.CancellationReasonCustomerRequest, 0,
+				domain.Refund{Type: domain.RefundFull, DeliveryFeeCents: 500}),
+			Entry("withholds the wasted dishes of customer cancellations", domain.OrderStatusPreparing, domain.CancellationReasonCustomerRequest, 2,
+				domain.Refund{Type: domain.RefundPartial, DeliveryFeeCents: 500}),
+			Entry("keeps the fee once the courier left", domain.OrderStatusOutForDelivery, domain.CancellationReasonOther, 2,
+				domain.Refund{Type: domain.RefundPartial}),
+			Entry("refunds everything for restaurant reasons", domain.OrderStatusReady, domain.CancellationReasonKitchenError, 2,
+				domain.Refund{Type: domain.RefundFull, DeliveryFeeCents: 500}),
+			Entry("refunds nothing when the payment failed", domain.OrderStatusPending, domain.CancellationReasonPaymentFailed, 0,
+				domain.Refund{Type: domain.RefundNone}),
+		)
+	})
+
+	Describe("CancelOrder", func() {
+		It("should need a note for other reasons", func() {
+			Expect(domain.CancelOrder{Reason: domain.CancellationReasonDuplicate}.IsValid()).To(BeTrue())
+			Expect(domain.CancelOrder{Reason: domain.CancellationReasonOther}.IsValid()).To(BeFalse())
+			Expect(domain.CancelOrder{Reason: domain.CancellationReasonOther, Note: "Left"}.IsValid()).To(BeTrue())
+			Expect(domain.CancelOrder{Reason: "bored"}.IsValid()).To(BeFalse())
+		})
+	})
+
+	Describe("CancellationPolicy", func() {
+		policy := domain.DefaultCancellationPolicy()
+
+		DescribeTable("allowing roles", func(role domain.StaffRole, status domain.OrderStatus, allowed bool) {
+			Expect(policy.Allows(role, status)).To(Equal(allowed))
+		},
+			Entry("cooks cancel pending orders", domain.StaffRoleCook, domain.OrderStatusPending, true),
+			Entry("cooks can't cancel orders in preparation", domain.StaffRoleCook, domain.OrderStatusPreparing, false),
+			Entry("managers cancel ready orders", domain.StaffRoleManager, domain.OrderStatusReady, true),
+			Entry("managers cancel pending orders", domain.StaffRoleManager, domain.OrderStatusPending, true),
+			Entry("nobody cancels completed orders", domain.StaffRoleManager, domain.OrderStatusDone, false),
+			Entry("unknown roles cancel nothing", domain.StaffRole(""), domain.OrderStatusPending, false),
+		)
+
+		It("should override the roles", func() {
+			overridden, err := policy.Override("preparing=cook, pending=manager")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(overridden.Allows(domain.StaffRoleCook, domain.OrderStatusPreparing)).To(BeTrue())
+			Expect(overridden.Allows(domain.StaffRoleCook, domain.OrderStatusPending)).To(BeFalse())
+			Expect(policy.Allows(domain.StaffRoleCook, domain.OrderStatusPending)).To(BeTrue())
+		})
+
+		DescribeTable("rejecting invalid overrides", func(roles string) {
+			_, err := policy.Override(roles)
+			Expect(err).To(HaveOccurred())
+		},
+			Entry("without a role", "pending"),
+			Entry("with an unknown role", "pending=owner"),
+			Entry("with a final status", "done=manager"),
+		)
+	})
+
+	Describe("ParseStaffKeys", func() {
+		It("should map the keys to their roles", func() {
+			keys, err := domain.ParseStaffKeys("k1=cook, k2=manager")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(keys).To(Equal(domain.StaffKeys{"k1": domain.StaffRoleCook, "k2": domain.StaffRoleManager}))
+		})
+
+		It("should reject unknown roles", func() {
+			_, err := domain.ParseStaffKeys("k1=owner")
+			Expect(err).To(MatchError(ContainSubstring("unknown role")))
+		})
+	})
+})
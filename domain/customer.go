@@ -0,0 +1,37 @@
+package domain
+
+import "strings"
+
+type LoyaltyTier string
+
+const LoyaltyTierRegular LoyaltyTier = "regular"
+const LoyaltyTierSilver LoyaltyTier = "silver"
+const LoyaltyTierGold LoyaltyTier = "gold"
+const LoyaltyTierVIP LoyaltyTier = "vip"
+
+type NewCustomer struct {
+	Name        string      `json:"name" binding:"required"`
+	Phone       string      `json:"phone" binding:"required"`
+	Email       string      `json:"email" binding:"omitempty,email"`
+	Notes       string      `json:"notes"`
+	LoyaltyTier LoyaltyTier `json:"loyalty_tier" binding:"omitempty,oneof=regular silver gold vip"`
+}
+
+type Customer struct {
+	ID uint `json:"id"`
+	NewCustomer
+}
+
+// NormalizePhone keeps only the digits of a phone number, plus the leading + if any,
+// so the same number typed in different formats matches the same customer
+func NormalizePhone(phone string) string {
+	var builder strings.Builder
+
+	for i, char := range strings.TrimSpace(phone) {
+		if (char >= '0' && char <= '9') || (char == '+' && i == 0) {
+			builder.WriteRune(char)
+		}
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,16 @@
+package domain
+
+import "time"
+
+type DeliveryDetails struct {
+	Address      string `json:"address" binding:"required"`
+	ContactName  string `json:"contact_name" binding:"required"`
+	ContactPhone string `json:"contact_phone" binding:"required"`
+	FeeCents     uint   `json:"fee_cents"`
+}
+
+type Courier struct {
+	Name       string     `json:"name" binding:"required"`
+	Phone      string     `json:"phone"`
+	AssignedAt *time.Time `json:"assigned_at"`
+}
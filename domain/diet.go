@@ -0,0 +1,183 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Allergen is one of the allergens restaurants have to declare
+type Allergen string
+
+const AllergenCelery Allergen = "celery"
+const AllergenCrustaceans Allergen = "crustaceans"
+const AllergenEggs Allergen = "eggs"
+const AllergenFish Allergen = "fish"
+const AllergenGluten Allergen = "gluten"
+const AllergenLupin Allergen = "lupin"
+const AllergenMilk Allergen = "milk"
+const AllergenMolluscs Allergen = "molluscs"
+const AllergenMustard Allergen = "mustard"
+const AllergenPeanuts Allergen = "peanuts"
+const AllergenSesame Allergen = "sesame"
+const AllergenSoy Allergen = "soy"
+const AllergenSulphites Allergen = "sulphites"
+const AllergenTreeNuts Allergen = "tree_nuts"
+
+var Allergens = []Allergen{
+	AllergenCelery,
+	AllergenCrustaceans,
+	AllergenEggs,
+	AllergenFish,
+	AllergenGluten,
+	AllergenLupin,
+	AllergenMilk,
+	AllergenMolluscs,
+	AllergenMustard,
+	AllergenPeanuts,
+	AllergenSesame,
+	AllergenSoy,
+	AllergenSulphites,
+	AllergenTreeNuts,
+}
+
+func (a Allergen) IsValid() bool {
+	return slices.Contains(Allergens, a)
+}
+
+type DietaryFlag string
+
+const DietaryVegetarian DietaryFlag = "vegetarian"
+const DietaryVegan DietaryFlag = "vegan"
+const DietaryGlutenFree DietaryFlag = "gluten_free"
+const DietaryDairyFree DietaryFlag = "dairy_free"
+const DietaryHalal DietaryFlag = "halal"
+const DietaryKosher DietaryFlag = "kosher"
+
+var DietaryFlags = []DietaryFlag{
+	DietaryVegetarian,
+	DietaryVegan,
+	DietaryGlutenFree,
+	DietaryDairyFree,
+	DietaryHalal,
+	DietaryKosher,
+}
+
+func (d DietaryFlag) IsValid() bool {
+	return slices.Contains(DietaryFlags, d)
+}
+
+type Allergy struct {
+	Allergen Allergen `json:"allergen" binding:"required"`
+	// Orders with dishes known to contain the allergen of a severe allergy are rejected, others only warned about
+	Severe bool `json:"severe"`
+}
+
+// MenuItem is the allergen data of a dish of the menu catalog
+type MenuItem struct {
+	Name      string     `json:"name"`
+	Allergens []Allergen `json:"allergens"`
+	// Diets the dish is suitable for
+	Dietary []DietaryFlag `json:"dietary"`
+}
+
+// DietaryWarning is a conflict between a dish and the allergies or diet of its order that didn't
+// reject the order. Dishes missing from the menu catalog are warned about without an allergen or diet
+type DietaryWarning struct {
+	Dish     string      `json:"dish"`
+	Allergen Allergen    `json:"allergen,omitempty"`
+	Dietary  DietaryFlag `json:"dietary,omitempty"`
+	Message  string      `json:"message"`
+}
+
+func (o NewOrder) HasDietaryNeeds() bool {
+	return len(o.Allergies) > 0 || len(o.Dietary) > 0
+}
+
+// ValidateDiet checks the allergies and dietary flags are known ones
+func (o NewOrder) ValidateDiet() error {
+	for _, allergy := range o.Allergies {
+		if !allergy.Allergen.IsValid() {
+			return fmt.Errorf("%w: unknown allergen %q", ErrInvalidOrderDiet, allergy.Allergen)
+		}
+	}
+
+	for _, flag := range o.Dietary {
+		if !flag.IsValid() {
+			return fmt.Errorf("%w: unknown dietary flag %q", ErrInvalidOrderDiet, flag)
+		}
+	}
+
+	return nil
+}
+
+// CheckDish cross checks a dish against the allergies and diet of the order, given its menu catalog
+// item, which is nil for dishes missing from the catalog. Severe allergies return ErrAllergenConflict
+func (o NewOrder) CheckDish(dish string, item *MenuItem) ([]DietaryWarning, error) {
+	if !o.HasDietaryNeeds() {
+		return nil, nil
+	}
+
+	if item == nil {
+		return []DietaryWarning{{Dish: dish, Message: "dish is not in the menu catalog, its allergens are unknown"}}, nil
+	}
+
+	var warnings []DietaryWarning
+
+	for _, allergy := range o.Allergies {
+		if !slices.Contains(item.Allergens, allergy.Allergen) {
+			continue
+		}
+
+		if allergy.Severe {
+			return nil, fmt.Errorf("%w: %s contains %s", ErrAllergenConflict, dish, allergy.Allergen)
+		}
+
+		warnings = append(warnings, DietaryWarning{
+			Dish:     dish,
+			Allergen: allergy.Allergen,
+			Message:  fmt.Sprintf("dish contains %s", allergy.Allergen),
+		})
+	}
+
+	for _, flag := range o.Dietary {
+		if slices.Contains(item.Dietary, flag) {
+			continue
+		}
+
+		warnings = append(warnings, DietaryWarning{
+			Dish:    dish,
+			Dietary: flag,
+			Message: fmt.Sprintf("dish is not %s", strings.ReplaceAll(string(flag), "_", " ")),
+		})
+	}
+
+	return warnings, nil
+}
+
+// KitchenAlerts sums up what the kitchen has to watch out for with the order, severe allergies first
+func (o *Order) KitchenAlerts() []string {
+	var alerts []string
+
+	for _, allergy := range o.Allergies {
+		if allergy.Severe {
+			alerts = append(alerts, "severe allergy: "+string(allergy.Allergen))
+		}
+	}
+
+	for _, allergy := range o.Allergies {
+		if !allergy.Severe {
+			alerts = append(alerts, "allergy: "+string(allergy.Allergen))
+		}
+	}
+
+	for _, flag := range o.Dietary {
+		alerts = append(alerts, "diet: "+string(flag))
+	}
+
+	if o.Notes != "" {
+		alerts = append(alerts, "note: "+o.Notes)
+	}
+
+	return alerts
+}
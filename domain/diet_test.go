@@ -0,0 +1,108 @@
+package domain_test
+
+import (
+	"github.com/danbrato999/yuno-gveloz/domain"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Diet", func() {
+	padThai := &domain.MenuItem{
+		Name:      "Pad Thai",
+		Allergens: []domain.Allergen{domain.AllergenPeanuts, domain.AllergenEggs},
+		Dietary:   []domain.DietaryFlag{domain.DietaryDairyFree},
+	}
+
+	Describe("ValidateDiet", func() {
+		It("accepts known allergens and diets", func() {
+			order := domain.NewOrder{
+				Allergies: []domain.Allergy{{Allergen: domain.AllergenMilk}},
+				Dietary:   []domain.DietaryFlag{domain.DietaryVegan},
+			}
+
+			Expect(order.ValidateDiet()).To(Succeed())
+		})
+
+		It("rejects unknown allergens", func() {
+			order := domain.NewOrder{Allergies: []domain.Allergy{{Allergen: "chocolate"}}}
+
+			Expect(order.ValidateDiet()).To(MatchError(domain.ErrInvalidOrderDiet))
+		})
+
+		It("rejects unknown diets", func() {
+			order := domain.NewOrder{Dietary: []domain.DietaryFlag{"keto"}}
+
+			Expect(order.ValidateDiet()).To(MatchError(domain.ErrInvalidOrderDiet))
+		})
+	})
+
+	Describe("CheckDish", func() {
+		It("skips orders without dietary needs", func() {
+			warnings, err := domain.NewOrder{}.CheckDish("Pad Thai", nil)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("rejects dishes with the allergen of a severe allergy", func() {
+			order := domain.NewOrder{Allergies: []domain.Allergy{{Allergen: domain.AllergenPeanuts, Severe: true}}}
+
+			_, err := order.CheckDish("Pad Thai", padThai)
+
+			Expect(err).To(MatchError(domain.ErrAllergenConflict))
+			Expect(err.Error()).To(ContainSubstring("Pad Thai contains peanuts"))
+		})
+
+		It("warns about other allergies and unsuitable diets", func() {
+			order := domain.NewOrder{
+				Allergies: []domain.Allergy{{Allergen: domain.AllergenEggs}, {Allergen: domain.AllergenMilk}},
+				Dietary:   []domain.DietaryFlag{domain.DietaryDairyFree, domain.DietaryGlutenFree},
+			}
+
+			warnings, err := order.CheckDish("Pad Thai", padThai)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(Equal([]domain.DietaryWarning{
+				{Dish: "Pad Thai", Allergen: domain.AllergenEggs, Message: "dish contains eggs"},
+				{Dish: "Pad Thai", Dietary: domain.DietaryGlutenFree, Message: "dish is not gluten free"},
+			}))
+		})
+
+		It("warns about dishes missing from the catalog", func() {
+			order := domain.NewOrder{Allergies: []domain.Allergy{{Allergen: domain.AllergenPeanuts, Severe: true}}}
+
+			warnings, err := order.CheckDish("Mystery Curry", nil)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(HaveLen(1))
+			Expect(warnings[0].Dish).To(Equal("Mystery Curry"))
+			Expect(warnings[0].Allergen).To(BeEmpty())
+		})
+	})
+
+	Describe("KitchenAlerts", func() {
+		It("lists severe allergies first and the notes last", func() {
+			order := domain.Order{
+				NewOrder: domain.NewOrder{
+					Notes: "No cilantro",
+					Allergies: []domain.Allergy{
+						{Allergen: domain.AllergenMilk},
+						{Allergen: domain.AllergenPeanuts, Severe: true},
+					},
+					Dietary: []domain.DietaryFlag{domain.DietaryVegetarian},
+				},
+			}
+
+			Expect(order.KitchenAlerts()).To(Equal([]string{
+				"severe allergy: peanuts",
+				"allergy: milk",
+				"diet: vegetarian",
+				"note: No cilantro",
+			}))
+		})
+
+		It("is empty for orders without anything to watch out for", func() {
+			Expect((&domain.Order{}).KitchenAlerts()).To(BeEmpty())
+		})
+	})
+})
@@ -1,5 +1,169 @@
 package domain
 
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+type DishStatus string
+
+const DishStatusPending DishStatus = "pending"
+const DishStatusReady DishStatus = "ready"
+
+// Voided dishes stay in their order for its history, but are no longer made
+const DishStatusVoided DishStatus = "voided"
+
 type Dish struct {
-	Name string `json:"name" binding:"required"`
+	// Stable line id, set once the dish is stored
+	ID     uint       `json:"id,omitempty"`
+	Name   string     `json:"name" binding:"required"`
+	Status DishStatus `json:"status,omitempty"`
+}
+
+// NewDishes returns the dishes as new pending lines, dropping the ids and statuses clients may send
+func NewDishes(dishes []Dish) []Dish {
+	result := make([]Dish, len(dishes))
+
+	for i, dish := range dishes {
+		result[i] = Dish{Name: dish.Name, Status: DishStatusPending}
+	}
+
+	return result
+}
+
+// ActiveDishes leaves out the voided dishes
+func ActiveDishes(dishes []Dish) []Dish {
+	result := make([]Dish, 0, len(dishes))
+
+	for _, dish := range dishes {
+		if dish.Status != DishStatusVoided {
+			result = append(result, dish)
+		}
+	}
+
+	return result
+}
+
+// CheckDishLimit fails with ErrTooManyDishes when an order would have more than maxDishes dishes.
+// A zero limit leaves the orders unbounded
+func CheckDishLimit(dishes int, maxDishes int) error {
+	if maxDishes > 0 && dishes > maxDishes {
+		return fmt.Errorf("%w: orders can't have more than %d dishes", ErrTooManyDishes, maxDishes)
+	}
+
+	return nil
+}
+
+type DishAction string
+
+const DishActionAdded DishAction = "added"
+const DishActionVoided DishAction = "voided"
+const DishActionReady DishAction = "ready"
+
+// The order statuses each dish action is allowed in
+var dishActionOrderStatuses = map[DishAction][]OrderStatus{
+	DishActionAdded:  {OrderStatusScheduled, OrderStatusPending, OrderStatusPreparing},
+	DishActionVoided: {OrderStatusScheduled, OrderStatusPending, OrderStatusPreparing},
+	DishActionReady:  {OrderStatusPreparing},
+}
+
+// The dish statuses each action applies to, added dishes are new lines
+var dishActionDishStatuses = map[DishAction][]DishStatus{
+	DishActionVoided: {DishStatusPending, DishStatusReady},
+	DishActionReady:  {DishStatusPending},
+}
+
+var dishActionResults = map[DishAction]DishStatus{
+	DishActionAdded:  DishStatusPending,
+	DishActionVoided: DishStatusVoided,
+	DishActionReady:  DishStatusReady,
+}
+
+func (a DishAction) IsAllowedFor(status OrderStatus) bool {
+	return slices.Contains(dishActionOrderStatuses[a], status)
+}
+
+func (a DishAction) AppliesTo(status DishStatus) bool {
+	return slices.Contains(dishActionDishStatuses[a], status)
+}
+
+// Result is the status the action leaves the dish in
+func (a DishAction) Result() DishStatus {
+	return dishActionResults[a]
+}
+
+// VoidDish takes the same reasons as a cancellation, but for payment failures, which affect whole orders
+type VoidDish struct {
+	Reason CancellationReason `json:"reason" binding:"required"`
+	Note   string             `json:"note,omitempty" binding:"max=500"`
+}
+
+func (v VoidDish) IsValid() bool {
+	return CancelOrder(v).IsValid() && v.Reason != CancellationReasonPaymentFailed
+}
+
+// DishChange is an entry of the history of the dishes of an order
+type DishChange struct {
+	ID     uint       `json:"id"`
+	DishID uint       `json:"dish_id"`
+	Dish   string     `json:"dish"`
+	Action DishAction `json:"action"`
+	// Status of the dish before the change, empty for added dishes
+	PreviousStatus DishStatus  `json:"previous_status,omitempty"`
+	OrderStatus    OrderStatus `json:"order_status"`
+	// Only set for voided dishes
+	Reason    CancellationReason `json:"reason,omitempty"`
+	Note      string             `json:"note,omitempty"`
+	ChangedAt time.Time          `json:"changed_at"`
+}
+
+// CanReplaceDishes tells whether the whole dish list can still be replaced, which is only before the
+// kitchen starts on it. Later on, dishes are changed one at a time
+func (o *Order) CanReplaceDishes() bool {
+	return o.Status == OrderStatusScheduled || o.Status == OrderStatusPending
+}
+
+// ChangeDish checks the action is allowed for the order and the dish, returning the change to apply.
+// New dishes are added when dishID is zero
+func (o *Order) ChangeDish(dishID uint, name string, action DishAction, at time.Time) (DishChange, error) {
+	change := DishChange{
+		DishID:      dishID,
+		Dish:        strings.TrimSpace(name),
+		Action:      action,
+		OrderStatus: o.Status,
+		ChangedAt:   at,
+	}
+
+	if !action.IsAllowedFor(o.Status) {
+		return change, ErrDishChangeNotAllowed
+	}
+
+	if action == DishActionAdded {
+		if change.Dish == "" {
+			return change, ErrInvalidOrderUpdate
+		}
+
+		return change, nil
+	}
+
+	index := slices.IndexFunc(o.Dishes, func(dish Dish) bool { return dish.ID == dishID })
+	if index < 0 {
+		return change, ErrDishNotFound
+	}
+
+	dish := o.Dishes[index]
+	change.Dish = dish.Name
+	change.PreviousStatus = dish.Status
+
+	if !action.AppliesTo(dish.Status) {
+		return change, ErrDishChangeNotAllowed
+	}
+
+	if action == DishActionVoided && len(ActiveDishes(o.Dishes)) == 1 {
+		return change, ErrLastDishVoided
+	}
+
+	return change, nil
 }
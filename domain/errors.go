@@ -6,3 +6,33 @@ var ErrOrderNotFound = fmt.Errorf("Order not found")
 var ErrInvalidOrderUpdate = fmt.Errorf("Order updated is incorrect")
 var ErrCompleteOrderUpdate = fmt.Errorf("Completed order cannot be updated")
 var ErrIncorrectOrderQueueing = fmt.Errorf("Order queue operation is not valid")
+var ErrOrderNotQueued = fmt.Errorf("Order is not queued")
+var ErrCustomerNotFound = fmt.Errorf("Customer not found")
+var ErrDuplicateCustomer = fmt.Errorf("Customer with the same phone already exists")
+var ErrUnknownOrderCustomer = fmt.Errorf("Order customer does not exist")
+var ErrNotDeliveryOrder = fmt.Errorf("Order is not a delivery order")
+var ErrWebhookNotFound = fmt.Errorf("Webhook not found")
+var ErrInvalidWebhook = fmt.Errorf("Webhook is not valid")
+var ErrUnknownIntegration = fmt.Errorf("Integration provider is not supported")
+var ErrInvalidIntegrationSignature = fmt.Errorf("Integration payload signature is not valid")
+var ErrInvalidIntegrationPayload = fmt.Errorf("Integration payload is not valid")
+var ErrDuplicateExternalOrder = fmt.Errorf("Order was already received from the provider")
+var ErrInvalidImportedOrder = fmt.Errorf("Imported order is not valid")
+var ErrInvalidCancellation = fmt.Errorf("Cancellation needs a known reason, and a note for other reasons")
+var ErrCancellationNotAllowed = fmt.Errorf("Staff role is not allowed to cancel the order in its status")
+var ErrCancellationWithoutReason = fmt.Errorf("Orders can only be cancelled through the cancel operation")
+var ErrDishNotFound = fmt.Errorf("Dish not found in the order")
+var ErrDishChangeNotAllowed = fmt.Errorf("Dish change is not allowed in the order or dish status")
+var ErrLastDishVoided = fmt.Errorf("The last dish of an order can't be voided, the order has to be cancelled")
+var ErrTooManyDishes = fmt.Errorf("Order has more dishes than allowed")
+var ErrInvalidDishVoid = fmt.Errorf("Voided dishes need a known reason, and a note for other reasons")
+var ErrInvalidOrderDiet = fmt.Errorf("Order allergies and dietary flags must be known ones")
+var ErrAllergenConflict = fmt.Errorf("Order dish contains an allergen the order is severely allergic to")
+var ErrTableNotFound = fmt.Errorf("Table not found")
+var ErrDuplicateTable = fmt.Errorf("Table with the same name already exists")
+var ErrTableOccupied = fmt.Errorf("Table already has an open session")
+var ErrTableNotSeated = fmt.Errorf("Table has no open session")
+var ErrTableSessionNotFound = fmt.Errorf("Table session not found")
+var ErrTableSessionInProgress = fmt.Errorf("Table session can't be closed while its orders are in progress")
+var ErrUnknownOrderTable = fmt.Errorf("Order table does not exist")
+var ErrNotDineInOrder = fmt.Errorf("Only in person orders can be linked to a table")
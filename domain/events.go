@@ -0,0 +1,35 @@
+package domain
+
+import "time"
+
+type OrderEventType string
+
+const OrderEventCreated OrderEventType = "order.created"
+const OrderEventUpdated OrderEventType = "order.updated"
+const OrderEventStatusChanged OrderEventType = "order.status_changed"
+const OrderEventCancelled OrderEventType = "order.cancelled"
+const OrderEventLate OrderEventType = "order.late"
+
+var OrderEventTypes = []OrderEventType{
+	OrderEventCreated,
+	OrderEventUpdated,
+	OrderEventStatusChanged,
+	OrderEventCancelled,
+	OrderEventLate,
+}
+
+type OrderEvent struct {
+	Type      OrderEventType `json:"type"`
+	Order     Order          `json:"order"`
+	Timestamp time.Time      `json:"timestamp"`
+}
+
+func (t OrderEventType) IsValid() bool {
+	for _, eventType := range OrderEventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,40 @@
+package fakeclock
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock is a domain.Clock for tests, it only moves when told to
+type Clock struct {
+	mu  sync.RWMutex
+	now time.Time
+}
+
+func New(now time.Time) *Clock {
+	return &Clock{
+		now: now,
+	}
+}
+
+func (c *Clock) Now() time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.now
+}
+
+func (c *Clock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = now
+}
+
+func (c *Clock) Advance(d time.Duration) time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+	return c.now
+}
@@ -0,0 +1,7 @@
+package domain
+
+// ExternalReference identifies an order received from a third-party platform
+type ExternalReference struct {
+	Provider string `json:"provider"`
+	ID       string `json:"id"`
+}
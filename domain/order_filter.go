@@ -1,13 +1,105 @@
 package domain
 
+import "time"
+
 type OrderFilters struct {
 	AnyStatus    []OrderStatus
 	PrioritySort bool
+	CustomerID   *uint
+	// Orders of any of these table sessions
+	TableSessionIDs []uint
+	Source          *OrderSource
+	// Only orders to be released into the kitchen up to this time
+	ReleasedBy  *time.Time
+	ReleaseSort bool
+	Late        *bool
+	// Also look for the orders moved to the archive
+	IncludeArchived bool
+	// Page of the stored orders, by their sorts and then by id. No limit when zero, and the
+	// archived orders are left out of it
+	Limit  int
+	Offset int
+}
+
+var ActiveStatuses = []OrderStatus{
+	OrderStatusPending,
+	OrderStatusPreparing,
+	OrderStatusReady,
+	OrderStatusAwaitingCourier,
+	OrderStatusOutForDelivery,
+}
+
+// Orders in these statuses hold a place in the kitchen queue, delivery orders leave it
+// once they wait for their courier
+var KitchenStatuses = []OrderStatus{
+	OrderStatusPending,
+	OrderStatusPreparing,
+	OrderStatusReady,
+}
+
+// Orders in these statuses can no longer change
+var FinalStatuses = []OrderStatus{
+	OrderStatusDone,
+	OrderStatusCancelled,
+	OrderStatusDelivered,
+	OrderStatusDeliveryFailed,
 }
 
 type OrderFilterFn = func(filter *OrderFilters)
 
 var FilterActive OrderFilterFn = func(filter *OrderFilters) {
-	filter.AnyStatus = []OrderStatus{OrderStatusPending, OrderStatusPreparing, OrderStatusReady}
+	filter.AnyStatus = ActiveStatuses
 	filter.PrioritySort = true
 }
+
+func FilterByCustomer(id uint) OrderFilterFn {
+	return func(filter *OrderFilters) {
+		filter.CustomerID = &id
+	}
+}
+
+func FilterByTableSession(ids ...uint) OrderFilterFn {
+	return func(filter *OrderFilters) {
+		filter.TableSessionIDs = ids
+	}
+}
+
+func FilterByStatus(statuses ...OrderStatus) OrderFilterFn {
+	return func(filter *OrderFilters) {
+		filter.AnyStatus = statuses
+	}
+}
+
+func FilterBySource(source OrderSource) OrderFilterFn {
+	return func(filter *OrderFilters) {
+		filter.Source = &source
+	}
+}
+
+var FilterScheduled OrderFilterFn = func(filter *OrderFilters) {
+	filter.AnyStatus = []OrderStatus{OrderStatusScheduled}
+	filter.ReleaseSort = true
+}
+
+func FilterReleasedBy(deadline time.Time) OrderFilterFn {
+	return func(filter *OrderFilters) {
+		FilterScheduled(filter)
+		filter.ReleasedBy = &deadline
+	}
+}
+
+var FilterLate OrderFilterFn = func(filter *OrderFilters) {
+	late := true
+	filter.Late = &late
+}
+
+var FilterIncludeArchived OrderFilterFn = func(filter *OrderFilters) {
+	filter.IncludeArchived = true
+}
+
+func FilterPage(limit int, offset int) OrderFilterFn {
+	return func(filter *OrderFilters) {
+		filter.Limit = limit
+		filter.Offset = offset
+	}
+}
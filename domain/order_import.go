@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Only the first errors are kept in a report, the rest are just counted as failed
+const MaxImportErrors = 1000
+
+// ImportedOrder is an order brought from another system, usually already completed
+type ImportedOrder struct {
+	NewOrder
+	// Defaults to the final status of a successful order of its source
+	Status OrderStatus `json:"status,omitempty"`
+	// Exported orders keep their creation time and status history, otherwise the order is created
+	// at the import time with just its current status
+	CreatedAt     *time.Time           `json:"created_at,omitempty"`
+	StatusHistory []OrderStatusHistory `json:"status_history,omitempty"`
+}
+
+// ImportRow is a single record of an import file. ParseError is set when the record couldn't be read
+type ImportRow struct {
+	Number     int
+	Order      ImportedOrder
+	ParseError error
+}
+
+type ImportRowError struct {
+	Row   int    `json:"row"`
+	Error string `json:"error"`
+}
+
+type ImportReport struct {
+	DryRun   bool             `json:"dry_run"`
+	Total    int              `json:"total"`
+	Imported int              `json:"imported"`
+	Failed   int              `json:"failed"`
+	Errors   []ImportRowError `json:"errors"`
+}
+
+func (r *ImportReport) AddError(row int, err error) {
+	r.Failed++
+
+	if len(r.Errors) < MaxImportErrors {
+		r.Errors = append(r.Errors, ImportRowError{Row: row, Error: err.Error()})
+	}
+}
+
+// ToOrder validates the imported order the same way new orders are validated, returning the order to
+// save along with its status history
+func (o ImportedOrder) ToOrder() (OrderWithStatusHistory, error) {
+	if err := o.validate(); err != nil {
+		return OrderWithStatusHistory{}, err
+	}
+
+	order := Order{NewOrder: o.NewOrder, Status: o.Status, CreatedAt: o.CreatedAt}
+	order.Dishes = NewDishes(o.Dishes)
+
+	// The history of an order ends with its current status
+	if order.Status == "" && len(o.StatusHistory) > 0 {
+		order.Status = o.StatusHistory[len(o.StatusHistory)-1].Status
+	}
+
+	if order.Status == "" {
+		order.Status = OrderStatusDone
+
+		if order.IsDelivery() {
+			order.Status = OrderStatusDelivered
+		}
+	}
+
+	if !order.IsDelivery() && deliveryStatuses[order.Status] {
+		return OrderWithStatusHistory{}, fmt.Errorf("%w: status %s is only valid for delivery orders", ErrInvalidImportedOrder, order.Status)
+	}
+
+	if order.IsDelivery() && order.Status == OrderStatusDone {
+		return OrderWithStatusHistory{}, fmt.Errorf("%w: delivery orders finish as delivered instead of done", ErrInvalidImportedOrder)
+	}
+
+	if len(o.StatusHistory) > 0 && o.StatusHistory[len(o.StatusHistory)-1].Status != order.Status {
+		return OrderWithStatusHistory{}, fmt.Errorf("%w: status history must end with the status %s", ErrInvalidImportedOrder, order.Status)
+	}
+
+	return OrderWithStatusHistory{Order: order, StatusHistory: o.StatusHistory}, nil
+}
+
+func (o ImportedOrder) validate() error {
+	if o.Time.IsZero() {
+		return fmt.Errorf("%w: time is required", ErrInvalidImportedOrder)
+	}
+
+	if len(o.Dishes) == 0 {
+		return fmt.Errorf("%w: at least one dish is required", ErrInvalidImportedOrder)
+	}
+
+	for _, dish := range o.Dishes {
+		if dish.Name == "" {
+			return fmt.Errorf("%w: dish name is required", ErrInvalidImportedOrder)
+		}
+	}
+
+	if !o.Source.IsValid() {
+		return fmt.Errorf("%w: unknown source %q", ErrInvalidImportedOrder, o.Source)
+	}
+
+	if err := o.ValidateDiet(); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidImportedOrder, err.Error())
+	}
+
+	// Table sessions only exist for the orders served here
+	if o.TableID != nil {
+		return fmt.Errorf("%w: orders can't be imported into a table", ErrInvalidImportedOrder)
+	}
+
+	// Scheduled orders need a release time, which only makes sense for orders created here
+	if o.Status != "" && (!o.Status.IsValid() || o.Status == OrderStatusScheduled) {
+		return fmt.Errorf("%w: unsupported status %q", ErrInvalidImportedOrder, o.Status)
+	}
+
+	var previous time.Time
+
+	for _, change := range o.StatusHistory {
+		if !change.Status.IsValid() || change.Timestamp == nil {
+			return fmt.Errorf("%w: status history entries need a known status and a timestamp", ErrInvalidImportedOrder)
+		}
+
+		if change.Timestamp.Before(previous) {
+			return fmt.Errorf("%w: status history must be sorted by time", ErrInvalidImportedOrder)
+		}
+
+		previous = *change.Timestamp
+	}
+
+	if o.Delivery != nil {
+		if o.Source != OrderSourceDelivery {
+			return fmt.Errorf("%w: %s", ErrInvalidImportedOrder, ErrNotDeliveryOrder.Error())
+		}
+
+		if o.Delivery.Address == "" || o.Delivery.ContactName == "" || o.Delivery.ContactPhone == "" {
+			return fmt.Errorf("%w: delivery address and contact are required", ErrInvalidImportedOrder)
+		}
+	}
+
+	return nil
+}
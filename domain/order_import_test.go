@@ -0,0 +1,82 @@
+package domain_test
+
+import (
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ImportedOrder", func() {
+	validOrder := func(source domain.OrderSource, status domain.OrderStatus) domain.ImportedOrder {
+		return domain.ImportedOrder{
+			NewOrder: domain.NewOrder{
+				Time:   time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+				Dishes: []domain.Dish{{Name: "Pizza"}},
+				Source: source,
+			},
+			Status: status,
+		}
+	}
+
+	DescribeTable("default status", func(source domain.OrderSource, expected domain.OrderStatus) {
+		order, err := validOrder(source, "").ToOrder()
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(order.Status).To(Equal(expected))
+	},
+		Entry("in person orders are done", domain.OrderSourceInPerson, domain.OrderStatusDone),
+		Entry("delivery orders are delivered", domain.OrderSourceDelivery, domain.OrderStatusDelivered),
+	)
+
+	DescribeTable("invalid orders", func(update func(order *domain.ImportedOrder), message string) {
+		order := validOrder(domain.OrderSourcePhone, domain.OrderStatusCancelled)
+		update(&order)
+
+		_, err := order.ToOrder()
+
+		Expect(err).To(MatchError(domain.ErrInvalidImportedOrder))
+		Expect(err).To(MatchError(ContainSubstring(message)))
+	},
+		Entry("without time", func(order *domain.ImportedOrder) { order.Time = time.Time{} }, "time is required"),
+		Entry("without dishes", func(order *domain.ImportedOrder) { order.Dishes = nil }, "at least one dish"),
+		Entry("with an unnamed dish", func(order *domain.ImportedOrder) { order.Dishes = []domain.Dish{{}} }, "dish name"),
+		Entry("with an unknown source", func(order *domain.ImportedOrder) { order.Source = "kiosk" }, "unknown source"),
+		Entry("with an unknown status", func(order *domain.ImportedOrder) { order.Status = "lost" }, "unsupported status"),
+		Entry("scheduled", func(order *domain.ImportedOrder) { order.Status = domain.OrderStatusScheduled }, "unsupported status"),
+		Entry("with a delivery status", func(order *domain.ImportedOrder) { order.Status = domain.OrderStatusDelivered }, "only valid for delivery"),
+		Entry("with delivery details", func(order *domain.ImportedOrder) {
+			order.Delivery = &domain.DeliveryDetails{Address: "Calle 10", ContactName: "Ana", ContactPhone: "555"}
+		}, "not a delivery order"),
+		Entry("with an untimed status change", func(order *domain.ImportedOrder) {
+			order.StatusHistory = []domain.OrderStatusHistory{{Status: domain.OrderStatusCancelled}}
+		}, "known status and a timestamp"),
+		Entry("with an unsorted status history", func(order *domain.ImportedOrder) {
+			later := order.Time.Add(time.Minute)
+			order.StatusHistory = []domain.OrderStatusHistory{
+				{Status: domain.OrderStatusPending, Timestamp: &later},
+				{Status: domain.OrderStatusCancelled, Timestamp: &order.Time},
+			}
+		}, "sorted by time"),
+		Entry("with a status history ending in another status", func(order *domain.ImportedOrder) {
+			order.StatusHistory = []domain.OrderStatusHistory{{Status: domain.OrderStatusPending, Timestamp: &order.Time}}
+		}, "must end with the status cancelled"),
+		Entry("with a table", func(order *domain.ImportedOrder) {
+			tableID := uint(1)
+			order.TableID = &tableID
+		}, "into a table"),
+	)
+
+	It("should add errors to the report up to the limit", func() {
+		report := &domain.ImportReport{}
+
+		for i := range domain.MaxImportErrors + 5 {
+			report.AddError(i+2, domain.ErrInvalidImportedOrder)
+		}
+
+		Expect(report.Failed).To(Equal(domain.MaxImportErrors + 5))
+		Expect(report.Errors).To(HaveLen(domain.MaxImportErrors))
+		Expect(report.Errors[0]).To(Equal(domain.ImportRowError{Row: 2, Error: "Imported order is not valid"}))
+	})
+})
@@ -5,3 +5,7 @@ type OrderSource string
 const OrderSourceInPerson OrderSource = "in_person"
 const OrderSourceDelivery OrderSource = "delivery"
 const OrderSourcePhone OrderSource = "phone"
+
+func (s OrderSource) IsValid() bool {
+	return s == OrderSourceInPerson || s == OrderSourceDelivery || s == OrderSourcePhone
+}
@@ -1,17 +1,52 @@
 package domain
 
+import "slices"
+
 type OrderStatus string
 
+// Orders waiting to be released into the kitchen at their release time
+const OrderStatusScheduled OrderStatus = "scheduled"
 const OrderStatusPending OrderStatus = "pending"
 const OrderStatusPreparing OrderStatus = "preparing"
 const OrderStatusReady OrderStatus = "ready"
 const OrderStatusDone OrderStatus = "done"
 const OrderStatusCancelled OrderStatus = "cancelled"
 
+// Delivery orders only, they replace done once the order leaves the kitchen
+const OrderStatusAwaitingCourier OrderStatus = "awaiting_courier"
+const OrderStatusOutForDelivery OrderStatus = "out_for_delivery"
+const OrderStatusDelivered OrderStatus = "delivered"
+const OrderStatusDeliveryFailed OrderStatus = "delivery_failed"
+
 var statusWeights = map[OrderStatus]uint{
-	OrderStatusPending:   10,
-	OrderStatusPreparing: 20,
-	OrderStatusReady:     30,
-	OrderStatusDone:      40,
-	OrderStatusCancelled: 40,
+	OrderStatusScheduled:       5,
+	OrderStatusPending:         10,
+	OrderStatusPreparing:       20,
+	OrderStatusReady:           30,
+	OrderStatusAwaitingCourier: 32,
+	OrderStatusOutForDelivery:  34,
+	OrderStatusDone:            40,
+	OrderStatusDelivered:       40,
+	OrderStatusDeliveryFailed:  40,
+	OrderStatusCancelled:       40,
+}
+
+var deliveryStatuses = map[OrderStatus]bool{
+	OrderStatusAwaitingCourier: true,
+	OrderStatusOutForDelivery:  true,
+	OrderStatusDelivered:       true,
+	OrderStatusDeliveryFailed:  true,
+}
+
+func (s OrderStatus) IsFinal() bool {
+	return statusWeights[s] == statusWeights[OrderStatusDone]
+}
+
+func (s OrderStatus) IsValid() bool {
+	_, ok := statusWeights[s]
+	return ok
+}
+
+func (s OrderStatus) InKitchen() bool {
+	return slices.Contains(KitchenStatuses, s)
 }
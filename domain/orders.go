@@ -8,6 +8,21 @@ type NewOrder struct {
 	Time   time.Time   `json:"time" binding:"required"`
 	Dishes []Dish      `json:"dishes" binding:"required,min=1,dive"`
 	Source OrderSource `json:"source" binding:"oneof=in_person delivery phone"`
+	// Optional, phone orders can instead provide CustomerPhone to be linked to a known customer
+	CustomerID    *uint  `json:"customer_id,omitempty"`
+	CustomerPhone string `json:"customer_phone,omitempty"`
+	// Delivery orders only
+	Delivery *DeliveryDetails `json:"delivery,omitempty"`
+	// When set far enough in the future, the order is held until it needs to be prepared
+	ReadyAt *time.Time `json:"ready_at,omitempty"`
+	// Set for orders received through an integration, which are never created twice
+	External *ExternalReference `json:"external,omitempty"`
+	// Free text for the kitchen, e.g. "no onions, cut in half"
+	Notes     string        `json:"notes,omitempty" binding:"max=500"`
+	Allergies []Allergy     `json:"allergies,omitempty" binding:"dive"`
+	Dietary   []DietaryFlag `json:"dietary,omitempty"`
+	// In person orders only, the order joins the open session of the table
+	TableID *uint `json:"table_id,omitempty"`
 }
 
 type OrderWithStatusHistory struct {
@@ -19,8 +34,35 @@ type Order struct {
 	ID     uint        `json:"id"`
 	Status OrderStatus `json:"status"`
 	NewOrder
+	Courier *Courier `json:"courier,omitempty"`
+	// Set for the orders of a table, along with TableID
+	TableSessionID *uint      `json:"table_session_id,omitempty"`
+	ReleaseAt      *time.Time `json:"release_at,omitempty"`
+	CreatedAt      *time.Time `json:"created_at,omitempty"`
+	// Whether the order exceeded the time limit of its current status
+	Late   bool       `json:"late"`
+	LateAt *time.Time `json:"late_at,omitempty"`
+	// Only set for cancelled orders
+	Cancellation *Cancellation `json:"cancellation,omitempty"`
+	// The allergies, diet and notes of the order, for kitchen displays to highlight
+	Alerts []string `json:"alerts,omitempty"`
+	// Only set in the responses of the operations whose dishes were cross checked against the menu catalog
+	Warnings []DietaryWarning `json:"warnings,omitempty"`
 }
 
 func (o *Order) IsNewStatusValid(status OrderStatus) bool {
+	// Delivery orders finish as delivered or failed instead of done
+	if o.IsDelivery() && status == OrderStatusDone {
+		return false
+	}
+
+	if !o.IsDelivery() && deliveryStatuses[status] {
+		return false
+	}
+
 	return statusWeights[o.Status] < statusWeights[status]
 }
+
+func (o *Order) IsDelivery() bool {
+	return o.Source == OrderSourceDelivery
+}
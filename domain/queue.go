@@ -0,0 +1,23 @@
+package domain
+
+import "time"
+
+type PositionChange struct {
+	Position  uint       `json:"position"`
+	Timestamp *time.Time `json:"timestamp"`
+}
+
+type QueuePosition struct {
+	OrderID  uint
+	Position uint
+	QueuedAt *time.Time
+	History  []PositionChange
+}
+
+type QueuedOrder struct {
+	Order
+	Position        uint             `json:"position"`
+	QueuedAt        *time.Time       `json:"queued_at"`
+	WaitingSeconds  int64            `json:"waiting_seconds"`
+	PositionHistory []PositionChange `json:"position_history"`
+}
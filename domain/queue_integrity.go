@@ -0,0 +1,13 @@
+package domain
+
+type QueueIntegrityReport struct {
+	Healthy bool `json:"healthy"`
+	// Positions missing between 1 and the last queued position
+	Gaps []uint `json:"gaps"`
+	// Positions held by more than one order
+	Duplicates []uint `json:"duplicates"`
+	// Queued orders that don't exist anymore or are already completed
+	Orphaned []uint `json:"orphaned"`
+	// Active orders without a queue position
+	Missing []uint `json:"missing"`
+}
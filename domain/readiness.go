@@ -0,0 +1,13 @@
+package domain
+
+type ReadinessReport struct {
+	Ready  bool                   `json:"ready"`
+	Checks []ReadinessCheckResult `json:"checks"`
+}
+
+type ReadinessCheckResult struct {
+	Name  string `json:"name"`
+	Ready bool   `json:"ready"`
+	// Why the check failed, empty when it passed
+	Error string `json:"error,omitempty"`
+}
@@ -0,0 +1,25 @@
+package domain
+
+import "time"
+
+const DefaultArchiveAfter = 30 * 24 * time.Hour
+const DefaultArchiveBatchSize = 500
+
+// RetentionPolicy decides when completed orders are moved out of the order tables. They are
+// archived in batches so a single transaction never holds the database for too long
+type RetentionPolicy struct {
+	ArchiveAfter time.Duration
+	BatchSize    int
+}
+
+func DefaultRetentionPolicy() RetentionPolicy {
+	return RetentionPolicy{
+		ArchiveAfter: DefaultArchiveAfter,
+		BatchSize:    DefaultArchiveBatchSize,
+	}
+}
+
+// ArchiveBefore is the time before which completed orders are due to be archived
+func (p RetentionPolicy) ArchiveBefore(now time.Time) time.Time {
+	return now.Add(-p.ArchiveAfter)
+}
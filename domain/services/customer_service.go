@@ -0,0 +1,125 @@
+package services
+
+import (
+	"context"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+)
+
+type CustomerService interface {
+	CreateCustomer(ctx context.Context, request domain.NewCustomer) (*domain.Customer, error)
+	FindByID(ctx context.Context, id uint) (*domain.Customer, error)
+	FindByPhone(ctx context.Context, phone string) (*domain.Customer, error)
+	FindMany(ctx context.Context) ([]domain.Customer, error)
+	UpdateCustomer(ctx context.Context, id uint, request domain.NewCustomer) (*domain.Customer, error)
+	DeleteCustomer(ctx context.Context, id uint) error
+	GetOrderHistory(ctx context.Context, id uint) ([]domain.Order, error)
+}
+
+type customerServiceImpl struct {
+	customerStore CustomerStore
+	orderStore    OrderStore
+}
+
+func NewCustomerService(customerStore CustomerStore, orderStore OrderStore) CustomerService {
+	return &customerServiceImpl{
+		customerStore: customerStore,
+		orderStore:    orderStore,
+	}
+}
+
+func (s *customerServiceImpl) CreateCustomer(ctx context.Context, request domain.NewCustomer) (*domain.Customer, error) {
+	request = normalizeCustomer(request)
+
+	if err := s.checkPhoneAvailable(ctx, request.Phone, 0); err != nil {
+		return nil, err
+	}
+
+	return s.customerStore.Save(ctx, domain.Customer{NewCustomer: request})
+}
+
+func (s *customerServiceImpl) FindByID(ctx context.Context, id uint) (*domain.Customer, error) {
+	customer, err := s.customerStore.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if customer == nil {
+		return nil, domain.ErrCustomerNotFound
+	}
+
+	return customer, nil
+}
+
+func (s *customerServiceImpl) FindByPhone(ctx context.Context, phone string) (*domain.Customer, error) {
+	customer, err := s.customerStore.FindByPhone(ctx, domain.NormalizePhone(phone))
+	if err != nil {
+		return nil, err
+	}
+
+	if customer == nil {
+		return nil, domain.ErrCustomerNotFound
+	}
+
+	return customer, nil
+}
+
+func (s *customerServiceImpl) FindMany(ctx context.Context) ([]domain.Customer, error) {
+	return s.customerStore.GetAll(ctx)
+}
+
+func (s *customerServiceImpl) UpdateCustomer(ctx context.Context, id uint, request domain.NewCustomer) (*domain.Customer, error) {
+	if _, err := s.FindByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	request = normalizeCustomer(request)
+
+	if err := s.checkPhoneAvailable(ctx, request.Phone, id); err != nil {
+		return nil, err
+	}
+
+	return s.customerStore.Save(ctx, domain.Customer{ID: id, NewCustomer: request})
+}
+
+func (s *customerServiceImpl) DeleteCustomer(ctx context.Context, id uint) error {
+	if _, err := s.FindByID(ctx, id); err != nil {
+		return err
+	}
+
+	return s.customerStore.Delete(ctx, id)
+}
+
+func (s *customerServiceImpl) GetOrderHistory(ctx context.Context, id uint) ([]domain.Order, error) {
+	if _, err := s.FindByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	filters := &domain.OrderFilters{}
+	domain.FilterByCustomer(id)(filters)
+
+	return s.orderStore.GetAll(ctx, filters)
+}
+
+func (s *customerServiceImpl) checkPhoneAvailable(ctx context.Context, phone string, ownerID uint) error {
+	existing, err := s.customerStore.FindByPhone(ctx, phone)
+	if err != nil {
+		return err
+	}
+
+	if existing != nil && existing.ID != ownerID {
+		return domain.ErrDuplicateCustomer
+	}
+
+	return nil
+}
+
+func normalizeCustomer(request domain.NewCustomer) domain.NewCustomer {
+	request.Phone = domain.NormalizePhone(request.Phone)
+
+	if request.LoyaltyTier == "" {
+		request.LoyaltyTier = domain.LoyaltyTierRegular
+	}
+
+	return request
+}
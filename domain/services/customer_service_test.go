@@ -0,0 +1,132 @@
+package services_test
+
+import (
+	"context"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("CustomerService", func() {
+	var (
+		mockCustomerStore *mocks.MockCustomerStore
+		mockOrderStore    *mocks.MockOrderStore
+		customerService   services.CustomerService
+		existing          *domain.Customer
+	)
+
+	BeforeEach(func() {
+		mockCtrl := gomock.NewController(GinkgoT())
+		mockCustomerStore = mocks.NewMockCustomerStore(mockCtrl)
+		mockOrderStore = mocks.NewMockOrderStore(mockCtrl)
+		customerService = services.NewCustomerService(mockCustomerStore, mockOrderStore)
+		existing = &domain.Customer{
+			ID:          1,
+			NewCustomer: domain.NewCustomer{Name: "Ana", Phone: "5550000", LoyaltyTier: domain.LoyaltyTierGold},
+		}
+	})
+
+	Context("CreateCustomer", func() {
+		It("should store the customer with a normalized phone and default tier", func() {
+			mockCustomerStore.EXPECT().FindByPhone(gomock.Any(), "+5550001").Return(nil, nil)
+			mockCustomerStore.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, c domain.Customer) (*domain.Customer, error) {
+				c.ID = 2
+				return &c, nil
+			})
+
+			customer, err := customerService.CreateCustomer(context.Background(), domain.NewCustomer{Name: "Bruno", Phone: "+555 00-01"})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(customer.Phone).To(Equal("+5550001"))
+			Expect(customer.LoyaltyTier).To(Equal(domain.LoyaltyTierRegular))
+		})
+
+		It("should reject a phone used by another customer", func() {
+			mockCustomerStore.EXPECT().FindByPhone(gomock.Any(), "5550000").Return(existing, nil)
+
+			customer, err := customerService.CreateCustomer(context.Background(), domain.NewCustomer{Name: "Bruno", Phone: "555-0000"})
+
+			Expect(customer).To(BeNil())
+			Expect(err).To(Equal(domain.ErrDuplicateCustomer))
+		})
+	})
+
+	Context("UpdateCustomer", func() {
+		It("should allow keeping the same phone", func() {
+			mockCustomerStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(existing, nil)
+			mockCustomerStore.EXPECT().FindByPhone(gomock.Any(), "5550000").Return(existing, nil)
+			mockCustomerStore.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, c domain.Customer) (*domain.Customer, error) {
+				return &c, nil
+			})
+
+			customer, err := customerService.UpdateCustomer(context.Background(), 1, domain.NewCustomer{
+				Name:        "Ana",
+				Phone:       "5550000",
+				LoyaltyTier: domain.LoyaltyTierVIP,
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(customer.ID).To(Equal(uint(1)))
+			Expect(customer.LoyaltyTier).To(Equal(domain.LoyaltyTierVIP))
+		})
+
+		It("should return an error if the customer does not exist", func() {
+			mockCustomerStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(nil, nil)
+
+			customer, err := customerService.UpdateCustomer(context.Background(), 1, domain.NewCustomer{Name: "Ana", Phone: "5550000"})
+
+			Expect(customer).To(BeNil())
+			Expect(err).To(Equal(domain.ErrCustomerNotFound))
+		})
+	})
+
+	Context("FindByPhone", func() {
+		It("should look up the normalized phone", func() {
+			mockCustomerStore.EXPECT().FindByPhone(gomock.Any(), "5550000").Return(existing, nil)
+
+			customer, err := customerService.FindByPhone(context.Background(), " 555 0000 ")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(customer).To(Equal(existing))
+		})
+
+		It("should return an error if nobody has the phone", func() {
+			mockCustomerStore.EXPECT().FindByPhone(gomock.Any(), "5550000").Return(nil, nil)
+
+			customer, err := customerService.FindByPhone(context.Background(), "5550000")
+
+			Expect(customer).To(BeNil())
+			Expect(err).To(Equal(domain.ErrCustomerNotFound))
+		})
+	})
+
+	Context("GetOrderHistory", func() {
+		It("should return the orders of the customer", func() {
+			orders := []domain.Order{{ID: 10}, {ID: 12}}
+
+			mockCustomerStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(existing, nil)
+			mockOrderStore.EXPECT().GetAll(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, filters *domain.OrderFilters) ([]domain.Order, error) {
+				Expect(*filters.CustomerID).To(Equal(uint(1)))
+				return orders, nil
+			})
+
+			result, err := customerService.GetOrderHistory(context.Background(), 1)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(orders))
+		})
+
+		It("should return an error if the customer does not exist", func() {
+			mockCustomerStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(nil, nil)
+
+			result, err := customerService.GetOrderHistory(context.Background(), 1)
+
+			Expect(result).To(BeNil())
+			Expect(err).To(Equal(domain.ErrCustomerNotFound))
+		})
+	})
+})
@@ -0,0 +1,15 @@
+package services
+
+import (
+	"context"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+)
+
+type CustomerStore interface {
+	Save(ctx context.Context, customer domain.Customer) (*domain.Customer, error)
+	FindByID(ctx context.Context, id uint) (*domain.Customer, error)
+	FindByPhone(ctx context.Context, phone string) (*domain.Customer, error)
+	GetAll(ctx context.Context) ([]domain.Customer, error)
+	Delete(ctx context.Context, id uint) error
+}
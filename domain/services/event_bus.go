@@ -0,0 +1,89 @@
+package services
+
+import (
+	"sync"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+)
+
+type EventPublisher interface {
+	Publish(event domain.OrderEvent)
+}
+
+type EventSubscriber interface {
+	// Subscribe returns a channel receiving every published event, and a function to stop receiving them
+	Subscribe(buffer int, options ...SubscribeOption) (<-chan domain.OrderEvent, func())
+}
+
+type SubscribeOption func(*subscription)
+
+// OnDropped is called with every event missed because the buffer of the subscriber was full.
+// It runs while publishing, so it must not block
+func OnDropped(dropped func(event domain.OrderEvent)) SubscribeOption {
+	return func(s *subscription) {
+		s.dropped = dropped
+	}
+}
+
+type subscription struct {
+	events  chan domain.OrderEvent
+	dropped func(event domain.OrderEvent)
+}
+
+// EventBus delivers order events in memory. Slow subscribers miss events instead of
+// blocking the publisher once their buffer is full, which they can learn through OnDropped
+type EventBus struct {
+	mu          sync.RWMutex
+	nextID      int
+	subscribers map[int]*subscription
+}
+
+func NewEventBus() *EventBus {
+	return &EventBus{
+		subscribers: make(map[int]*subscription),
+	}
+}
+
+func (b *EventBus) Publish(event domain.OrderEvent) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, subscriber := range b.subscribers {
+		select {
+		case subscriber.events <- event:
+		default:
+			if subscriber.dropped != nil {
+				subscriber.dropped(event)
+			}
+		}
+	}
+}
+
+func (b *EventBus) Subscribe(buffer int, options ...SubscribeOption) (<-chan domain.OrderEvent, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	id := b.nextID
+	b.nextID++
+
+	events := make(chan domain.OrderEvent, buffer)
+	subscriber := &subscription{events: events}
+
+	for _, option := range options {
+		option(subscriber)
+	}
+
+	b.subscribers[id] = subscriber
+
+	var once sync.Once
+
+	return events, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+
+			delete(b.subscribers, id)
+			close(events)
+		})
+	}
+}
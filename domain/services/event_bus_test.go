@@ -0,0 +1,67 @@
+package services_test
+
+import (
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EventBus", func() {
+	var bus *services.EventBus
+
+	BeforeEach(func() {
+		bus = services.NewEventBus()
+	})
+
+	It("should deliver events to every subscriber", func() {
+		first, unsubscribeFirst := bus.Subscribe(1)
+		defer unsubscribeFirst()
+		second, unsubscribeSecond := bus.Subscribe(1)
+		defer unsubscribeSecond()
+
+		event := domain.OrderEvent{Type: domain.OrderEventLate, Order: domain.Order{ID: 1}}
+		bus.Publish(event)
+
+		Expect(first).To(Receive(Equal(event)))
+		Expect(second).To(Receive(Equal(event)))
+	})
+
+	It("should drop events for subscribers with a full buffer", func() {
+		events, unsubscribe := bus.Subscribe(1)
+		defer unsubscribe()
+
+		bus.Publish(domain.OrderEvent{Order: domain.Order{ID: 1}})
+		bus.Publish(domain.OrderEvent{Order: domain.Order{ID: 2}})
+
+		var event domain.OrderEvent
+		Expect(events).To(Receive(&event))
+		Expect(event.Order.ID).To(Equal(uint(1)))
+		Expect(events).ToNot(Receive())
+	})
+
+	It("should report the dropped events to their subscriber", func() {
+		var dropped []uint
+		events, unsubscribe := bus.Subscribe(1, services.OnDropped(func(event domain.OrderEvent) {
+			dropped = append(dropped, event.Order.ID)
+		}))
+		defer unsubscribe()
+
+		bus.Publish(domain.OrderEvent{Order: domain.Order{ID: 1}})
+		bus.Publish(domain.OrderEvent{Order: domain.Order{ID: 2}})
+		bus.Publish(domain.OrderEvent{Order: domain.Order{ID: 3}})
+
+		Expect(events).To(Receive())
+		Expect(dropped).To(Equal([]uint{2, 3}))
+	})
+
+	It("should stop delivering events after unsubscribing", func() {
+		events, unsubscribe := bus.Subscribe(1)
+		unsubscribe()
+		unsubscribe()
+
+		bus.Publish(domain.OrderEvent{Order: domain.Order{ID: 1}})
+
+		Expect(events).To(BeClosed())
+	})
+})
@@ -0,0 +1,88 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+)
+
+const integrationEventsBuffer = 100
+
+// IntegrationStatusNotifier sends the status changes of the orders received through an
+// integration back to their provider
+type IntegrationStatusNotifier struct {
+	providers  map[string]IntegrationProvider
+	subscriber EventSubscriber
+	client     *http.Client
+	logger     *slog.Logger
+}
+
+func NewIntegrationStatusNotifier(
+	subscriber EventSubscriber,
+	client *http.Client,
+	logger *slog.Logger,
+	providers ...IntegrationProvider,
+) *IntegrationStatusNotifier {
+	return &IntegrationStatusNotifier{
+		providers:  integrationProvidersByName(providers),
+		subscriber: subscriber,
+		client:     client,
+		logger:     logger,
+	}
+}
+
+// Run blocks until the context is cancelled
+func (n *IntegrationStatusNotifier) Run(ctx context.Context) {
+	events, unsubscribe := n.subscriber.Subscribe(integrationEventsBuffer)
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+
+			if err := n.Notify(ctx, event); err != nil {
+				n.logger.ErrorContext(ctx, "failed to notify the order status", orderIDLogAttr(event.Order.ID), slog.Any("error", err))
+			}
+		}
+	}
+}
+
+// Notify ignores the events that aren't status changes of orders received through an integration
+func (n *IntegrationStatusNotifier) Notify(ctx context.Context, event domain.OrderEvent) error {
+	if event.Type != domain.OrderEventStatusChanged || event.Order.External == nil {
+		return nil
+	}
+
+	provider, ok := n.providers[event.Order.External.Provider]
+	if !ok {
+		return nil
+	}
+
+	req, err := provider.StatusCallback(ctx, event)
+	if err != nil || req == nil {
+		return err
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package services_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("IntegrationStatusNotifier", func() {
+	var (
+		mockProvider *mocks.MockIntegrationProvider
+		notifier     *services.IntegrationStatusNotifier
+		server       *httptest.Server
+		status       int
+		calls        int
+		event        domain.OrderEvent
+	)
+
+	BeforeEach(func() {
+		mockProvider = mocks.NewMockIntegrationProvider(gomock.NewController(GinkgoT()))
+		mockProvider.EXPECT().Name().Return("marketplace")
+
+		status = http.StatusOK
+		calls = 0
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(status)
+		}))
+		DeferCleanup(server.Close)
+
+		notifier = services.NewIntegrationStatusNotifier(services.NewEventBus(), server.Client(), testLogger, mockProvider)
+
+		event = domain.OrderEvent{
+			Type: domain.OrderEventStatusChanged,
+			Order: domain.Order{
+				ID:       1,
+				Status:   domain.OrderStatusPreparing,
+				NewOrder: domain.NewOrder{External: &domain.ExternalReference{Provider: "marketplace", ID: "M-1"}},
+			},
+		}
+	})
+
+	callback := func(ctx context.Context, _ domain.OrderEvent) (*http.Request, error) {
+		return http.NewRequestWithContext(ctx, http.MethodPost, server.URL, nil)
+	}
+
+	It("should send the status changes to the provider", func() {
+		mockProvider.EXPECT().StatusCallback(gomock.Any(), event).DoAndReturn(callback)
+
+		Expect(notifier.Notify(context.Background(), event)).To(Succeed())
+		Expect(calls).To(Equal(1))
+	})
+
+	It("should return an error when the provider rejects the update", func() {
+		status = http.StatusBadGateway
+		mockProvider.EXPECT().StatusCallback(gomock.Any(), event).DoAndReturn(callback)
+
+		Expect(notifier.Notify(context.Background(), event)).To(MatchError(ContainSubstring("502")))
+	})
+
+	It("should skip statuses the provider doesn't track", func() {
+		mockProvider.EXPECT().StatusCallback(gomock.Any(), event).Return(nil, nil)
+
+		Expect(notifier.Notify(context.Background(), event)).To(Succeed())
+		Expect(calls).To(BeZero())
+	})
+
+	DescribeTable("ignored events", func(update func(e *domain.OrderEvent)) {
+		update(&event)
+
+		Expect(notifier.Notify(context.Background(), event)).To(Succeed())
+		Expect(calls).To(BeZero())
+	},
+		Entry("other event types", func(e *domain.OrderEvent) { e.Type = domain.OrderEventUpdated }),
+		Entry("orders created directly", func(e *domain.OrderEvent) { e.Order.External = nil }),
+		Entry("orders of unknown providers", func(e *domain.OrderEvent) {
+			e.Order.External = &domain.ExternalReference{Provider: "other", ID: "O-1"}
+		}),
+	)
+})
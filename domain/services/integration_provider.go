@@ -0,0 +1,18 @@
+package services
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+)
+
+// IntegrationProvider translates the payloads of a third-party ordering platform
+type IntegrationProvider interface {
+	Name() string
+	VerifySignature(payload []byte, headers http.Header) error
+	ParseOrder(payload []byte) (*domain.NewOrder, error)
+	// StatusCallback builds the request notifying the provider about the order status change,
+	// or nil when the provider doesn't track the new status
+	StatusCallback(ctx context.Context, event domain.OrderEvent) (*http.Request, error)
+}
@@ -0,0 +1,100 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+)
+
+type IntegrationService interface {
+	// ReceiveOrder creates the order sent by the provider, returning the existing one instead
+	// if it was already received. The returned flag tells whether the order was created
+	ReceiveOrder(ctx context.Context, provider string, payload []byte, headers http.Header) (*domain.Order, bool, error)
+}
+
+type integrationServiceImpl struct {
+	orderService OrderService
+	orderStore   OrderStore
+	providers    map[string]IntegrationProvider
+}
+
+func NewIntegrationService(
+	orderService OrderService,
+	orderStore OrderStore,
+	providers ...IntegrationProvider,
+) IntegrationService {
+	return &integrationServiceImpl{
+		orderService: orderService,
+		orderStore:   orderStore,
+		providers:    integrationProvidersByName(providers),
+	}
+}
+
+func (s *integrationServiceImpl) ReceiveOrder(
+	ctx context.Context,
+	provider string,
+	payload []byte,
+	headers http.Header,
+) (*domain.Order, bool, error) {
+	integration, ok := s.providers[provider]
+	if !ok {
+		return nil, false, domain.ErrUnknownIntegration
+	}
+
+	if err := integration.VerifySignature(payload, headers); err != nil {
+		return nil, false, err
+	}
+
+	request, err := integration.ParseOrder(payload)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if request.External == nil || request.External.ID == "" {
+		return nil, false, domain.ErrInvalidIntegrationPayload
+	}
+
+	request.External.Provider = provider
+
+	existing, err := s.orderStore.FindByExternalID(ctx, provider, request.External.ID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if existing != nil {
+		return existing, false, nil
+	}
+
+	order, err := s.orderService.CreateOrder(ctx, *request)
+	// Another delivery of the same order may have been created since it was looked up
+	if errors.Is(err, domain.ErrDuplicateExternalOrder) {
+		existing, err = s.orderStore.FindByExternalID(ctx, provider, request.External.ID)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if existing == nil {
+			return nil, false, domain.ErrDuplicateExternalOrder
+		}
+
+		return existing, false, nil
+	}
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	return order, true, nil
+}
+
+func integrationProvidersByName(providers []IntegrationProvider) map[string]IntegrationProvider {
+	byName := make(map[string]IntegrationProvider, len(providers))
+
+	for _, provider := range providers {
+		byName[provider.Name()] = provider
+	}
+
+	return byName
+}
@@ -0,0 +1,133 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("IntegrationService", func() {
+	var (
+		mockProvider       *mocks.MockIntegrationProvider
+		mockOrderService   *mocks.MockOrderService
+		mockOrderStore     *mocks.MockOrderStore
+		integrationService services.IntegrationService
+		payload            []byte
+		headers            http.Header
+		request            domain.NewOrder
+	)
+
+	BeforeEach(func() {
+		mockCtrl := gomock.NewController(GinkgoT())
+		mockProvider = mocks.NewMockIntegrationProvider(mockCtrl)
+		mockOrderService = mocks.NewMockOrderService(mockCtrl)
+		mockOrderStore = mocks.NewMockOrderStore(mockCtrl)
+
+		mockProvider.EXPECT().Name().Return("marketplace")
+		integrationService = services.NewIntegrationService(mockOrderService, mockOrderStore, mockProvider)
+
+		payload = []byte(`{"id":"M-1"}`)
+		headers = http.Header{}
+		request = domain.NewOrder{
+			Source:   domain.OrderSourceDelivery,
+			Dishes:   []domain.Dish{{Name: "Pizza"}},
+			External: &domain.ExternalReference{ID: "M-1"},
+		}
+	})
+
+	It("should create the orders received for the first time", func() {
+		mockProvider.EXPECT().VerifySignature(payload, headers).Return(nil)
+		mockProvider.EXPECT().ParseOrder(payload).Return(&request, nil)
+		mockOrderStore.EXPECT().FindByExternalID(gomock.Any(), "marketplace", "M-1").Return(nil, nil)
+		mockOrderService.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, r domain.NewOrder) (*domain.Order, error) {
+			Expect(r.External.Provider).To(Equal("marketplace"))
+			return &domain.Order{ID: 1, NewOrder: r}, nil
+		})
+
+		order, created, err := integrationService.ReceiveOrder(context.Background(), "marketplace", payload, headers)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(created).To(BeTrue())
+		Expect(order.ID).To(Equal(uint(1)))
+	})
+
+	It("should return the existing order when it's received again", func() {
+		existing := &domain.Order{ID: 1, NewOrder: request}
+
+		mockProvider.EXPECT().VerifySignature(payload, headers).Return(nil)
+		mockProvider.EXPECT().ParseOrder(payload).Return(&request, nil)
+		mockOrderStore.EXPECT().FindByExternalID(gomock.Any(), "marketplace", "M-1").Return(existing, nil)
+
+		order, created, err := integrationService.ReceiveOrder(context.Background(), "marketplace", payload, headers)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(created).To(BeFalse())
+		Expect(order).To(Equal(existing))
+	})
+
+	It("should return the order created by a concurrent delivery", func() {
+		existing := &domain.Order{ID: 1, NewOrder: request}
+
+		mockProvider.EXPECT().VerifySignature(payload, headers).Return(nil)
+		mockProvider.EXPECT().ParseOrder(payload).Return(&request, nil)
+		gomock.InOrder(
+			mockOrderStore.EXPECT().FindByExternalID(gomock.Any(), "marketplace", "M-1").Return(nil, nil),
+			mockOrderService.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil, domain.ErrDuplicateExternalOrder),
+			mockOrderStore.EXPECT().FindByExternalID(gomock.Any(), "marketplace", "M-1").Return(existing, nil),
+		)
+
+		order, created, err := integrationService.ReceiveOrder(context.Background(), "marketplace", payload, headers)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(created).To(BeFalse())
+		Expect(order).To(Equal(existing))
+	})
+
+	It("should reject unknown providers", func() {
+		order, _, err := integrationService.ReceiveOrder(context.Background(), "other", payload, headers)
+
+		Expect(order).To(BeNil())
+		Expect(err).To(Equal(domain.ErrUnknownIntegration))
+	})
+
+	It("should reject payloads with invalid signatures", func() {
+		mockProvider.EXPECT().VerifySignature(payload, headers).Return(domain.ErrInvalidIntegrationSignature)
+
+		order, _, err := integrationService.ReceiveOrder(context.Background(), "marketplace", payload, headers)
+
+		Expect(order).To(BeNil())
+		Expect(err).To(Equal(domain.ErrInvalidIntegrationSignature))
+	})
+
+	It("should reject orders without an external id", func() {
+		request.External = nil
+
+		mockProvider.EXPECT().VerifySignature(payload, headers).Return(nil)
+		mockProvider.EXPECT().ParseOrder(payload).Return(&request, nil)
+
+		order, _, err := integrationService.ReceiveOrder(context.Background(), "marketplace", payload, headers)
+
+		Expect(order).To(BeNil())
+		Expect(err).To(Equal(domain.ErrInvalidIntegrationPayload))
+	})
+
+	It("should return an error if the order can't be created", func() {
+		mockProvider.EXPECT().VerifySignature(payload, headers).Return(nil)
+		mockProvider.EXPECT().ParseOrder(payload).Return(&request, nil)
+		mockOrderStore.EXPECT().FindByExternalID(gomock.Any(), "marketplace", "M-1").Return(nil, nil)
+		mockOrderService.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+
+		order, created, err := integrationService.ReceiveOrder(context.Background(), "marketplace", payload, headers)
+
+		Expect(order).To(BeNil())
+		Expect(created).To(BeFalse())
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,135 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+)
+
+const DefaultLateOrderWatcherInterval = time.Minute
+
+// LateOrderWatcher periodically flags the active orders that exceeded the time limit of
+// their current status, and notifies the event subscribers about them
+type LateOrderWatcher struct {
+	orderStore  OrderStore
+	statusStore OrderStatusStore
+	publisher   EventPublisher
+	policy      domain.SLAPolicy
+	clock       domain.Clock
+	interval    time.Duration
+	logger      *slog.Logger
+}
+
+func NewLateOrderWatcher(
+	orderStore OrderStore,
+	statusStore OrderStatusStore,
+	publisher EventPublisher,
+	policy domain.SLAPolicy,
+	clock domain.Clock,
+	interval time.Duration,
+	logger *slog.Logger,
+) *LateOrderWatcher {
+	return &LateOrderWatcher{
+		orderStore:  orderStore,
+		statusStore: statusStore,
+		publisher:   publisher,
+		policy:      policy,
+		clock:       clock,
+		interval:    interval,
+		logger:      logger,
+	}
+}
+
+// Run blocks until the context is cancelled
+func (w *LateOrderWatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := w.CheckOnce(ctx); err != nil {
+				w.logger.ErrorContext(ctx, "failed to check late orders", slog.Any("error", err))
+			}
+		}
+	}
+}
+
+// CheckOnce returns the orders that became late since the last check
+func (w *LateOrderWatcher) CheckOnce(ctx context.Context) (_ []domain.Order, err error) {
+	ctx, span := tracer.Start(ctx, "LateOrderWatcher.CheckOnce")
+	defer func() { endSpan(span, err) }()
+
+	filters := &domain.OrderFilters{}
+	domain.FilterActive(filters)
+
+	orders, err := w.orderStore.GetAll(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	now := w.clock.Now()
+	var late []domain.Order
+
+	for _, order := range orders {
+		if order.Late {
+			continue
+		}
+
+		limit, ok := w.policy.LimitFor(order.Source, order.Status)
+		if !ok {
+			continue
+		}
+
+		since, err := w.statusSince(ctx, order)
+		if err != nil {
+			return late, err
+		}
+
+		if since == nil || now.Sub(*since) <= limit {
+			continue
+		}
+
+		marked, err := w.orderStore.MarkLate(ctx, order.ID, order.Status, now)
+		if err != nil {
+			return late, err
+		}
+
+		// The order changed its status since it was loaded
+		if !marked {
+			continue
+		}
+
+		order.Late = true
+		order.LateAt = &now
+
+		w.publisher.Publish(domain.OrderEvent{
+			Type:      domain.OrderEventLate,
+			Order:     order,
+			Timestamp: now,
+		})
+
+		late = append(late, order)
+	}
+
+	return late, nil
+}
+
+func (w *LateOrderWatcher) statusSince(ctx context.Context, order domain.Order) (*time.Time, error) {
+	history, err := w.statusStore.GetHistory(ctx, order.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].Status == order.Status {
+			return history[i].Timestamp, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,156 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/fakeclock"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("LateOrderWatcher", func() {
+	var (
+		mockOrderStore  *mocks.MockOrderStore
+		mockStatusStore *mocks.MockOrderStatusStore
+		mockPublisher   *mocks.MockEventPublisher
+		clock           *fakeclock.Clock
+		watcher         *services.LateOrderWatcher
+	)
+
+	historyFrom := func(entries ...any) []domain.OrderStatusHistory {
+		history := make([]domain.OrderStatusHistory, 0, len(entries)/2)
+
+		for i := 0; i < len(entries); i += 2 {
+			timestamp := entries[i+1].(time.Time)
+			history = append(history, domain.OrderStatusHistory{
+				Status:    entries[i].(domain.OrderStatus),
+				Timestamp: &timestamp,
+			})
+		}
+
+		return history
+	}
+
+	BeforeEach(func() {
+		mockCtrl := gomock.NewController(GinkgoT())
+		mockOrderStore = mocks.NewMockOrderStore(mockCtrl)
+		mockStatusStore = mocks.NewMockOrderStatusStore(mockCtrl)
+		mockPublisher = mocks.NewMockEventPublisher(mockCtrl)
+		clock = fakeclock.New(time.Date(2025, 2, 10, 12, 0, 0, 0, time.UTC))
+
+		policy := domain.SLAPolicy{
+			StatusLimits: map[domain.OrderStatus]time.Duration{
+				domain.OrderStatusPending:   10 * time.Minute,
+				domain.OrderStatusPreparing: 30 * time.Minute,
+			},
+			SourceLimits: map[domain.OrderSource]map[domain.OrderStatus]time.Duration{
+				domain.OrderSourceDelivery: {domain.OrderStatusPreparing: 20 * time.Minute},
+			},
+		}
+
+		watcher = services.NewLateOrderWatcher(mockOrderStore, mockStatusStore, mockPublisher, policy, clock, time.Minute, testLogger)
+	})
+
+	It("should flag and announce orders that exceeded their status limit", func() {
+		now := clock.Now()
+		orders := []domain.Order{
+			{ID: 1, Status: domain.OrderStatusPending, NewOrder: domain.NewOrder{Source: domain.OrderSourcePhone}},
+			{ID: 2, Status: domain.OrderStatusPreparing, NewOrder: domain.NewOrder{Source: domain.OrderSourceDelivery}},
+			{ID: 3, Status: domain.OrderStatusPreparing, NewOrder: domain.NewOrder{Source: domain.OrderSourcePhone}},
+		}
+
+		mockOrderStore.EXPECT().GetAll(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, filters *domain.OrderFilters) ([]domain.Order, error) {
+			Expect(filters.AnyStatus).To(Equal(domain.ActiveStatuses))
+			return orders, nil
+		})
+		mockStatusStore.EXPECT().GetHistory(gomock.Any(), uint(1)).Return(historyFrom(
+			domain.OrderStatusPending, now.Add(-9*time.Minute),
+		), nil)
+		mockStatusStore.EXPECT().GetHistory(gomock.Any(), uint(2)).Return(historyFrom(
+			domain.OrderStatusPending, now.Add(-40*time.Minute),
+			domain.OrderStatusPreparing, now.Add(-25*time.Minute),
+		), nil)
+		mockStatusStore.EXPECT().GetHistory(gomock.Any(), uint(3)).Return(historyFrom(
+			domain.OrderStatusPending, now.Add(-40*time.Minute),
+			domain.OrderStatusPreparing, now.Add(-25*time.Minute),
+		), nil)
+
+		mockOrderStore.EXPECT().MarkLate(gomock.Any(), uint(2), domain.OrderStatusPreparing, now).Return(true, nil)
+		mockPublisher.EXPECT().Publish(gomock.Any()).Do(func(event domain.OrderEvent) {
+			Expect(event.Type).To(Equal(domain.OrderEventLate))
+			Expect(event.Order.ID).To(Equal(uint(2)))
+			Expect(event.Order.Late).To(BeTrue())
+			Expect(event.Timestamp).To(Equal(now))
+		})
+
+		late, err := watcher.CheckOnce(context.Background())
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(late).To(HaveLen(1))
+		Expect(late[0].ID).To(Equal(uint(2)))
+	})
+
+	It("should flag orders once the clock moves past the limit", func() {
+		since := clock.Now()
+		orders := []domain.Order{{ID: 1, Status: domain.OrderStatusPending}}
+
+		mockOrderStore.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(orders, nil).Times(2)
+		mockStatusStore.EXPECT().GetHistory(gomock.Any(), uint(1)).Return(historyFrom(domain.OrderStatusPending, since), nil).Times(2)
+
+		late, err := watcher.CheckOnce(context.Background())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(late).To(BeEmpty())
+
+		now := clock.Advance(11 * time.Minute)
+		mockOrderStore.EXPECT().MarkLate(gomock.Any(), uint(1), domain.OrderStatusPending, now).Return(true, nil)
+		mockPublisher.EXPECT().Publish(gomock.Any())
+
+		late, err = watcher.CheckOnce(context.Background())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(late).To(HaveLen(1))
+	})
+
+	It("should skip orders already flagged or without limits", func() {
+		orders := []domain.Order{
+			{ID: 1, Status: domain.OrderStatusPending, Late: true},
+			{ID: 2, Status: domain.OrderStatusReady},
+		}
+
+		mockOrderStore.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(orders, nil)
+
+		late, err := watcher.CheckOnce(context.Background())
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(late).To(BeEmpty())
+	})
+
+	It("should not announce orders that changed status meanwhile", func() {
+		orders := []domain.Order{{ID: 1, Status: domain.OrderStatusPending}}
+
+		mockOrderStore.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(orders, nil)
+		mockStatusStore.EXPECT().GetHistory(gomock.Any(), uint(1)).Return(historyFrom(
+			domain.OrderStatusPending, clock.Now().Add(-time.Hour),
+		), nil)
+		mockOrderStore.EXPECT().MarkLate(gomock.Any(), uint(1), domain.OrderStatusPending, clock.Now()).Return(false, nil)
+
+		late, err := watcher.CheckOnce(context.Background())
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(late).To(BeEmpty())
+	})
+
+	It("should return an error if the orders can't be loaded", func() {
+		mockOrderStore.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+
+		late, err := watcher.CheckOnce(context.Background())
+
+		Expect(late).To(BeNil())
+		Expect(err).To(HaveOccurred())
+	})
+})
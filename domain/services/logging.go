@@ -0,0 +1,7 @@
+package services
+
+import "log/slog"
+
+func orderIDLogAttr(id uint) slog.Attr {
+	return slog.Uint64("order_id", uint64(id))
+}
@@ -0,0 +1,74 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Logging", func() {
+	var (
+		mockOrderStore    *mocks.MockOrderStore
+		mockStatusStore   *mocks.MockOrderStatusStore
+		mockPriorityQueue *mocks.MockPriorityQueue
+		output            *gbytes.Buffer
+		orderService      services.OrderService
+	)
+
+	BeforeEach(func() {
+		mockCtrl := gomock.NewController(GinkgoT())
+		mockOrderStore = mocks.NewMockOrderStore(mockCtrl)
+		mockPriorityQueue = mocks.NewMockPriorityQueue(mockCtrl)
+		mockStatusStore = mocks.NewMockOrderStatusStore(mockCtrl)
+		output = gbytes.NewBuffer()
+		orderService = services.NewOrderService(
+			mockOrderStore,
+			mockPriorityQueue,
+			mockStatusStore,
+			services.WithLogger(slog.New(slog.NewTextHandler(output, nil))),
+		)
+	})
+
+	createOrder := func(queueErr error) {
+		order := &domain.Order{ID: 1, Status: domain.OrderStatusPending}
+
+		mockOrderStore.EXPECT().Save(gomock.Any(), gomock.Any()).Return(order, nil)
+
+		var wg sync.WaitGroup
+		wg.Add(2)
+		mockStatusStore.EXPECT().AddCurrentStatus(gomock.Any(), order).DoAndReturn(func(_ context.Context, _ *domain.Order) error {
+			wg.Done()
+			return nil
+		})
+		mockPriorityQueue.EXPECT().Add(gomock.Any(), order).DoAndReturn(func(_ context.Context, _ *domain.Order) error {
+			wg.Done()
+			return queueErr
+		})
+
+		_, err := orderService.CreateOrder(context.Background(), domain.NewOrder{Dishes: []domain.Dish{{Name: "Pizza"}}})
+		Expect(err).ToNot(HaveOccurred())
+
+		wg.Wait()
+	}
+
+	It("should log the created orders", func() {
+		createOrder(nil)
+
+		Expect(output).To(gbytes.Say(`level=INFO msg="order created" order_id=1`))
+	})
+
+	It("should log the failures of the background work", func() {
+		createOrder(errors.New("database is locked"))
+
+		Eventually(output).Should(gbytes.Say(`level=ERROR msg="failed to queue the order" order_id=1 error="database is locked"`))
+	})
+})
@@ -0,0 +1,13 @@
+package services
+
+import (
+	"context"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+)
+
+// MenuCatalog holds the allergen data of the dishes the restaurant serves
+type MenuCatalog interface {
+	// FindItem returns nil for dishes missing from the catalog
+	FindItem(ctx context.Context, name string) (*domain.MenuItem, error)
+}
@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: customer_store.go
+//
+// Generated by this command:
+//
+//	mockgen -source=customer_store.go -destination mocks/customer_store_mock.go -package mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/danbrato999/yuno-gveloz/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCustomerStore is a mock of CustomerStore interface.
+type MockCustomerStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomerStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockCustomerStoreMockRecorder is the mock recorder for MockCustomerStore.
+type MockCustomerStoreMockRecorder struct {
+	mock *MockCustomerStore
+}
+
+// NewMockCustomerStore creates a new mock instance.
+func NewMockCustomerStore(ctrl *gomock.Controller) *MockCustomerStore {
+	mock := &MockCustomerStore{ctrl: ctrl}
+	mock.recorder = &MockCustomerStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomerStore) EXPECT() *MockCustomerStoreMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockCustomerStore) Delete(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCustomerStoreMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCustomerStore)(nil).Delete), ctx, id)
+}
+
+// FindByID mocks base method.
+func (m *MockCustomerStore) FindByID(ctx context.Context, id uint) (*domain.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockCustomerStoreMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockCustomerStore)(nil).FindByID), ctx, id)
+}
+
+// FindByPhone mocks base method.
+func (m *MockCustomerStore) FindByPhone(ctx context.Context, phone string) (*domain.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByPhone", ctx, phone)
+	ret0, _ := ret[0].(*domain.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByPhone indicates an expected call of FindByPhone.
+func (mr *MockCustomerStoreMockRecorder) FindByPhone(ctx, phone any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPhone", reflect.TypeOf((*MockCustomerStore)(nil).FindByPhone), ctx, phone)
+}
+
+// GetAll mocks base method.
+func (m *MockCustomerStore) GetAll(ctx context.Context) ([]domain.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]domain.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockCustomerStoreMockRecorder) GetAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockCustomerStore)(nil).GetAll), ctx)
+}
+
+// Save mocks base method.
+func (m *MockCustomerStore) Save(ctx context.Context, customer domain.Customer) (*domain.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, customer)
+	ret0, _ := ret[0].(*domain.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockCustomerStoreMockRecorder) Save(ctx, customer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockCustomerStore)(nil).Save), ctx, customer)
+}
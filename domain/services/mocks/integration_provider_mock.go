@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: integration_provider.go
+//
+// Generated by this command:
+//
+//	mockgen -source=integration_provider.go -destination mocks/integration_provider_mock.go -package mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	http "net/http"
+	reflect "reflect"
+
+	domain "github.com/danbrato999/yuno-gveloz/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIntegrationProvider is a mock of IntegrationProvider interface.
+type MockIntegrationProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockIntegrationProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockIntegrationProviderMockRecorder is the mock recorder for MockIntegrationProvider.
+type MockIntegrationProviderMockRecorder struct {
+	mock *MockIntegrationProvider
+}
+
+// NewMockIntegrationProvider creates a new mock instance.
+func NewMockIntegrationProvider(ctrl *gomock.Controller) *MockIntegrationProvider {
+	mock := &MockIntegrationProvider{ctrl: ctrl}
+	mock.recorder = &MockIntegrationProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIntegrationProvider) EXPECT() *MockIntegrationProviderMockRecorder {
+	return m.recorder
+}
+
+// Name mocks base method.
+func (m *MockIntegrationProvider) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockIntegrationProviderMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockIntegrationProvider)(nil).Name))
+}
+
+// ParseOrder mocks base method.
+func (m *MockIntegrationProvider) ParseOrder(payload []byte) (*domain.NewOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseOrder", payload)
+	ret0, _ := ret[0].(*domain.NewOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseOrder indicates an expected call of ParseOrder.
+func (mr *MockIntegrationProviderMockRecorder) ParseOrder(payload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseOrder", reflect.TypeOf((*MockIntegrationProvider)(nil).ParseOrder), payload)
+}
+
+// StatusCallback mocks base method.
+func (m *MockIntegrationProvider) StatusCallback(ctx context.Context, event domain.OrderEvent) (*http.Request, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StatusCallback", ctx, event)
+	ret0, _ := ret[0].(*http.Request)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StatusCallback indicates an expected call of StatusCallback.
+func (mr *MockIntegrationProviderMockRecorder) StatusCallback(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatusCallback", reflect.TypeOf((*MockIntegrationProvider)(nil).StatusCallback), ctx, event)
+}
+
+// VerifySignature mocks base method.
+func (m *MockIntegrationProvider) VerifySignature(payload []byte, headers http.Header) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifySignature", payload, headers)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifySignature indicates an expected call of VerifySignature.
+func (mr *MockIntegrationProviderMockRecorder) VerifySignature(payload, headers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifySignature", reflect.TypeOf((*MockIntegrationProvider)(nil).VerifySignature), payload, headers)
+}
@@ -0,0 +1,59 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: integration_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=integration_service.go -destination mocks/integration_service_mock.go -package mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	http "net/http"
+	reflect "reflect"
+
+	domain "github.com/danbrato999/yuno-gveloz/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIntegrationService is a mock of IntegrationService interface.
+type MockIntegrationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIntegrationServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockIntegrationServiceMockRecorder is the mock recorder for MockIntegrationService.
+type MockIntegrationServiceMockRecorder struct {
+	mock *MockIntegrationService
+}
+
+// NewMockIntegrationService creates a new mock instance.
+func NewMockIntegrationService(ctrl *gomock.Controller) *MockIntegrationService {
+	mock := &MockIntegrationService{ctrl: ctrl}
+	mock.recorder = &MockIntegrationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIntegrationService) EXPECT() *MockIntegrationServiceMockRecorder {
+	return m.recorder
+}
+
+// ReceiveOrder mocks base method.
+func (m *MockIntegrationService) ReceiveOrder(ctx context.Context, provider string, payload []byte, headers http.Header) (*domain.Order, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReceiveOrder", ctx, provider, payload, headers)
+	ret0, _ := ret[0].(*domain.Order)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ReceiveOrder indicates an expected call of ReceiveOrder.
+func (mr *MockIntegrationServiceMockRecorder) ReceiveOrder(ctx, provider, payload, headers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiveOrder", reflect.TypeOf((*MockIntegrationService)(nil).ReceiveOrder), ctx, provider, payload, headers)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: menu_catalog.go
+//
+// Generated by this command:
+//
+//	mockgen -source=menu_catalog.go -destination mocks/menu_catalog_mock.go -package mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/danbrato999/yuno-gveloz/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMenuCatalog is a mock of MenuCatalog interface.
+type MockMenuCatalog struct {
+	ctrl     *gomock.Controller
+	recorder *MockMenuCatalogMockRecorder
+	isgomock struct{}
+}
+
+// MockMenuCatalogMockRecorder is the mock recorder for MockMenuCatalog.
+type MockMenuCatalogMockRecorder struct {
+	mock *MockMenuCatalog
+}
+
+// NewMockMenuCatalog creates a new mock instance.
+func NewMockMenuCatalog(ctrl *gomock.Controller) *MockMenuCatalog {
+	mock := &MockMenuCatalog{ctrl: ctrl}
+	mock.recorder = &MockMenuCatalogMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMenuCatalog) EXPECT() *MockMenuCatalogMockRecorder {
+	return m.recorder
+}
+
+// FindItem mocks base method.
+func (m *MockMenuCatalog) FindItem(ctx context.Context, name string) (*domain.MenuItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindItem", ctx, name)
+	ret0, _ := ret[0].(*domain.MenuItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindItem indicates an expected call of FindItem.
+func (mr *MockMenuCatalogMockRecorder) FindItem(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindItem", reflect.TypeOf((*MockMenuCatalog)(nil).FindItem), ctx, name)
+}
@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: order_archive_store.go
+//
+// Generated by this command:
+//
+//	mockgen -source=order_archive_store.go -destination mocks/order_archive_store_mock.go -package mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/danbrato999/yuno-gveloz/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOrderArchiveStore is a mock of OrderArchiveStore interface.
+type MockOrderArchiveStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrderArchiveStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockOrderArchiveStoreMockRecorder is the mock recorder for MockOrderArchiveStore.
+type MockOrderArchiveStoreMockRecorder struct {
+	mock *MockOrderArchiveStore
+}
+
+// NewMockOrderArchiveStore creates a new mock instance.
+func NewMockOrderArchiveStore(ctrl *gomock.Controller) *MockOrderArchiveStore {
+	mock := &MockOrderArchiveStore{ctrl: ctrl}
+	mock.recorder = &MockOrderArchiveStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrderArchiveStore) EXPECT() *MockOrderArchiveStoreMockRecorder {
+	return m.recorder
+}
+
+// Archive mocks base method.
+func (m *MockOrderArchiveStore) Archive(ctx context.Context, before time.Time, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Archive", ctx, before, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Archive indicates an expected call of Archive.
+func (mr *MockOrderArchiveStoreMockRecorder) Archive(ctx, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockOrderArchiveStore)(nil).Archive), ctx, before, limit)
+}
+
+// FindByID mocks base method.
+func (m *MockOrderArchiveStore) FindByID(ctx context.Context, id uint) (*domain.OrderWithStatusHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*domain.OrderWithStatusHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockOrderArchiveStoreMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockOrderArchiveStore)(nil).FindByID), ctx, id)
+}
+
+// GetAll mocks base method.
+func (m *MockOrderArchiveStore) GetAll(ctx context.Context, filters *domain.OrderFilters) ([]domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, filters)
+	ret0, _ := ret[0].([]domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockOrderArchiveStoreMockRecorder) GetAll(ctx, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockOrderArchiveStore)(nil).GetAll), ctx, filters)
+}
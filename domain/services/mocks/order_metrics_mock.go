@@ -0,0 +1,89 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: order_metrics.go
+//
+// Generated by this command:
+//
+//	mockgen -source=order_metrics.go -destination mocks/order_metrics_mock.go -package mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	domain "github.com/danbrato999/yuno-gveloz/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOrderMetrics is a mock of OrderMetrics interface.
+type MockOrderMetrics struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrderMetricsMockRecorder
+	isgomock struct{}
+}
+
+// MockOrderMetricsMockRecorder is the mock recorder for MockOrderMetrics.
+type MockOrderMetricsMockRecorder struct {
+	mock *MockOrderMetrics
+}
+
+// NewMockOrderMetrics creates a new mock instance.
+func NewMockOrderMetrics(ctrl *gomock.Controller) *MockOrderMetrics {
+	mock := &MockOrderMetrics{ctrl: ctrl}
+	mock.recorder = &MockOrderMetricsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrderMetrics) EXPECT() *MockOrderMetricsMockRecorder {
+	return m.recorder
+}
+
+// OrderCancelled mocks base method.
+func (m *MockOrderMetrics) OrderCancelled(source domain.OrderSource, reason domain.CancellationReason) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OrderCancelled", source, reason)
+}
+
+// OrderCancelled indicates an expected call of OrderCancelled.
+func (mr *MockOrderMetricsMockRecorder) OrderCancelled(source, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderCancelled", reflect.TypeOf((*MockOrderMetrics)(nil).OrderCancelled), source, reason)
+}
+
+// OrderCreated mocks base method.
+func (m *MockOrderMetrics) OrderCreated(source domain.OrderSource) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OrderCreated", source)
+}
+
+// OrderCreated indicates an expected call of OrderCreated.
+func (mr *MockOrderMetricsMockRecorder) OrderCreated(source any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderCreated", reflect.TypeOf((*MockOrderMetrics)(nil).OrderCreated), source)
+}
+
+// OrderPrioritized mocks base method.
+func (m *MockOrderMetrics) OrderPrioritized() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OrderPrioritized")
+}
+
+// OrderPrioritized indicates an expected call of OrderPrioritized.
+func (mr *MockOrderMetricsMockRecorder) OrderPrioritized() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderPrioritized", reflect.TypeOf((*MockOrderMetrics)(nil).OrderPrioritized))
+}
+
+// StatusChanged mocks base method.
+func (m *MockOrderMetrics) StatusChanged(from, to domain.OrderStatus) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "StatusChanged", from, to)
+}
+
+// StatusChanged indicates an expected call of StatusChanged.
+func (mr *MockOrderMetricsMockRecorder) StatusChanged(from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatusChanged", reflect.TypeOf((*MockOrderMetrics)(nil).StatusChanged), from, to)
+}
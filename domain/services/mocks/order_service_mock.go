@@ -10,7 +10,9 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/danbrato999/yuno-gveloz/domain"
 	gomock "go.uber.org/mock/gomock"
@@ -40,40 +42,125 @@ func (m *MockOrderService) EXPECT() *MockOrderServiceMockRecorder {
 	return m.recorder
 }
 
+// AddDish mocks base method.
+func (m *MockOrderService) AddDish(ctx context.Context, id uint, dish domain.Dish) (*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDish", ctx, id, dish)
+	ret0, _ := ret[0].(*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDish indicates an expected call of AddDish.
+func (mr *MockOrderServiceMockRecorder) AddDish(ctx, id, dish any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDish", reflect.TypeOf((*MockOrderService)(nil).AddDish), ctx, id, dish)
+}
+
+// AssignCourier mocks base method.
+func (m *MockOrderService) AssignCourier(ctx context.Context, id uint, courier domain.Courier) (*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignCourier", ctx, id, courier)
+	ret0, _ := ret[0].(*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignCourier indicates an expected call of AssignCourier.
+func (mr *MockOrderServiceMockRecorder) AssignCourier(ctx, id, courier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignCourier", reflect.TypeOf((*MockOrderService)(nil).AssignCourier), ctx, id, courier)
+}
+
+// Cancel mocks base method.
+func (m *MockOrderService) Cancel(ctx context.Context, id uint, request domain.CancelOrder, role domain.StaffRole) (*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, id, request, role)
+	ret0, _ := ret[0].(*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockOrderServiceMockRecorder) Cancel(ctx, id, request, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockOrderService)(nil).Cancel), ctx, id, request, role)
+}
+
+// CountMany mocks base method.
+func (m *MockOrderService) CountMany(ctx context.Context, filters ...domain.OrderFilterFn) (int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range filters {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CountMany", varargs...)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountMany indicates an expected call of CountMany.
+func (mr *MockOrderServiceMockRecorder) CountMany(ctx any, filters ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, filters...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountMany", reflect.TypeOf((*MockOrderService)(nil).CountMany), varargs...)
+}
+
 // CreateOrder mocks base method.
-func (m *MockOrderService) CreateOrder(request domain.NewOrder) (*domain.Order, error) {
+func (m *MockOrderService) CreateOrder(ctx context.Context, request domain.NewOrder) (*domain.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateOrder", request)
+	ret := m.ctrl.Call(m, "CreateOrder", ctx, request)
 	ret0, _ := ret[0].(*domain.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateOrder indicates an expected call of CreateOrder.
-func (mr *MockOrderServiceMockRecorder) CreateOrder(request any) *gomock.Call {
+func (mr *MockOrderServiceMockRecorder) CreateOrder(ctx, request any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrder", reflect.TypeOf((*MockOrderService)(nil).CreateOrder), request)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrder", reflect.TypeOf((*MockOrderService)(nil).CreateOrder), ctx, request)
 }
 
 // FindByID mocks base method.
-func (m *MockOrderService) FindByID(id uint) (*domain.OrderWithStatusHistory, error) {
+func (m *MockOrderService) FindByID(ctx context.Context, id uint, filters ...domain.OrderFilterFn) (*domain.OrderWithStatusHistory, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByID", id)
+	varargs := []any{ctx, id}
+	for _, a := range filters {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindByID", varargs...)
 	ret0, _ := ret[0].(*domain.OrderWithStatusHistory)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByID indicates an expected call of FindByID.
-func (mr *MockOrderServiceMockRecorder) FindByID(id any) *gomock.Call {
+func (mr *MockOrderServiceMockRecorder) FindByID(ctx, id any, filters ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockOrderService)(nil).FindByID), id)
+	varargs := append([]any{ctx, id}, filters...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockOrderService)(nil).FindByID), varargs...)
+}
+
+// FindDishHistory mocks base method.
+func (m *MockOrderService) FindDishHistory(ctx context.Context, id uint) ([]domain.DishChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDishHistory", ctx, id)
+	ret0, _ := ret[0].([]domain.DishChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDishHistory indicates an expected call of FindDishHistory.
+func (mr *MockOrderServiceMockRecorder) FindDishHistory(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDishHistory", reflect.TypeOf((*MockOrderService)(nil).FindDishHistory), ctx, id)
 }
 
 // FindMany mocks base method.
-func (m *MockOrderService) FindMany(filters ...domain.OrderFilterFn) ([]domain.Order, error) {
+func (m *MockOrderService) FindMany(ctx context.Context, filters ...domain.OrderFilterFn) ([]domain.Order, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{}
+	varargs := []any{ctx}
 	for _, a := range filters {
 		varargs = append(varargs, a)
 	}
@@ -84,51 +171,127 @@ func (m *MockOrderService) FindMany(filters ...domain.OrderFilterFn) ([]domain.O
 }
 
 // FindMany indicates an expected call of FindMany.
-func (mr *MockOrderServiceMockRecorder) FindMany(filters ...any) *gomock.Call {
+func (mr *MockOrderServiceMockRecorder) FindMany(ctx any, filters ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, filters...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMany", reflect.TypeOf((*MockOrderService)(nil).FindMany), varargs...)
+}
+
+// FindWaste mocks base method.
+func (m *MockOrderService) FindWaste(ctx context.Context, from, to time.Time) ([]domain.WasteRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWaste", ctx, from, to)
+	ret0, _ := ret[0].([]domain.WasteRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindWaste indicates an expected call of FindWaste.
+func (mr *MockOrderServiceMockRecorder) FindWaste(ctx, from, to any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMany", reflect.TypeOf((*MockOrderService)(nil).FindMany), filters...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWaste", reflect.TypeOf((*MockOrderService)(nil).FindWaste), ctx, from, to)
+}
+
+// MarkDishReady mocks base method.
+func (m *MockOrderService) MarkDishReady(ctx context.Context, id, dishID uint) (*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDishReady", ctx, id, dishID)
+	ret0, _ := ret[0].(*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkDishReady indicates an expected call of MarkDishReady.
+func (mr *MockOrderServiceMockRecorder) MarkDishReady(ctx, id, dishID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDishReady", reflect.TypeOf((*MockOrderService)(nil).MarkDishReady), ctx, id, dishID)
 }
 
 // Prioritize mocks base method.
-func (m *MockOrderService) Prioritize(id, afterID uint) error {
+func (m *MockOrderService) Prioritize(ctx context.Context, id, afterID uint) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Prioritize", id, afterID)
+	ret := m.ctrl.Call(m, "Prioritize", ctx, id, afterID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Prioritize indicates an expected call of Prioritize.
-func (mr *MockOrderServiceMockRecorder) Prioritize(id, afterID any) *gomock.Call {
+func (mr *MockOrderServiceMockRecorder) Prioritize(ctx, id, afterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prioritize", reflect.TypeOf((*MockOrderService)(nil).Prioritize), ctx, id, afterID)
+}
+
+// ReleaseDueOrders mocks base method.
+func (m *MockOrderService) ReleaseDueOrders(ctx context.Context) ([]domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseDueOrders", ctx)
+	ret0, _ := ret[0].([]domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseDueOrders indicates an expected call of ReleaseDueOrders.
+func (mr *MockOrderServiceMockRecorder) ReleaseDueOrders(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prioritize", reflect.TypeOf((*MockOrderService)(nil).Prioritize), id, afterID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDueOrders", reflect.TypeOf((*MockOrderService)(nil).ReleaseDueOrders), ctx)
+}
+
+// Reschedule mocks base method.
+func (m *MockOrderService) Reschedule(ctx context.Context, id uint, readyAt time.Time) (*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reschedule", ctx, id, readyAt)
+	ret0, _ := ret[0].(*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reschedule indicates an expected call of Reschedule.
+func (mr *MockOrderServiceMockRecorder) Reschedule(ctx, id, readyAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reschedule", reflect.TypeOf((*MockOrderService)(nil).Reschedule), ctx, id, readyAt)
 }
 
 // UpdateDishes mocks base method.
-func (m *MockOrderService) UpdateDishes(id uint, dishes []domain.Dish) (*domain.Order, error) {
+func (m *MockOrderService) UpdateDishes(ctx context.Context, id uint, dishes []domain.Dish) (*domain.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateDishes", id, dishes)
+	ret := m.ctrl.Call(m, "UpdateDishes", ctx, id, dishes)
 	ret0, _ := ret[0].(*domain.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateDishes indicates an expected call of UpdateDishes.
-func (mr *MockOrderServiceMockRecorder) UpdateDishes(id, dishes any) *gomock.Call {
+func (mr *MockOrderServiceMockRecorder) UpdateDishes(ctx, id, dishes any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDishes", reflect.TypeOf((*MockOrderService)(nil).UpdateDishes), id, dishes)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDishes", reflect.TypeOf((*MockOrderService)(nil).UpdateDishes), ctx, id, dishes)
 }
 
 // UpdateStatus mocks base method.
-func (m *MockOrderService) UpdateStatus(id uint, status domain.OrderStatus) (*domain.Order, error) {
+func (m *MockOrderService) UpdateStatus(ctx context.Context, id uint, status domain.OrderStatus) (*domain.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateStatus", id, status)
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, id, status)
 	ret0, _ := ret[0].(*domain.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateStatus indicates an expected call of UpdateStatus.
-func (mr *MockOrderServiceMockRecorder) UpdateStatus(id, status any) *gomock.Call {
+func (mr *MockOrderServiceMockRecorder) UpdateStatus(ctx, id, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockOrderService)(nil).UpdateStatus), ctx, id, status)
+}
+
+// VoidDish mocks base method.
+func (m *MockOrderService) VoidDish(ctx context.Context, id, dishID uint, request domain.VoidDish) (*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoidDish", ctx, id, dishID, request)
+	ret0, _ := ret[0].(*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VoidDish indicates an expected call of VoidDish.
+func (mr *MockOrderServiceMockRecorder) VoidDish(ctx, id, dishID, request any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockOrderService)(nil).UpdateStatus), id, status)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoidDish", reflect.TypeOf((*MockOrderService)(nil).VoidDish), ctx, id, dishID, request)
 }
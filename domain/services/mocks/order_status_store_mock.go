@@ -10,6 +10,7 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	domain "github.com/danbrato999/yuno-gveloz/domain"
@@ -41,30 +42,59 @@ func (m *MockOrderStatusStore) EXPECT() *MockOrderStatusStoreMockRecorder {
 }
 
 // AddCurrentStatus mocks base method.
-func (m *MockOrderStatusStore) AddCurrentStatus(order *domain.Order) error {
+func (m *MockOrderStatusStore) AddCurrentStatus(ctx context.Context, order *domain.Order) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddCurrentStatus", order)
+	ret := m.ctrl.Call(m, "AddCurrentStatus", ctx, order)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // AddCurrentStatus indicates an expected call of AddCurrentStatus.
-func (mr *MockOrderStatusStoreMockRecorder) AddCurrentStatus(order any) *gomock.Call {
+func (mr *MockOrderStatusStoreMockRecorder) AddCurrentStatus(ctx, order any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCurrentStatus", reflect.TypeOf((*MockOrderStatusStore)(nil).AddCurrentStatus), order)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCurrentStatus", reflect.TypeOf((*MockOrderStatusStore)(nil).AddCurrentStatus), ctx, order)
+}
+
+// AddHistory mocks base method.
+func (m *MockOrderStatusStore) AddHistory(ctx context.Context, id uint, history []domain.OrderStatusHistory) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddHistory", ctx, id, history)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddHistory indicates an expected call of AddHistory.
+func (mr *MockOrderStatusStoreMockRecorder) AddHistory(ctx, id, history any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddHistory", reflect.TypeOf((*MockOrderStatusStore)(nil).AddHistory), ctx, id, history)
+}
+
+// GetHistories mocks base method.
+func (m *MockOrderStatusStore) GetHistories(ctx context.Context, ids []uint) (map[uint][]domain.OrderStatusHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistories", ctx, ids)
+	ret0, _ := ret[0].(map[uint][]domain.OrderStatusHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistories indicates an expected call of GetHistories.
+func (mr *MockOrderStatusStoreMockRecorder) GetHistories(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistories", reflect.TypeOf((*MockOrderStatusStore)(nil).GetHistories), ctx, ids)
 }
 
 // GetHistory mocks base method.
-func (m *MockOrderStatusStore) GetHistory(id uint) ([]domain.OrderStatusHistory, error) {
+func (m *MockOrderStatusStore) GetHistory(ctx context.Context, id uint) ([]domain.OrderStatusHistory, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetHistory", id)
+	ret := m.ctrl.Call(m, "GetHistory", ctx, id)
 	ret0, _ := ret[0].([]domain.OrderStatusHistory)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetHistory indicates an expected call of GetHistory.
-func (mr *MockOrderStatusStoreMockRecorder) GetHistory(id any) *gomock.Call {
+func (mr *MockOrderStatusStoreMockRecorder) GetHistory(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistory", reflect.TypeOf((*MockOrderStatusStore)(nil).GetHistory), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistory", reflect.TypeOf((*MockOrderStatusStore)(nil).GetHistory), ctx, id)
 }
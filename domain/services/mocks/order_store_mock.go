@@ -10,7 +10,9 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/danbrato999/yuno-gveloz/domain"
 	gomock "go.uber.org/mock/gomock"
@@ -40,47 +42,181 @@ func (m *MockOrderStore) EXPECT() *MockOrderStoreMockRecorder {
 	return m.recorder
 }
 
+// Cancel mocks base method.
+func (m *MockOrderStore) Cancel(ctx context.Context, order domain.Order) (*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, order)
+	ret0, _ := ret[0].(*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockOrderStoreMockRecorder) Cancel(ctx, order any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockOrderStore)(nil).Cancel), ctx, order)
+}
+
+// ChangeDish mocks base method.
+func (m *MockOrderStore) ChangeDish(ctx context.Context, orderID uint, change domain.DishChange) (*domain.DishChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeDish", ctx, orderID, change)
+	ret0, _ := ret[0].(*domain.DishChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeDish indicates an expected call of ChangeDish.
+func (mr *MockOrderStoreMockRecorder) ChangeDish(ctx, orderID, change any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeDish", reflect.TypeOf((*MockOrderStore)(nil).ChangeDish), ctx, orderID, change)
+}
+
+// Count mocks base method.
+func (m *MockOrderStore) Count(ctx context.Context, filters *domain.OrderFilters) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, filters)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockOrderStoreMockRecorder) Count(ctx, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockOrderStore)(nil).Count), ctx, filters)
+}
+
+// FindByExternalID mocks base method.
+func (m *MockOrderStore) FindByExternalID(ctx context.Context, provider, externalID string) (*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByExternalID", ctx, provider, externalID)
+	ret0, _ := ret[0].(*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByExternalID indicates an expected call of FindByExternalID.
+func (mr *MockOrderStoreMockRecorder) FindByExternalID(ctx, provider, externalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByExternalID", reflect.TypeOf((*MockOrderStore)(nil).FindByExternalID), ctx, provider, externalID)
+}
+
 // FindByID mocks base method.
-func (m *MockOrderStore) FindByID(id uint) (*domain.Order, error) {
+func (m *MockOrderStore) FindByID(ctx context.Context, id uint) (*domain.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByID", id)
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
 	ret0, _ := ret[0].(*domain.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByID indicates an expected call of FindByID.
-func (mr *MockOrderStoreMockRecorder) FindByID(id any) *gomock.Call {
+func (mr *MockOrderStoreMockRecorder) FindByID(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockOrderStore)(nil).FindByID), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockOrderStore)(nil).FindByID), ctx, id)
 }
 
 // GetAll mocks base method.
-func (m *MockOrderStore) GetAll(filters *domain.OrderFilters) ([]domain.Order, error) {
+func (m *MockOrderStore) GetAll(ctx context.Context, filters *domain.OrderFilters) ([]domain.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", filters)
+	ret := m.ctrl.Call(m, "GetAll", ctx, filters)
 	ret0, _ := ret[0].([]domain.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockOrderStoreMockRecorder) GetAll(filters any) *gomock.Call {
+func (mr *MockOrderStoreMockRecorder) GetAll(ctx, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockOrderStore)(nil).GetAll), ctx, filters)
+}
+
+// GetDishHistory mocks base method.
+func (m *MockOrderStore) GetDishHistory(ctx context.Context, orderID uint) ([]domain.DishChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDishHistory", ctx, orderID)
+	ret0, _ := ret[0].([]domain.DishChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDishHistory indicates an expected call of GetDishHistory.
+func (mr *MockOrderStoreMockRecorder) GetDishHistory(ctx, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDishHistory", reflect.TypeOf((*MockOrderStore)(nil).GetDishHistory), ctx, orderID)
+}
+
+// GetWaste mocks base method.
+func (m *MockOrderStore) GetWaste(ctx context.Context, from, to time.Time) ([]domain.WasteRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWaste", ctx, from, to)
+	ret0, _ := ret[0].([]domain.WasteRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWaste indicates an expected call of GetWaste.
+func (mr *MockOrderStoreMockRecorder) GetWaste(ctx, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWaste", reflect.TypeOf((*MockOrderStore)(nil).GetWaste), ctx, from, to)
+}
+
+// Iterate mocks base method.
+func (m *MockOrderStore) Iterate(ctx context.Context, filters *domain.OrderFilters, batchSize int, fn func([]domain.Order) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Iterate", ctx, filters, batchSize, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Iterate indicates an expected call of Iterate.
+func (mr *MockOrderStoreMockRecorder) Iterate(ctx, filters, batchSize, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Iterate", reflect.TypeOf((*MockOrderStore)(nil).Iterate), ctx, filters, batchSize, fn)
+}
+
+// MarkLate mocks base method.
+func (m *MockOrderStore) MarkLate(ctx context.Context, id uint, status domain.OrderStatus, at time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkLate", ctx, id, status, at)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkLate indicates an expected call of MarkLate.
+func (mr *MockOrderStoreMockRecorder) MarkLate(ctx, id, status, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkLate", reflect.TypeOf((*MockOrderStore)(nil).MarkLate), ctx, id, status, at)
+}
+
+// ReplaceDishes mocks base method.
+func (m *MockOrderStore) ReplaceDishes(ctx context.Context, order domain.Order) (*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceDishes", ctx, order)
+	ret0, _ := ret[0].(*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceDishes indicates an expected call of ReplaceDishes.
+func (mr *MockOrderStoreMockRecorder) ReplaceDishes(ctx, order any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockOrderStore)(nil).GetAll), filters)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceDishes", reflect.TypeOf((*MockOrderStore)(nil).ReplaceDishes), ctx, order)
 }
 
 // Save mocks base method.
-func (m *MockOrderStore) Save(order domain.Order) (*domain.Order, error) {
+func (m *MockOrderStore) Save(ctx context.Context, order domain.Order) (*domain.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Save", order)
+	ret := m.ctrl.Call(m, "Save", ctx, order)
 	ret0, _ := ret[0].(*domain.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Save indicates an expected call of Save.
-func (mr *MockOrderStoreMockRecorder) Save(order any) *gomock.Call {
+func (mr *MockOrderStoreMockRecorder) Save(ctx, order any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockOrderStore)(nil).Save), order)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockOrderStore)(nil).Save), ctx, order)
 }
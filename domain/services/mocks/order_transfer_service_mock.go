@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: order_transfer_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=order_transfer_service.go -destination mocks/order_transfer_service_mock.go -package mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/danbrato999/yuno-gveloz/domain"
+	services "github.com/danbrato999/yuno-gveloz/domain/services"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOrderImportReader is a mock of OrderImportReader interface.
+type MockOrderImportReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrderImportReaderMockRecorder
+	isgomock struct{}
+}
+
+// MockOrderImportReaderMockRecorder is the mock recorder for MockOrderImportReader.
+type MockOrderImportReaderMockRecorder struct {
+	mock *MockOrderImportReader
+}
+
+// NewMockOrderImportReader creates a new mock instance.
+func NewMockOrderImportReader(ctrl *gomock.Controller) *MockOrderImportReader {
+	mock := &MockOrderImportReader{ctrl: ctrl}
+	mock.recorder = &MockOrderImportReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrderImportReader) EXPECT() *MockOrderImportReaderMockRecorder {
+	return m.recorder
+}
+
+// Next mocks base method.
+func (m *MockOrderImportReader) Next() (domain.ImportRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Next")
+	ret0, _ := ret[0].(domain.ImportRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Next indicates an expected call of Next.
+func (mr *MockOrderImportReaderMockRecorder) Next() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Next", reflect.TypeOf((*MockOrderImportReader)(nil).Next))
+}
+
+// MockOrderTransferService is a mock of OrderTransferService interface.
+type MockOrderTransferService struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrderTransferServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockOrderTransferServiceMockRecorder is the mock recorder for MockOrderTransferService.
+type MockOrderTransferServiceMockRecorder struct {
+	mock *MockOrderTransferService
+}
+
+// NewMockOrderTransferService creates a new mock instance.
+func NewMockOrderTransferService(ctrl *gomock.Controller) *MockOrderTransferService {
+	mock := &MockOrderTransferService{ctrl: ctrl}
+	mock.recorder = &MockOrderTransferServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrderTransferService) EXPECT() *MockOrderTransferServiceMockRecorder {
+	return m.recorder
+}
+
+// Export mocks base method.
+func (m *MockOrderTransferService) Export(ctx context.Context, fn func([]domain.OrderWithStatusHistory) error, filters ...domain.OrderFilterFn) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, fn}
+	for _, a := range filters {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Export", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockOrderTransferServiceMockRecorder) Export(ctx, fn any, filters ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, fn}, filters...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockOrderTransferService)(nil).Export), varargs...)
+}
+
+// Import mocks base method.
+func (m *MockOrderTransferService) Import(ctx context.Context, reader services.OrderImportReader, dryRun bool) (*domain.ImportReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, reader, dryRun)
+	ret0, _ := ret[0].(*domain.ImportReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockOrderTransferServiceMockRecorder) Import(ctx, reader, dryRun any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockOrderTransferService)(nil).Import), ctx, reader, dryRun)
+}
@@ -10,6 +10,7 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	domain "github.com/danbrato999/yuno-gveloz/domain"
@@ -41,43 +42,88 @@ func (m *MockPriorityQueue) EXPECT() *MockPriorityQueueMockRecorder {
 }
 
 // Add mocks base method.
-func (m *MockPriorityQueue) Add(order *domain.Order) error {
+func (m *MockPriorityQueue) Add(ctx context.Context, order *domain.Order) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Add", order)
+	ret := m.ctrl.Call(m, "Add", ctx, order)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Add indicates an expected call of Add.
-func (mr *MockPriorityQueueMockRecorder) Add(order any) *gomock.Call {
+func (mr *MockPriorityQueueMockRecorder) Add(ctx, order any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockPriorityQueue)(nil).Add), order)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockPriorityQueue)(nil).Add), ctx, order)
+}
+
+// GetPosition mocks base method.
+func (m *MockPriorityQueue) GetPosition(ctx context.Context, id uint) (*domain.QueuePosition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPosition", ctx, id)
+	ret0, _ := ret[0].(*domain.QueuePosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPosition indicates an expected call of GetPosition.
+func (mr *MockPriorityQueueMockRecorder) GetPosition(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPosition", reflect.TypeOf((*MockPriorityQueue)(nil).GetPosition), ctx, id)
+}
+
+// GetPositions mocks base method.
+func (m *MockPriorityQueue) GetPositions(ctx context.Context) ([]domain.QueuePosition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPositions", ctx)
+	ret0, _ := ret[0].([]domain.QueuePosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPositions indicates an expected call of GetPositions.
+func (mr *MockPriorityQueueMockRecorder) GetPositions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPositions", reflect.TypeOf((*MockPriorityQueue)(nil).GetPositions), ctx)
+}
+
+// Length mocks base method.
+func (m *MockPriorityQueue) Length(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Length", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Length indicates an expected call of Length.
+func (mr *MockPriorityQueueMockRecorder) Length(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Length", reflect.TypeOf((*MockPriorityQueue)(nil).Length), ctx)
 }
 
 // Remove mocks base method.
-func (m *MockPriorityQueue) Remove(id uint) error {
+func (m *MockPriorityQueue) Remove(ctx context.Context, id uint) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Remove", id)
+	ret := m.ctrl.Call(m, "Remove", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Remove indicates an expected call of Remove.
-func (mr *MockPriorityQueueMockRecorder) Remove(id any) *gomock.Call {
+func (mr *MockPriorityQueueMockRecorder) Remove(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockPriorityQueue)(nil).Remove), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockPriorityQueue)(nil).Remove), ctx, id)
 }
 
 // ShuffleAfter mocks base method.
-func (m *MockPriorityQueue) ShuffleAfter(id, targetID uint) error {
+func (m *MockPriorityQueue) ShuffleAfter(ctx context.Context, id, targetID uint) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ShuffleAfter", id, targetID)
+	ret := m.ctrl.Call(m, "ShuffleAfter", ctx, id, targetID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ShuffleAfter indicates an expected call of ShuffleAfter.
-func (mr *MockPriorityQueueMockRecorder) ShuffleAfter(id, targetID any) *gomock.Call {
+func (mr *MockPriorityQueueMockRecorder) ShuffleAfter(ctx, id, targetID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShuffleAfter", reflect.TypeOf((*MockPriorityQueue)(nil).ShuffleAfter), id, targetID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShuffleAfter", reflect.TypeOf((*MockPriorityQueue)(nil).ShuffleAfter), ctx, id, targetID)
 }
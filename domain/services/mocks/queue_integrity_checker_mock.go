@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: queue_integrity_checker.go
+//
+// Generated by this command:
+//
+//	mockgen -source=queue_integrity_checker.go -destination mocks/queue_integrity_checker_mock.go -package mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/danbrato999/yuno-gveloz/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockQueueIntegrityChecker is a mock of QueueIntegrityChecker interface.
+type MockQueueIntegrityChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockQueueIntegrityCheckerMockRecorder
+	isgomock struct{}
+}
+
+// MockQueueIntegrityCheckerMockRecorder is the mock recorder for MockQueueIntegrityChecker.
+type MockQueueIntegrityCheckerMockRecorder struct {
+	mock *MockQueueIntegrityChecker
+}
+
+// NewMockQueueIntegrityChecker creates a new mock instance.
+func NewMockQueueIntegrityChecker(ctrl *gomock.Controller) *MockQueueIntegrityChecker {
+	mock := &MockQueueIntegrityChecker{ctrl: ctrl}
+	mock.recorder = &MockQueueIntegrityCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQueueIntegrityChecker) EXPECT() *MockQueueIntegrityCheckerMockRecorder {
+	return m.recorder
+}
+
+// Repair mocks base method.
+func (m *MockQueueIntegrityChecker) Repair(ctx context.Context) (*domain.QueueIntegrityReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Repair", ctx)
+	ret0, _ := ret[0].(*domain.QueueIntegrityReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Repair indicates an expected call of Repair.
+func (mr *MockQueueIntegrityCheckerMockRecorder) Repair(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Repair", reflect.TypeOf((*MockQueueIntegrityChecker)(nil).Repair), ctx)
+}
+
+// Verify mocks base method.
+func (m *MockQueueIntegrityChecker) Verify(ctx context.Context) (*domain.QueueIntegrityReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", ctx)
+	ret0, _ := ret[0].(*domain.QueueIntegrityReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockQueueIntegrityCheckerMockRecorder) Verify(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockQueueIntegrityChecker)(nil).Verify), ctx)
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: queue_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=queue_service.go -destination mocks/queue_service_mock.go -package mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/danbrato999/yuno-gveloz/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockQueueService is a mock of QueueService interface.
+type MockQueueService struct {
+	ctrl     *gomock.Controller
+	recorder *MockQueueServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockQueueServiceMockRecorder is the mock recorder for MockQueueService.
+type MockQueueServiceMockRecorder struct {
+	mock *MockQueueService
+}
+
+// NewMockQueueService creates a new mock instance.
+func NewMockQueueService(ctrl *gomock.Controller) *MockQueueService {
+	mock := &MockQueueService{ctrl: ctrl}
+	mock.recorder = &MockQueueServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQueueService) EXPECT() *MockQueueServiceMockRecorder {
+	return m.recorder
+}
+
+// GetOrderPosition mocks base method.
+func (m *MockQueueService) GetOrderPosition(ctx context.Context, id uint) (*domain.QueuedOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderPosition", ctx, id)
+	ret0, _ := ret[0].(*domain.QueuedOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderPosition indicates an expected call of GetOrderPosition.
+func (mr *MockQueueServiceMockRecorder) GetOrderPosition(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderPosition", reflect.TypeOf((*MockQueueService)(nil).GetOrderPosition), ctx, id)
+}
+
+// GetQueue mocks base method.
+func (m *MockQueueService) GetQueue(ctx context.Context) ([]domain.QueuedOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueue", ctx)
+	ret0, _ := ret[0].([]domain.QueuedOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueue indicates an expected call of GetQueue.
+func (mr *MockQueueServiceMockRecorder) GetQueue(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueue", reflect.TypeOf((*MockQueueService)(nil).GetQueue), ctx)
+}
@@ -0,0 +1,147 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: table_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=table_service.go -destination mocks/table_service_mock.go -package mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/danbrato999/yuno-gveloz/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTableService is a mock of TableService interface.
+type MockTableService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTableServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockTableServiceMockRecorder is the mock recorder for MockTableService.
+type MockTableServiceMockRecorder struct {
+	mock *MockTableService
+}
+
+// NewMockTableService creates a new mock instance.
+func NewMockTableService(ctrl *gomock.Controller) *MockTableService {
+	mock := &MockTableService{ctrl: ctrl}
+	mock.recorder = &MockTableServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTableService) EXPECT() *MockTableServiceMockRecorder {
+	return m.recorder
+}
+
+// CloseSession mocks base method.
+func (m *MockTableService) CloseSession(ctx context.Context, tableID uint) (*domain.TableSessionView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSession", ctx, tableID)
+	ret0, _ := ret[0].(*domain.TableSessionView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseSession indicates an expected call of CloseSession.
+func (mr *MockTableServiceMockRecorder) CloseSession(ctx, tableID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSession", reflect.TypeOf((*MockTableService)(nil).CloseSession), ctx, tableID)
+}
+
+// CreateTable mocks base method.
+func (m *MockTableService) CreateTable(ctx context.Context, request domain.NewTable) (*domain.Table, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTable", ctx, request)
+	ret0, _ := ret[0].(*domain.Table)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTable indicates an expected call of CreateTable.
+func (mr *MockTableServiceMockRecorder) CreateTable(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTable", reflect.TypeOf((*MockTableService)(nil).CreateTable), ctx, request)
+}
+
+// FindCurrentSession mocks base method.
+func (m *MockTableService) FindCurrentSession(ctx context.Context, tableID uint) (*domain.TableSessionView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCurrentSession", ctx, tableID)
+	ret0, _ := ret[0].(*domain.TableSessionView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCurrentSession indicates an expected call of FindCurrentSession.
+func (mr *MockTableServiceMockRecorder) FindCurrentSession(ctx, tableID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCurrentSession", reflect.TypeOf((*MockTableService)(nil).FindCurrentSession), ctx, tableID)
+}
+
+// FindMany mocks base method.
+func (m *MockTableService) FindMany(ctx context.Context) ([]domain.Table, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMany", ctx)
+	ret0, _ := ret[0].([]domain.Table)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMany indicates an expected call of FindMany.
+func (mr *MockTableServiceMockRecorder) FindMany(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMany", reflect.TypeOf((*MockTableService)(nil).FindMany), ctx)
+}
+
+// FindSession mocks base method.
+func (m *MockTableService) FindSession(ctx context.Context, id uint) (*domain.TableSessionView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSession", ctx, id)
+	ret0, _ := ret[0].(*domain.TableSessionView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSession indicates an expected call of FindSession.
+func (mr *MockTableServiceMockRecorder) FindSession(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSession", reflect.TypeOf((*MockTableService)(nil).FindSession), ctx, id)
+}
+
+// GetFloorPlan mocks base method.
+func (m *MockTableService) GetFloorPlan(ctx context.Context) ([]domain.FloorArea, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFloorPlan", ctx)
+	ret0, _ := ret[0].([]domain.FloorArea)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFloorPlan indicates an expected call of GetFloorPlan.
+func (mr *MockTableServiceMockRecorder) GetFloorPlan(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFloorPlan", reflect.TypeOf((*MockTableService)(nil).GetFloorPlan), ctx)
+}
+
+// SeatTable mocks base method.
+func (m *MockTableService) SeatTable(ctx context.Context, tableID uint, request domain.SeatTable) (*domain.TableSessionView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeatTable", ctx, tableID, request)
+	ret0, _ := ret[0].(*domain.TableSessionView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeatTable indicates an expected call of SeatTable.
+func (mr *MockTableServiceMockRecorder) SeatTable(ctx, tableID, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeatTable", reflect.TypeOf((*MockTableService)(nil).SeatTable), ctx, tableID, request)
+}
@@ -0,0 +1,178 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: table_store.go
+//
+// Generated by this command:
+//
+//	mockgen -source=table_store.go -destination mocks/table_store_mock.go -package mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/danbrato999/yuno-gveloz/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTableStore is a mock of TableStore interface.
+type MockTableStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockTableStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockTableStoreMockRecorder is the mock recorder for MockTableStore.
+type MockTableStoreMockRecorder struct {
+	mock *MockTableStore
+}
+
+// NewMockTableStore creates a new mock instance.
+func NewMockTableStore(ctrl *gomock.Controller) *MockTableStore {
+	mock := &MockTableStore{ctrl: ctrl}
+	mock.recorder = &MockTableStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTableStore) EXPECT() *MockTableStoreMockRecorder {
+	return m.recorder
+}
+
+// CloseSession mocks base method.
+func (m *MockTableStore) CloseSession(ctx context.Context, id uint, closedAt time.Time) (*domain.TableSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSession", ctx, id, closedAt)
+	ret0, _ := ret[0].(*domain.TableSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseSession indicates an expected call of CloseSession.
+func (mr *MockTableStoreMockRecorder) CloseSession(ctx, id, closedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSession", reflect.TypeOf((*MockTableStore)(nil).CloseSession), ctx, id, closedAt)
+}
+
+// FindByID mocks base method.
+func (m *MockTableStore) FindByID(ctx context.Context, id uint) (*domain.Table, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Table)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockTableStoreMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockTableStore)(nil).FindByID), ctx, id)
+}
+
+// FindByName mocks base method.
+func (m *MockTableStore) FindByName(ctx context.Context, name string) (*domain.Table, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByName", ctx, name)
+	ret0, _ := ret[0].(*domain.Table)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByName indicates an expected call of FindByName.
+func (mr *MockTableStoreMockRecorder) FindByName(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByName", reflect.TypeOf((*MockTableStore)(nil).FindByName), ctx, name)
+}
+
+// FindOpenSession mocks base method.
+func (m *MockTableStore) FindOpenSession(ctx context.Context, tableID uint) (*domain.TableSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOpenSession", ctx, tableID)
+	ret0, _ := ret[0].(*domain.TableSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOpenSession indicates an expected call of FindOpenSession.
+func (mr *MockTableStoreMockRecorder) FindOpenSession(ctx, tableID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOpenSession", reflect.TypeOf((*MockTableStore)(nil).FindOpenSession), ctx, tableID)
+}
+
+// FindSession mocks base method.
+func (m *MockTableStore) FindSession(ctx context.Context, id uint) (*domain.TableSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSession", ctx, id)
+	ret0, _ := ret[0].(*domain.TableSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSession indicates an expected call of FindSession.
+func (mr *MockTableStoreMockRecorder) FindSession(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSession", reflect.TypeOf((*MockTableStore)(nil).FindSession), ctx, id)
+}
+
+// GetAll mocks base method.
+func (m *MockTableStore) GetAll(ctx context.Context) ([]domain.Table, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]domain.Table)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockTableStoreMockRecorder) GetAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockTableStore)(nil).GetAll), ctx)
+}
+
+// GetOpenSessions mocks base method.
+func (m *MockTableStore) GetOpenSessions(ctx context.Context) ([]domain.TableSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenSessions", ctx)
+	ret0, _ := ret[0].([]domain.TableSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenSessions indicates an expected call of GetOpenSessions.
+func (mr *MockTableStoreMockRecorder) GetOpenSessions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenSessions", reflect.TypeOf((*MockTableStore)(nil).GetOpenSessions), ctx)
+}
+
+// OpenSession mocks base method.
+func (m *MockTableStore) OpenSession(ctx context.Context, session domain.TableSession) (*domain.TableSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenSession", ctx, session)
+	ret0, _ := ret[0].(*domain.TableSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenSession indicates an expected call of OpenSession.
+func (mr *MockTableStoreMockRecorder) OpenSession(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenSession", reflect.TypeOf((*MockTableStore)(nil).OpenSession), ctx, session)
+}
+
+// Save mocks base method.
+func (m *MockTableStore) Save(ctx context.Context, table domain.Table) (*domain.Table, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, table)
+	ret0, _ := ret[0].(*domain.Table)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockTableStoreMockRecorder) Save(ctx, table any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockTableStore)(nil).Save), ctx, table)
+}
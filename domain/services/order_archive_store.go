@@ -0,0 +1,16 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+)
+
+type OrderArchiveStore interface {
+	// Archive moves up to limit orders completed before the given time out of the order store,
+	// returning how many were moved
+	Archive(ctx context.Context, before time.Time, limit int) (int, error)
+	FindByID(ctx context.Context, id uint) (*domain.OrderWithStatusHistory, error)
+	GetAll(ctx context.Context, filters *domain.OrderFilters) ([]domain.Order, error)
+}
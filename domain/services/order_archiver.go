@@ -0,0 +1,87 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const DefaultArchiverInterval = time.Hour
+
+// OrderArchiver periodically moves the old completed orders into the archive
+type OrderArchiver struct {
+	store    OrderArchiveStore
+	policy   domain.RetentionPolicy
+	clock    domain.Clock
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+func NewOrderArchiver(
+	store OrderArchiveStore,
+	policy domain.RetentionPolicy,
+	clock domain.Clock,
+	interval time.Duration,
+	logger *slog.Logger,
+) *OrderArchiver {
+	return &OrderArchiver{
+		store:    store,
+		policy:   policy,
+		clock:    clock,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run blocks until the context is cancelled
+func (a *OrderArchiver) Run(ctx context.Context) {
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			a.RunOnce(ctx)
+		}
+	}
+}
+
+func (a *OrderArchiver) RunOnce(ctx context.Context) {
+	archived, err := a.ArchiveDue(ctx)
+
+	if err != nil {
+		a.logger.ErrorContext(ctx, "failed to archive completed orders", slog.Any("error", err))
+	}
+
+	if archived > 0 {
+		a.logger.InfoContext(ctx, "archived completed orders", slog.Int("orders", archived))
+	}
+}
+
+// ArchiveDue archives the due orders one batch at a time, until a batch comes out short or
+// the context is cancelled
+func (a *OrderArchiver) ArchiveDue(ctx context.Context) (total int, err error) {
+	ctx, span := tracer.Start(ctx, "OrderArchiver.ArchiveDue")
+	defer func() {
+		span.SetAttributes(attribute.Int("orders.archived", total))
+		endSpan(span, err)
+	}()
+
+	before := a.policy.ArchiveBefore(a.clock.Now())
+
+	for ctx.Err() == nil {
+		archived, err := a.store.Archive(ctx, before, a.policy.BatchSize)
+		total += archived
+
+		if err != nil || archived < a.policy.BatchSize {
+			return total, err
+		}
+	}
+
+	return total, nil
+}
@@ -0,0 +1,74 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/fakeclock"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("OrderArchiver", func() {
+	var (
+		mockArchiveStore *mocks.MockOrderArchiveStore
+		archiver         *services.OrderArchiver
+		now              time.Time
+		before           time.Time
+	)
+
+	BeforeEach(func() {
+		mockArchiveStore = mocks.NewMockOrderArchiveStore(gomock.NewController(GinkgoT()))
+		now = time.Date(2025, 2, 10, 12, 0, 0, 0, time.UTC)
+		before = now.Add(-7 * 24 * time.Hour)
+
+		policy := domain.RetentionPolicy{ArchiveAfter: 7 * 24 * time.Hour, BatchSize: 2}
+		archiver = services.NewOrderArchiver(mockArchiveStore, policy, fakeclock.New(now), time.Hour, testLogger)
+	})
+
+	It("should archive in batches until a batch comes out short", func() {
+		gomock.InOrder(
+			mockArchiveStore.EXPECT().Archive(gomock.Any(), before, 2).Return(2, nil),
+			mockArchiveStore.EXPECT().Archive(gomock.Any(), before, 2).Return(2, nil),
+			mockArchiveStore.EXPECT().Archive(gomock.Any(), before, 2).Return(1, nil),
+		)
+
+		archived, err := archiver.ArchiveDue(context.Background())
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(archived).To(Equal(5))
+	})
+
+	It("should stop at the first failing batch", func() {
+		testErr := errors.New("database is locked")
+
+		gomock.InOrder(
+			mockArchiveStore.EXPECT().Archive(gomock.Any(), before, 2).Return(2, nil),
+			mockArchiveStore.EXPECT().Archive(gomock.Any(), before, 2).Return(0, testErr),
+		)
+
+		archived, err := archiver.ArchiveDue(context.Background())
+
+		Expect(err).To(Equal(testErr))
+		Expect(archived).To(Equal(2))
+	})
+
+	It("should stop between batches once the context is cancelled", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		mockArchiveStore.EXPECT().Archive(gomock.Any(), before, 2).DoAndReturn(func(context.Context, time.Time, int) (int, error) {
+			cancel()
+			return 2, nil
+		})
+
+		archived, err := archiver.ArchiveDue(ctx)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(archived).To(Equal(2))
+	})
+})
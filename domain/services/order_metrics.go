@@ -0,0 +1,11 @@
+package services
+
+import "github.com/danbrato999/yuno-gveloz/domain"
+
+// OrderMetrics counts the changes of the orders lifecycle, for monitoring
+type OrderMetrics interface {
+	OrderCreated(source domain.OrderSource)
+	StatusChanged(from, to domain.OrderStatus)
+	OrderCancelled(source domain.OrderSource, reason domain.CancellationReason)
+	OrderPrioritized()
+}
@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+const DefaultSchedulerInterval = 30 * time.Second
+
+// OrderScheduler periodically releases scheduled orders into the kitchen queue
+type OrderScheduler struct {
+	orderService OrderService
+	interval     time.Duration
+	logger       *slog.Logger
+}
+
+func NewOrderScheduler(orderService OrderService, interval time.Duration, logger *slog.Logger) *OrderScheduler {
+	return &OrderScheduler{
+		orderService: orderService,
+		interval:     interval,
+		logger:       logger,
+	}
+}
+
+// Run blocks until the context is cancelled
+func (s *OrderScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.RunOnce(ctx)
+		}
+	}
+}
+
+func (s *OrderScheduler) RunOnce(ctx context.Context) {
+	released, err := s.orderService.ReleaseDueOrders(ctx)
+
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to release scheduled orders", slog.Any("error", err))
+	}
+
+	if len(released) > 0 {
+		s.logger.InfoContext(ctx, "released scheduled orders", slog.Int("orders", len(released)))
+	}
+}
@@ -0,0 +1,55 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("OrderScheduler", func() {
+	var (
+		mockOrderService *mocks.MockOrderService
+		scheduler        *services.OrderScheduler
+	)
+
+	BeforeEach(func() {
+		mockCtrl := gomock.NewController(GinkgoT())
+		mockOrderService = mocks.NewMockOrderService(mockCtrl)
+		scheduler = services.NewOrderScheduler(mockOrderService, 5*time.Millisecond, testLogger)
+	})
+
+	It("should release due orders on every tick until stopped", func() {
+		calls := make(chan struct{}, 10)
+		mockOrderService.EXPECT().ReleaseDueOrders(gomock.Any()).DoAndReturn(func(_ context.Context) ([]domain.Order, error) {
+			calls <- struct{}{}
+			return []domain.Order{{ID: 1}}, nil
+		}).MinTimes(2)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+
+		go func() {
+			scheduler.Run(ctx)
+			close(done)
+		}()
+
+		Eventually(calls).Should(Receive())
+		Eventually(calls).Should(Receive())
+
+		cancel()
+		Eventually(done).Should(BeClosed())
+	})
+
+	It("should keep running when releasing fails", func() {
+		mockOrderService.EXPECT().ReleaseDueOrders(gomock.Any()).Return(nil, errors.New("db error"))
+
+		Expect(func() { scheduler.RunOnce(context.Background()) }).ToNot(Panic())
+	})
+})
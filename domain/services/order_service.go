@@ -1,55 +1,261 @@
 package services
 
-import "github.com/danbrato999/yuno-gveloz/domain"
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
 
 type OrderService interface {
-	CreateOrder(request domain.NewOrder) (*domain.Order, error)
-	FindByID(id uint) (*domain.OrderWithStatusHistory, error)
-	FindMany(filters ...domain.OrderFilterFn) ([]domain.Order, error)
-	UpdateStatus(id uint, status domain.OrderStatus) (*domain.Order, error)
-	UpdateDishes(id uint, dishes []domain.Dish) (*domain.Order, error)
-	Prioritize(id uint, afterID uint) error
+	CreateOrder(ctx context.Context, request domain.NewOrder) (*domain.Order, error)
+	// FindByID only looks into the archive when FilterIncludeArchived is given
+	FindByID(ctx context.Context, id uint, filters ...domain.OrderFilterFn) (*domain.OrderWithStatusHistory, error)
+	FindMany(ctx context.Context, filters ...domain.OrderFilterFn) ([]domain.Order, error)
+	// CountMany counts the stored orders FindMany would find without a page, leaving out the archive
+	CountMany(ctx context.Context, filters ...domain.OrderFilterFn) (int, error)
+	// UpdateStatus can't cancel orders, Cancel does it following the cancellation policy
+	UpdateStatus(ctx context.Context, id uint, status domain.OrderStatus) (*domain.Order, error)
+	Cancel(ctx context.Context, id uint, request domain.CancelOrder, role domain.StaffRole) (*domain.Order, error)
+	FindWaste(ctx context.Context, from time.Time, to time.Time) ([]domain.WasteRecord, error)
+	// UpdateDishes replaces the whole dish list, only until the kitchen starts on it
+	UpdateDishes(ctx context.Context, id uint, dishes []domain.Dish) (*domain.Order, error)
+	AddDish(ctx context.Context, id uint, dish domain.Dish) (*domain.Order, error)
+	VoidDish(ctx context.Context, id uint, dishID uint, request domain.VoidDish) (*domain.Order, error)
+	MarkDishReady(ctx context.Context, id uint, dishID uint) (*domain.Order, error)
+	FindDishHistory(ctx context.Context, id uint) ([]domain.DishChange, error)
+	Prioritize(ctx context.Context, id uint, afterID uint) error
+	AssignCourier(ctx context.Context, id uint, courier domain.Courier) (*domain.Order, error)
+	Reschedule(ctx context.Context, id uint, readyAt time.Time) (*domain.Order, error)
+	// ReleaseDueOrders moves the scheduled orders whose release time has passed into the kitchen queue
+	ReleaseDueOrders(ctx context.Context) ([]domain.Order, error)
 }
 
+const DefaultPrepEstimate = 20 * time.Minute
+
 type orderServiceImpl struct {
 	orderStore    OrderStore
 	statusStore   OrderStatusStore
 	priorityQueue PriorityQueue
+	customerStore CustomerStore
+	clock         domain.Clock
+	prepEstimate  time.Duration
+	publisher     EventPublisher
+	archiveStore  OrderArchiveStore
+	metrics       OrderMetrics
+	logger        *slog.Logger
+	cancellations domain.CancellationPolicy
+	menuCatalog   MenuCatalog
+	tableStore    TableStore
+	maxDishes     int
+}
+
+type OrderServiceOption func(s *orderServiceImpl)
+
+// WithCustomerStore enables validating and resolving the customers linked to new orders
+func WithCustomerStore(customerStore CustomerStore) OrderServiceOption {
+	return func(s *orderServiceImpl) {
+		s.customerStore = customerStore
+	}
+}
+
+func WithClock(clock domain.Clock) OrderServiceOption {
+	return func(s *orderServiceImpl) {
+		s.clock = clock
+	}
+}
+
+// WithPrepEstimate sets how long before their ready time scheduled orders are released into the kitchen
+func WithPrepEstimate(estimate time.Duration) OrderServiceOption {
+	return func(s *orderServiceImpl) {
+		s.prepEstimate = estimate
+	}
+}
+
+// WithEventPublisher announces the changes of the orders lifecycle
+func WithEventPublisher(publisher EventPublisher) OrderServiceOption {
+	return func(s *orderServiceImpl) {
+		s.publisher = publisher
+	}
 }
 
-func NewOrderService(store OrderStore, priorityQueue PriorityQueue, statusStore OrderStatusStore) OrderService {
-	return &orderServiceImpl{
+// WithArchiveStore lets the orders lookups include the archived orders when requested
+func WithArchiveStore(archiveStore OrderArchiveStore) OrderServiceOption {
+	return func(s *orderServiceImpl) {
+		s.archiveStore = archiveStore
+	}
+}
+
+// WithMetrics counts the orders created, their status changes and prioritizations
+func WithMetrics(metrics OrderMetrics) OrderServiceOption {
+	return func(s *orderServiceImpl) {
+		s.metrics = metrics
+	}
+}
+
+// WithLogger replaces the default slog logger
+func WithLogger(logger *slog.Logger) OrderServiceOption {
+	return func(s *orderServiceImpl) {
+		s.logger = logger
+	}
+}
+
+// WithCancellationPolicy replaces the default roles allowed to cancel the orders in each status
+func WithCancellationPolicy(policy domain.CancellationPolicy) OrderServiceOption {
+	return func(s *orderServiceImpl) {
+		s.cancellations = policy
+	}
+}
+
+// WithMenuCatalog cross checks the dishes of the orders with allergies or a diet against the catalog
+func WithMenuCatalog(catalog MenuCatalog) OrderServiceOption {
+	return func(s *orderServiceImpl) {
+		s.menuCatalog = catalog
+	}
+}
+
+// WithTableStore enables linking the in person orders to the open session of their table
+func WithTableStore(tableStore TableStore) OrderServiceOption {
+	return func(s *orderServiceImpl) {
+		s.tableStore = tableStore
+	}
+}
+
+// WithMaxDishes bounds the dishes of every order, however it's created or updated
+func WithMaxDishes(maxDishes int) OrderServiceOption {
+	return func(s *orderServiceImpl) {
+		s.maxDishes = maxDishes
+	}
+}
+
+func NewOrderService(
+	store OrderStore,
+	priorityQueue PriorityQueue,
+	statusStore OrderStatusStore,
+	options ...OrderServiceOption,
+) OrderService {
+	service := &orderServiceImpl{
 		orderStore:    store,
 		priorityQueue: priorityQueue,
 		statusStore:   statusStore,
+		clock:         domain.SystemClock,
+		prepEstimate:  DefaultPrepEstimate,
+		logger:        slog.Default(),
+		cancellations: domain.DefaultCancellationPolicy(),
+	}
+
+	for _, option := range options {
+		option(service)
 	}
+
+	return service
 }
 
-func (s *orderServiceImpl) CreateOrder(request domain.NewOrder) (*domain.Order, error) {
+func (s *orderServiceImpl) CreateOrder(ctx context.Context, request domain.NewOrder) (_ *domain.Order, err error) {
+	ctx, span := tracer.Start(ctx, "OrderService.CreateOrder")
+	defer func() { endSpan(span, err) }()
+
+	if request.Delivery != nil && request.Source != domain.OrderSourceDelivery {
+		return nil, domain.ErrNotDeliveryOrder
+	}
+
+	if err = domain.CheckDishLimit(len(request.Dishes), s.maxDishes); err != nil {
+		return nil, err
+	}
+
+	if err = request.ValidateDiet(); err != nil {
+		return nil, err
+	}
+
+	if request.TableID != nil && request.Source != domain.OrderSourceInPerson {
+		return nil, domain.ErrNotDineInOrder
+	}
+
+	request, err = s.resolveCustomer(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	tableSessionID, err := s.resolveTableSession(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	warnings, err := s.checkDishes(ctx, request, request.Dishes)
+	if err != nil {
+		return nil, err
+	}
+
 	order := domain.Order{
-		NewOrder: request,
-		Status:   domain.OrderStatusPending,
+		NewOrder:       request,
+		Status:         domain.OrderStatusPending,
+		TableSessionID: tableSessionID,
+	}
+	order.Dishes = domain.NewDishes(request.Dishes)
+
+	if request.ReadyAt != nil {
+		releaseAt := request.ReadyAt.Add(-s.prepEstimate)
+
+		if releaseAt.After(s.clock.Now()) {
+			order.Status = domain.OrderStatusScheduled
+			order.ReleaseAt = &releaseAt
+		}
 	}
 
-	result, err := s.orderStore.Save(order)
+	result, err := s.orderStore.Save(ctx, order)
 	if err != nil {
 		return nil, err
 	}
 
-	go s.statusStore.AddCurrentStatus(result)
-	go s.priorityQueue.Add(result)
+	span.SetAttributes(orderIDAttribute(result.ID))
+	result.Warnings = warnings
+
+	go s.addCurrentStatus(ctx, result)
+
+	if result.Status != domain.OrderStatusScheduled {
+		go s.addToQueue(ctx, result)
+	}
+
+	s.publish(domain.OrderEventCreated, result)
+	s.logger.InfoContext(
+		ctx,
+		"order created",
+		orderIDLogAttr(result.ID),
+		slog.String("source", string(result.Source)),
+		slog.String("status", string(result.Status)),
+		slog.Int("dietary_warnings", len(warnings)),
+	)
+
+	if s.metrics != nil {
+		s.metrics.OrderCreated(result.Source)
+	}
 
 	return result, nil
 }
 
-func (s *orderServiceImpl) FindByID(id uint) (*domain.OrderWithStatusHistory, error) {
-	order, err := s.findByID(id)
+func (s *orderServiceImpl) FindByID(
+	ctx context.Context,
+	id uint,
+	filters ...domain.OrderFilterFn,
+) (_ *domain.OrderWithStatusHistory, err error) {
+	ctx, span := tracer.Start(ctx, "OrderService.FindByID", trace.WithAttributes(orderIDAttribute(id)))
+	defer func() { endSpan(span, err) }()
+
+	order, err := s.findByID(ctx, id)
+
+	if errors.Is(err, domain.ErrOrderNotFound) && s.archiveStore != nil && applyFilters(filters).IncludeArchived {
+		return s.findArchivedByID(ctx, id)
+	}
 
 	if err != nil {
 		return nil, err
 	}
 
-	history, err := s.statusStore.GetHistory(id)
+	history, err := s.statusStore.GetHistory(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -60,81 +266,507 @@ func (s *orderServiceImpl) FindByID(id uint) (*domain.OrderWithStatusHistory, er
 	}, nil
 }
 
-func (s *orderServiceImpl) FindMany(filters ...domain.OrderFilterFn) ([]domain.Order, error) {
-	orderFilters := &domain.OrderFilters{}
+func (s *orderServiceImpl) FindMany(ctx context.Context, filters ...domain.OrderFilterFn) (_ []domain.Order, err error) {
+	ctx, span := tracer.Start(ctx, "OrderService.FindMany")
+	defer func() { endSpan(span, err) }()
 
-	for _, filter := range filters {
-		filter(orderFilters)
+	orderFilters := applyFilters(filters)
+
+	orders, err := s.orderStore.GetAll(ctx, orderFilters)
+	if err != nil || !orderFilters.IncludeArchived || s.archiveStore == nil {
+		return orders, err
 	}
 
-	return s.orderStore.GetAll(orderFilters)
+	archived, err := s.archiveStore.GetAll(ctx, orderFilters)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(orders, archived...), nil
+}
+
+func (s *orderServiceImpl) CountMany(ctx context.Context, filters ...domain.OrderFilterFn) (_ int, err error) {
+	ctx, span := tracer.Start(ctx, "OrderService.CountMany")
+	defer func() { endSpan(span, err) }()
+
+	return s.orderStore.Count(ctx, applyFilters(filters))
 }
 
-func (s *orderServiceImpl) UpdateStatus(id uint, status domain.OrderStatus) (*domain.Order, error) {
-	existing, err := s.findActiveOrder(id)
+func (s *orderServiceImpl) UpdateStatus(ctx context.Context, id uint, status domain.OrderStatus) (_ *domain.Order, err error) {
+	ctx, span := tracer.Start(ctx, "OrderService.UpdateStatus", trace.WithAttributes(
+		orderIDAttribute(id),
+		attribute.String("order.status", string(status)),
+	))
+	defer func() { endSpan(span, err) }()
+
+	existing, err := s.findActiveOrder(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
+	if status == domain.OrderStatusCancelled {
+		return nil, domain.ErrCancellationWithoutReason
+	}
+
 	if !existing.IsNewStatusValid(status) {
 		return nil, domain.ErrInvalidOrderUpdate
 	}
 
+	previous := existing.Status
 	existing.Status = status
 
-	result, err := s.orderStore.Save(*existing)
+	result, err := s.orderStore.Save(ctx, *existing)
 	if err != nil {
 		return nil, err
 	}
 
-	go s.statusStore.AddCurrentStatus(result)
+	go s.addCurrentStatus(ctx, result)
 
-	if status == domain.OrderStatusDone || status == domain.OrderStatusCancelled {
-		go s.priorityQueue.Remove(id)
+	if previous.InKitchen() && !status.InKitchen() {
+		go s.removeFromQueue(ctx, id)
+	} else if previous == domain.OrderStatusScheduled {
+		go s.addToQueue(ctx, result)
 	}
 
+	s.publish(domain.OrderEventStatusChanged, result)
+	s.statusChanged(ctx, previous, result)
+
 	return result, nil
 }
 
-func (s *orderServiceImpl) UpdateDishes(id uint, dishes []domain.Dish) (*domain.Order, error) {
+func (s *orderServiceImpl) Cancel(
+	ctx context.Context,
+	id uint,
+	request domain.CancelOrder,
+	role domain.StaffRole,
+) (_ *domain.Order, err error) {
+	ctx, span := tracer.Start(ctx, "OrderService.Cancel", trace.WithAttributes(
+		orderIDAttribute(id),
+		attribute.String("order.cancellation_reason", string(request.Reason)),
+		attribute.String("staff.role", string(role)),
+	))
+	defer func() { endSpan(span, err) }()
+
+	if !request.IsValid() {
+		return nil, domain.ErrInvalidCancellation
+	}
+
+	existing, err := s.findActiveOrder(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.cancellations.Allows(role, existing.Status) {
+		return nil, domain.ErrCancellationNotAllowed
+	}
+
+	previous := existing.Status
+	cancellation := domain.NewCancellation(*existing, request, role, s.clock.Now())
+	existing.Status = domain.OrderStatusCancelled
+	existing.Cancellation = &cancellation
+
+	result, err := s.orderStore.Cancel(ctx, *existing)
+	if err != nil {
+		return nil, err
+	}
+
+	go s.addCurrentStatus(ctx, result)
+	go s.removeFromQueue(ctx, id)
+
+	s.publish(domain.OrderEventStatusChanged, result)
+	s.publish(domain.OrderEventCancelled, result)
+	s.statusChanged(ctx, previous, result)
+	s.logger.InfoContext(
+		ctx,
+		"order cancelled",
+		orderIDLogAttr(id),
+		slog.String("reason", string(request.Reason)),
+		slog.String("role", string(role)),
+		slog.Int("wasted_dishes", len(cancellation.Waste)),
+		slog.String("refund", string(cancellation.Refund.Type)),
+	)
+
+	return result, nil
+}
+
+func (s *orderServiceImpl) FindWaste(ctx context.Context, from time.Time, to time.Time) (_ []domain.WasteRecord, err error) {
+	ctx, span := tracer.Start(ctx, "OrderService.FindWaste")
+	defer func() { endSpan(span, err) }()
+
+	return s.orderStore.GetWaste(ctx, from, to)
+}
+
+func (s *orderServiceImpl) UpdateDishes(ctx context.Context, id uint, dishes []domain.Dish) (_ *domain.Order, err error) {
+	ctx, span := tracer.Start(ctx, "OrderService.UpdateDishes", trace.WithAttributes(orderIDAttribute(id)))
+	defer func() { endSpan(span, err) }()
+
 	if len(dishes) == 0 {
 		return nil, domain.ErrInvalidOrderUpdate
 	}
 
-	existing, err := s.findByID(id)
+	if err = domain.CheckDishLimit(len(dishes), s.maxDishes); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.findByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	if existing.Status != domain.OrderStatusPending && existing.Status != domain.OrderStatusPreparing {
+	if !existing.CanReplaceDishes() {
 		return nil, domain.ErrInvalidOrderUpdate
 	}
 
-	existing.Dishes = dishes
+	warnings, err := s.checkDishes(ctx, existing.NewOrder, dishes)
+	if err != nil {
+		return nil, err
+	}
 
-	return s.orderStore.Save(*existing)
+	existing.Dishes = domain.NewDishes(dishes)
+
+	result, err := s.orderStore.ReplaceDishes(ctx, *existing)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publish(domain.OrderEventUpdated, result)
+	result.Warnings = warnings
+	return result, nil
+}
+
+func (s *orderServiceImpl) AddDish(ctx context.Context, id uint, dish domain.Dish) (_ *domain.Order, err error) {
+	ctx, span := tracer.Start(ctx, "OrderService.AddDish", trace.WithAttributes(orderIDAttribute(id)))
+	defer func() { endSpan(span, err) }()
+
+	return s.changeDish(ctx, id, 0, dish.Name, domain.DishActionAdded, nil)
+}
+
+func (s *orderServiceImpl) VoidDish(
+	ctx context.Context,
+	id uint,
+	dishID uint,
+	request domain.VoidDish,
+) (_ *domain.Order, err error) {
+	ctx, span := tracer.Start(ctx, "OrderService.VoidDish", trace.WithAttributes(
+		orderIDAttribute(id),
+		attribute.Int64("order.dish_id", int64(dishID)),
+		attribute.String("order.void_reason", string(request.Reason)),
+	))
+	defer func() { endSpan(span, err) }()
+
+	if !request.IsValid() {
+		return nil, domain.ErrInvalidDishVoid
+	}
+
+	return s.changeDish(ctx, id, dishID, "", domain.DishActionVoided, &request)
+}
+
+func (s *orderServiceImpl) MarkDishReady(ctx context.Context, id uint, dishID uint) (_ *domain.Order, err error) {
+	ctx, span := tracer.Start(ctx, "OrderService.MarkDishReady", trace.WithAttributes(
+		orderIDAttribute(id),
+		attribute.Int64("order.dish_id", int64(dishID)),
+	))
+	defer func() { endSpan(span, err) }()
+
+	return s.changeDish(ctx, id, dishID, "", domain.DishActionReady, nil)
+}
+
+func (s *orderServiceImpl) FindDishHistory(ctx context.Context, id uint) (_ []domain.DishChange, err error) {
+	ctx, span := tracer.Start(ctx, "OrderService.FindDishHistory", trace.WithAttributes(orderIDAttribute(id)))
+	defer func() { endSpan(span, err) }()
+
+	if _, err = s.findByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return s.orderStore.GetDishHistory(ctx, id)
 }
 
-func (s *orderServiceImpl) Prioritize(id uint, afterID uint) error {
-	return s.priorityQueue.ShuffleAfter(id, afterID)
+func (s *orderServiceImpl) Prioritize(ctx context.Context, id uint, afterID uint) (err error) {
+	ctx, span := tracer.Start(ctx, "OrderService.Prioritize", trace.WithAttributes(
+		orderIDAttribute(id),
+		attribute.Int64("order.after_id", int64(afterID)),
+	))
+	defer func() { endSpan(span, err) }()
+
+	if err = s.priorityQueue.ShuffleAfter(ctx, id, afterID); err != nil {
+		return err
+	}
+
+	if s.metrics != nil {
+		s.metrics.OrderPrioritized()
+	}
+
+	return nil
 }
 
-func (s *orderServiceImpl) findActiveOrder(id uint) (*domain.Order, error) {
-	existing, err := s.findByID(id)
+func (s *orderServiceImpl) AssignCourier(ctx context.Context, id uint, courier domain.Courier) (_ *domain.Order, err error) {
+	ctx, span := tracer.Start(ctx, "OrderService.AssignCourier", trace.WithAttributes(orderIDAttribute(id)))
+	defer func() { endSpan(span, err) }()
 
+	existing, err := s.findActiveOrder(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	if existing.Status == domain.OrderStatusDone || existing.Status == domain.OrderStatusCancelled {
+	if !existing.IsDelivery() {
+		return nil, domain.ErrNotDeliveryOrder
+	}
+
+	assignedAt := s.clock.Now()
+	courier.AssignedAt = &assignedAt
+	existing.Courier = &courier
+
+	return s.saveUpdate(ctx, *existing)
+}
+
+func (s *orderServiceImpl) Reschedule(ctx context.Context, id uint, readyAt time.Time) (_ *domain.Order, err error) {
+	ctx, span := tracer.Start(ctx, "OrderService.Reschedule", trace.WithAttributes(orderIDAttribute(id)))
+	defer func() { endSpan(span, err) }()
+
+	existing, err := s.findByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing.Status != domain.OrderStatusScheduled {
+		return nil, domain.ErrInvalidOrderUpdate
+	}
+
+	releaseAt := readyAt.Add(-s.prepEstimate)
+	existing.ReadyAt = &readyAt
+	existing.ReleaseAt = &releaseAt
+
+	// Like new orders, the ones no longer due in the future go straight into the kitchen
+	if !releaseAt.After(s.clock.Now()) {
+		return s.release(ctx, *existing)
+	}
+
+	return s.saveUpdate(ctx, *existing)
+}
+
+func (s *orderServiceImpl) ReleaseDueOrders(ctx context.Context) (_ []domain.Order, err error) {
+	ctx, span := tracer.Start(ctx, "OrderService.ReleaseDueOrders")
+	defer func() { endSpan(span, err) }()
+
+	due, err := s.FindMany(ctx, domain.FilterReleasedBy(s.clock.Now()))
+	if err != nil {
+		return nil, err
+	}
+
+	released := make([]domain.Order, 0, len(due))
+
+	for _, order := range due {
+		result, err := s.release(ctx, order)
+		if err != nil {
+			return released, err
+		}
+
+		released = append(released, *result)
+	}
+
+	span.SetAttributes(attribute.Int("orders.released", len(released)))
+
+	return released, nil
+}
+
+// release moves a scheduled order into the kitchen queue
+func (s *orderServiceImpl) release(ctx context.Context, order domain.Order) (*domain.Order, error) {
+	order.Status = domain.OrderStatusPending
+
+	result, err := s.orderStore.Save(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.statusStore.AddCurrentStatus(ctx, result); err != nil {
+		return nil, err
+	}
+
+	if err = s.priorityQueue.Add(ctx, result); err != nil {
+		return nil, err
+	}
+
+	s.publish(domain.OrderEventStatusChanged, result)
+	s.statusChanged(ctx, domain.OrderStatusScheduled, result)
+
+	return result, nil
+}
+
+func (s *orderServiceImpl) saveUpdate(ctx context.Context, order domain.Order) (*domain.Order, error) {
+	result, err := s.orderStore.Save(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publish(domain.OrderEventUpdated, result)
+
+	return result, nil
+}
+
+// changeDish applies a single dish action and returns the order as it was left
+func (s *orderServiceImpl) changeDish(
+	ctx context.Context,
+	id uint,
+	dishID uint,
+	name string,
+	action domain.DishAction,
+	void *domain.VoidDish,
+) (*domain.Order, error) {
+	existing, err := s.findActiveOrder(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	change, err := existing.ChangeDish(dishID, name, action, s.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if void != nil {
+		change.Reason = void.Reason
+		change.Note = void.Note
+	}
+
+	var warnings []domain.DietaryWarning
+	if action == domain.DishActionAdded {
+		if err = domain.CheckDishLimit(len(domain.ActiveDishes(existing.Dishes))+1, s.maxDishes); err != nil {
+			return nil, err
+		}
+
+		warnings, err = s.checkDishes(ctx, existing.NewOrder, []domain.Dish{{Name: change.Dish}})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	stored, err := s.orderStore.ChangeDish(ctx, id, change)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.findByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Warnings = warnings
+	s.publish(domain.OrderEventUpdated, result)
+	s.logger.InfoContext(
+		ctx,
+		"order dish changed",
+		orderIDLogAttr(id),
+		slog.Uint64("dish_id", uint64(stored.DishID)),
+		slog.String("action", string(action)),
+	)
+
+	return result, nil
+}
+
+// checkDishes cross checks new dishes against the allergies and diet of the order, failing
+// with domain.ErrAllergenConflict for severe allergies
+func (s *orderServiceImpl) checkDishes(
+	ctx context.Context,
+	order domain.NewOrder,
+	dishes []domain.Dish,
+) ([]domain.DietaryWarning, error) {
+	if s.menuCatalog == nil || !order.HasDietaryNeeds() {
+		return nil, nil
+	}
+
+	var warnings []domain.DietaryWarning
+
+	for _, dish := range dishes {
+		item, err := s.menuCatalog.FindItem(ctx, dish.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		dishWarnings, err := order.CheckDish(dish.Name, item)
+		if err != nil {
+			return nil, err
+		}
+
+		warnings = append(warnings, dishWarnings...)
+	}
+
+	return warnings, nil
+}
+
+// The status history and the queue are updated in the background, so they don't hold the request
+
+func (s *orderServiceImpl) addCurrentStatus(ctx context.Context, order *domain.Order) {
+	ctx, span := detach(ctx, "OrderService.addCurrentStatus")
+	s.endBackgroundWork(ctx, span, "failed to store the order status", order.ID, s.statusStore.AddCurrentStatus(ctx, order))
+}
+
+func (s *orderServiceImpl) addToQueue(ctx context.Context, order *domain.Order) {
+	ctx, span := detach(ctx, "OrderService.addToQueue")
+	s.endBackgroundWork(ctx, span, "failed to queue the order", order.ID, s.priorityQueue.Add(ctx, order))
+}
+
+func (s *orderServiceImpl) removeFromQueue(ctx context.Context, id uint) {
+	ctx, span := detach(ctx, "OrderService.removeFromQueue")
+	s.endBackgroundWork(ctx, span, "failed to remove the order from the queue", id, s.priorityQueue.Remove(ctx, id))
+}
+
+// Nobody is waiting for the background work anymore, so its failures are only logged
+func (s *orderServiceImpl) endBackgroundWork(ctx context.Context, span trace.Span, msg string, id uint, err error) {
+	if err != nil {
+		s.logger.ErrorContext(ctx, msg, orderIDLogAttr(id), slog.Any("error", err))
+	}
+
+	endSpan(span, err)
+}
+
+func (s *orderServiceImpl) publish(eventType domain.OrderEventType, order *domain.Order) {
+	if s.publisher == nil {
+		return
+	}
+
+	s.publisher.Publish(domain.OrderEvent{
+		Type:      eventType,
+		Order:     *order,
+		Timestamp: s.clock.Now(),
+	})
+}
+
+func (s *orderServiceImpl) statusChanged(ctx context.Context, previous domain.OrderStatus, order *domain.Order) {
+	s.logger.InfoContext(
+		ctx,
+		"order status changed",
+		orderIDLogAttr(order.ID),
+		slog.String("from", string(previous)),
+		slog.String("to", string(order.Status)),
+	)
+
+	if s.metrics == nil {
+		return
+	}
+
+	s.metrics.StatusChanged(previous, order.Status)
+
+	if order.Cancellation != nil {
+		s.metrics.OrderCancelled(order.Source, order.Cancellation.Reason)
+	}
+}
+
+func (s *orderServiceImpl) findActiveOrder(ctx context.Context, id uint) (*domain.Order, error) {
+	existing, err := s.findByID(ctx, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if existing.Status.IsFinal() {
 		return nil, domain.ErrCompleteOrderUpdate
 	}
 
 	return existing, nil
 }
 
-func (s *orderServiceImpl) findByID(id uint) (*domain.Order, error) {
-	order, err := s.orderStore.FindByID(id)
+func (s *orderServiceImpl) findByID(ctx context.Context, id uint) (*domain.Order, error) {
+	order, err := s.orderStore.FindByID(ctx, id)
 
 	if err != nil {
 		return nil, err
@@ -146,3 +778,99 @@ func (s *orderServiceImpl) findByID(id uint) (*domain.Order, error) {
 
 	return order, nil
 }
+
+func (s *orderServiceImpl) findArchivedByID(ctx context.Context, id uint) (*domain.OrderWithStatusHistory, error) {
+	order, err := s.archiveStore.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if order == nil {
+		return nil, domain.ErrOrderNotFound
+	}
+
+	return order, nil
+}
+
+func applyFilters(filters []domain.OrderFilterFn) *domain.OrderFilters {
+	orderFilters := &domain.OrderFilters{}
+
+	for _, filter := range filters {
+		filter(orderFilters)
+	}
+
+	return orderFilters
+}
+
+// resolveTableSession returns the open session of the order table, which has to be seated first
+func (s *orderServiceImpl) resolveTableSession(ctx context.Context, request domain.NewOrder) (*uint, error) {
+	if s.tableStore == nil || request.TableID == nil {
+		return nil, nil
+	}
+
+	table, err := s.tableStore.FindByID(ctx, *request.TableID)
+	if err != nil {
+		return nil, err
+	}
+
+	if table == nil {
+		return nil, domain.ErrUnknownOrderTable
+	}
+
+	session, err := s.tableStore.FindOpenSession(ctx, table.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if session == nil {
+		return nil, domain.ErrTableNotSeated
+	}
+
+	return &session.ID, nil
+}
+
+func (s *orderServiceImpl) resolveCustomer(ctx context.Context, request domain.NewOrder) (domain.NewOrder, error) {
+	return resolveOrderCustomer(ctx, s.customerStore, request)
+}
+
+func resolveOrderCustomer(
+	ctx context.Context,
+	customerStore CustomerStore,
+	request domain.NewOrder,
+) (domain.NewOrder, error) {
+	phone := request.CustomerPhone
+	request.CustomerPhone = ""
+
+	if customerStore == nil {
+		return request, nil
+	}
+
+	if request.CustomerID != nil {
+		customer, err := customerStore.FindByID(ctx, *request.CustomerID)
+		if err != nil {
+			return request, err
+		}
+
+		if customer == nil {
+			return request, domain.ErrUnknownOrderCustomer
+		}
+
+		return request, nil
+	}
+
+	if request.Source != domain.OrderSourcePhone || phone == "" {
+		return request, nil
+	}
+
+	// Unknown callers are still accepted, the order just isn't linked to anyone
+	customer, err := customerStore.FindByPhone(ctx, domain.NormalizePhone(phone))
+	if err != nil {
+		return request, err
+	}
+
+	if customer != nil {
+		request.CustomerID = &customer.ID
+	}
+
+	return request, nil
+}
@@ -1,11 +1,14 @@
 package services_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/fakeclock"
 	"github.com/danbrato999/yuno-gveloz/domain/services"
 	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
 	. "github.com/onsi/ginkgo/v2"
@@ -41,18 +44,18 @@ var _ = Describe("OrderService", func() {
 				ID:       1,
 			}
 
-			mockOrderStore.EXPECT().Save(gomock.Any()).Return(savedOrder, nil)
+			mockOrderStore.EXPECT().Save(gomock.Any(), gomock.Any()).Return(savedOrder, nil)
 
 			var wg sync.WaitGroup
 			wg.Add(2)
-			mockStatusStore.EXPECT().AddCurrentStatus(savedOrder).Do(func(o *domain.Order) {
+			mockStatusStore.EXPECT().AddCurrentStatus(gomock.Any(), savedOrder).Do(func(_ context.Context, o *domain.Order) {
 				wg.Done()
 			})
-			mockPriorityQueue.EXPECT().Add(savedOrder).Do(func(o *domain.Order) {
+			mockPriorityQueue.EXPECT().Add(gomock.Any(), savedOrder).Do(func(_ context.Context, o *domain.Order) {
 				wg.Done()
 			})
 
-			order, err := orderService.CreateOrder(newOrder)
+			order, err := orderService.CreateOrder(context.Background(), newOrder)
 
 			Expect(err).To(Succeed())
 			Expect(order).NotTo(BeNil())
@@ -61,14 +64,27 @@ var _ = Describe("OrderService", func() {
 			wg.Wait()
 		})
 
+		It("should reject delivery details on other orders", func() {
+			newOrder := domain.NewOrder{
+				Source:   domain.OrderSourceInPerson,
+				Dishes:   []domain.Dish{{Name: "Pizza"}},
+				Delivery: &domain.DeliveryDetails{Address: "Main St 123"},
+			}
+
+			order, err := orderService.CreateOrder(context.Background(), newOrder)
+
+			Expect(order).To(BeNil())
+			Expect(err).To(Equal(domain.ErrNotDeliveryOrder))
+		})
+
 		It("should return an error if saving fails", func() {
 			newOrder := domain.NewOrder{
 				Dishes: []domain.Dish{{Name: "Pizza"}},
 			}
 
-			mockOrderStore.EXPECT().Save(gomock.Any()).Return(nil, errors.New("save error"))
+			mockOrderStore.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil, errors.New("save error"))
 
-			order, err := orderService.CreateOrder(newOrder)
+			order, err := orderService.CreateOrder(context.Background(), newOrder)
 
 			Expect(order).To(BeNil())
 			Expect(err).To(HaveOccurred())
@@ -76,6 +92,267 @@ var _ = Describe("OrderService", func() {
 		})
 	})
 
+	Context("CreateOrder with customers", func() {
+		var (
+			mockCustomerStore *mocks.MockCustomerStore
+			customer          *domain.Customer
+		)
+
+		BeforeEach(func() {
+			mockCtrl := gomock.NewController(GinkgoT())
+			mockCustomerStore = mocks.NewMockCustomerStore(mockCtrl)
+			orderService = services.NewOrderService(
+				mockOrderStore,
+				mockPriorityQueue,
+				mockStatusStore,
+				services.WithCustomerStore(mockCustomerStore),
+			)
+			customer = &domain.Customer{ID: 5}
+
+			mockStatusStore.EXPECT().AddCurrentStatus(gomock.Any(), gomock.Any()).AnyTimes()
+			mockPriorityQueue.EXPECT().Add(gomock.Any(), gomock.Any()).AnyTimes()
+		})
+
+		It("should link phone orders to the customer with the same phone", func() {
+			newOrder := domain.NewOrder{
+				Source:        domain.OrderSourcePhone,
+				Dishes:        []domain.Dish{{Name: "Pizza"}},
+				CustomerPhone: "555-0000",
+			}
+
+			mockCustomerStore.EXPECT().FindByPhone(gomock.Any(), "5550000").Return(customer, nil)
+			mockOrderStore.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (*domain.Order, error) {
+				return &o, nil
+			})
+
+			order, err := orderService.CreateOrder(context.Background(), newOrder)
+
+			Expect(err).To(Succeed())
+			Expect(*order.CustomerID).To(Equal(customer.ID))
+			Expect(order.CustomerPhone).To(BeEmpty())
+		})
+
+		It("should accept phone orders from unknown callers", func() {
+			newOrder := domain.NewOrder{
+				Source:        domain.OrderSourcePhone,
+				Dishes:        []domain.Dish{{Name: "Pizza"}},
+				CustomerPhone: "5550000",
+			}
+
+			mockCustomerStore.EXPECT().FindByPhone(gomock.Any(), "5550000").Return(nil, nil)
+			mockOrderStore.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (*domain.Order, error) {
+				return &o, nil
+			})
+
+			order, err := orderService.CreateOrder(context.Background(), newOrder)
+
+			Expect(err).To(Succeed())
+			Expect(order.CustomerID).To(BeNil())
+		})
+
+		It("should reject orders for unknown customers", func() {
+			var unknownID uint = 99
+			newOrder := domain.NewOrder{
+				Source:     domain.OrderSourceInPerson,
+				Dishes:     []domain.Dish{{Name: "Pizza"}},
+				CustomerID: &unknownID,
+			}
+
+			mockCustomerStore.EXPECT().FindByID(gomock.Any(), unknownID).Return(nil, nil)
+
+			order, err := orderService.CreateOrder(context.Background(), newOrder)
+
+			Expect(order).To(BeNil())
+			Expect(err).To(Equal(domain.ErrUnknownOrderCustomer))
+		})
+	})
+
+	Context("Scheduled orders", func() {
+		var clock *fakeclock.Clock
+
+		BeforeEach(func() {
+			clock = fakeclock.New(time.Date(2025, 2, 10, 12, 0, 0, 0, time.UTC))
+			orderService = services.NewOrderService(
+				mockOrderStore,
+				mockPriorityQueue,
+				mockStatusStore,
+				services.WithClock(clock),
+				services.WithPrepEstimate(30*time.Minute),
+			)
+		})
+
+		It("should hold orders ready far in the future", func() {
+			readyAt := clock.Now().Add(2 * time.Hour)
+			newOrder := domain.NewOrder{
+				Dishes:  []domain.Dish{{Name: "Pizza"}},
+				ReadyAt: &readyAt,
+			}
+
+			mockOrderStore.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (*domain.Order, error) {
+				o.ID = 1
+				return &o, nil
+			})
+
+			var wg sync.WaitGroup
+			wg.Add(1)
+			mockStatusStore.EXPECT().AddCurrentStatus(gomock.Any(), gomock.Any()).Do(func(_ context.Context, o *domain.Order) {
+				wg.Done()
+			})
+
+			order, err := orderService.CreateOrder(context.Background(), newOrder)
+
+			Expect(err).To(Succeed())
+			Expect(order.Status).To(Equal(domain.OrderStatusScheduled))
+			Expect(*order.ReleaseAt).To(Equal(readyAt.Add(-30 * time.Minute)))
+
+			wg.Wait()
+		})
+
+		It("should queue orders that need to be prepared right away", func() {
+			readyAt := clock.Now().Add(20 * time.Minute)
+			newOrder := domain.NewOrder{
+				Dishes:  []domain.Dish{{Name: "Pizza"}},
+				ReadyAt: &readyAt,
+			}
+
+			mockOrderStore.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (*domain.Order, error) {
+				return &o, nil
+			})
+
+			var wg sync.WaitGroup
+			wg.Add(2)
+			mockStatusStore.EXPECT().AddCurrentStatus(gomock.Any(), gomock.Any()).Do(func(_ context.Context, o *domain.Order) {
+				wg.Done()
+			})
+			mockPriorityQueue.EXPECT().Add(gomock.Any(), gomock.Any()).Do(func(_ context.Context, o *domain.Order) {
+				wg.Done()
+			})
+
+			order, err := orderService.CreateOrder(context.Background(), newOrder)
+
+			Expect(err).To(Succeed())
+			Expect(order.Status).To(Equal(domain.OrderStatusPending))
+			Expect(order.ReleaseAt).To(BeNil())
+
+			wg.Wait()
+		})
+
+		It("should release due orders into the queue", func() {
+			due := []domain.Order{
+				{ID: 1, Status: domain.OrderStatusScheduled},
+				{ID: 2, Status: domain.OrderStatusScheduled},
+			}
+
+			releasedBy := clock.Advance(time.Hour)
+
+			mockOrderStore.EXPECT().GetAll(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, filters *domain.OrderFilters) ([]domain.Order, error) {
+				Expect(*filters.ReleasedBy).To(Equal(releasedBy))
+				Expect(filters.AnyStatus).To(Equal([]domain.OrderStatus{domain.OrderStatusScheduled}))
+				return due, nil
+			})
+			mockOrderStore.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (*domain.Order, error) {
+				Expect(o.Status).To(Equal(domain.OrderStatusPending))
+				return &o, nil
+			}).Times(2)
+			mockStatusStore.EXPECT().AddCurrentStatus(gomock.Any(), gomock.Any()).Times(2)
+			mockPriorityQueue.EXPECT().Add(gomock.Any(), gomock.Any()).Times(2)
+
+			released, err := orderService.ReleaseDueOrders(context.Background())
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(released).To(HaveLen(2))
+		})
+
+		It("should stop releasing when an order can't be queued", func() {
+			due := []domain.Order{{ID: 1, Status: domain.OrderStatusScheduled}, {ID: 2, Status: domain.OrderStatusScheduled}}
+			queueErr := errors.New("queue error")
+
+			mockOrderStore.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(due, nil)
+			mockOrderStore.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (*domain.Order, error) {
+				return &o, nil
+			})
+			mockStatusStore.EXPECT().AddCurrentStatus(gomock.Any(), gomock.Any())
+			mockPriorityQueue.EXPECT().Add(gomock.Any(), gomock.Any()).Return(queueErr)
+
+			released, err := orderService.ReleaseDueOrders(context.Background())
+
+			Expect(err).To(Equal(queueErr))
+			Expect(released).To(BeEmpty())
+		})
+
+		It("should reschedule scheduled orders", func() {
+			readyAt := clock.Now().Add(3 * time.Hour)
+			order := &domain.Order{ID: 1, Status: domain.OrderStatusScheduled}
+
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(order, nil)
+			mockOrderStore.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (*domain.Order, error) {
+				return &o, nil
+			})
+
+			result, err := orderService.Reschedule(context.Background(), 1, readyAt)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*result.ReadyAt).To(Equal(readyAt))
+			Expect(*result.ReleaseAt).To(Equal(readyAt.Add(-30 * time.Minute)))
+		})
+
+		DescribeTable("should release the orders rescheduled to be ready too soon", func(readyIn time.Duration) {
+			readyAt := clock.Now().Add(readyIn)
+			order := &domain.Order{ID: 1, Status: domain.OrderStatusScheduled}
+
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(order, nil)
+			mockOrderStore.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (*domain.Order, error) {
+				return &o, nil
+			})
+			mockStatusStore.EXPECT().AddCurrentStatus(gomock.Any(), gomock.Any())
+			mockPriorityQueue.EXPECT().Add(gomock.Any(), gomock.Any()).Do(func(_ context.Context, o *domain.Order) {
+				Expect(o.ID).To(Equal(uint(1)))
+			})
+
+			result, err := orderService.Reschedule(context.Background(), 1, readyAt)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(domain.OrderStatusPending))
+			Expect(*result.ReadyAt).To(Equal(readyAt))
+		},
+			Entry("when the ready time already passed", -time.Hour),
+			Entry("when there's no time left to prepare it", 10*time.Minute),
+		)
+
+		It("should not reschedule orders already in the kitchen", func() {
+			order := &domain.Order{ID: 1, Status: domain.OrderStatusPending}
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(order, nil)
+
+			result, err := orderService.Reschedule(context.Background(), 1, clock.Now())
+
+			Expect(result).To(BeNil())
+			Expect(err).To(Equal(domain.ErrInvalidOrderUpdate))
+		})
+
+		It("should queue scheduled orders released manually", func() {
+			order := &domain.Order{ID: 1, Status: domain.OrderStatusScheduled}
+
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(order, nil)
+			mockOrderStore.EXPECT().Save(gomock.Any(), gomock.Any()).Return(order, nil)
+
+			var wg sync.WaitGroup
+			wg.Add(2)
+			mockStatusStore.EXPECT().AddCurrentStatus(gomock.Any(), order).Do(func(_ context.Context, o *domain.Order) {
+				wg.Done()
+			})
+			mockPriorityQueue.EXPECT().Add(gomock.Any(), order).Do(func(_ context.Context, o *domain.Order) {
+				wg.Done()
+			})
+
+			result, err := orderService.UpdateStatus(context.Background(), 1, domain.OrderStatusPending)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(domain.OrderStatusPending))
+
+			wg.Wait()
+		})
+	})
+
 	Context("FindByID", func() {
 		It("should return an order with status history", func() {
 			order := &domain.Order{ID: 1, Status: domain.OrderStatusPending}
@@ -88,10 +365,10 @@ var _ = Describe("OrderService", func() {
 				},
 			}
 
-			mockOrderStore.EXPECT().FindByID(uint(1)).Return(order, nil)
-			mockStatusStore.EXPECT().GetHistory(uint(1)).Return(history, nil)
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(order, nil)
+			mockStatusStore.EXPECT().GetHistory(gomock.Any(), uint(1)).Return(history, nil)
 
-			result, err := orderService.FindByID(1)
+			result, err := orderService.FindByID(context.Background(), 1)
 
 			Expect(err).To(Succeed())
 			Expect(result).NotTo(BeNil())
@@ -99,9 +376,9 @@ var _ = Describe("OrderService", func() {
 		})
 
 		It("should return an error if order not found", func() {
-			mockOrderStore.EXPECT().FindByID(uint(1)).Return(nil, nil)
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(nil, nil)
 
-			result, err := orderService.FindByID(1)
+			result, err := orderService.FindByID(context.Background(), 1)
 
 			Expect(result).To(BeNil())
 			Expect(err).To(Equal(domain.ErrOrderNotFound))
@@ -109,29 +386,89 @@ var _ = Describe("OrderService", func() {
 
 		It("should return an error if store fails", func() {
 			testErr := fmt.Errorf("random error")
-			mockOrderStore.EXPECT().FindByID(uint(1)).Return(nil, testErr)
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(nil, testErr)
 
-			result, err := orderService.FindByID(1)
+			result, err := orderService.FindByID(context.Background(), 1)
 
 			Expect(result).To(BeNil())
 			Expect(err).To(Equal(testErr))
 		})
 	})
 
+	Context("Archived orders", func() {
+		var mockArchiveStore *mocks.MockOrderArchiveStore
+
+		BeforeEach(func() {
+			mockArchiveStore = mocks.NewMockOrderArchiveStore(gomock.NewController(GinkgoT()))
+			orderService = services.NewOrderService(
+				mockOrderStore,
+				mockPriorityQueue,
+				mockStatusStore,
+				services.WithArchiveStore(mockArchiveStore),
+			)
+		})
+
+		It("should find archived orders only when requested", func() {
+			archived := &domain.OrderWithStatusHistory{Order: domain.Order{ID: 1, Status: domain.OrderStatusDone}}
+
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(nil, nil).Times(2)
+			mockArchiveStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(archived, nil)
+
+			_, err := orderService.FindByID(context.Background(), 1)
+			Expect(err).To(Equal(domain.ErrOrderNotFound))
+
+			result, err := orderService.FindByID(context.Background(), 1, domain.FilterIncludeArchived)
+			Expect(err).To(Succeed())
+			Expect(result).To(Equal(archived))
+		})
+
+		It("should return an error if the order is not archived either", func() {
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(nil, nil)
+			mockArchiveStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(nil, nil)
+
+			result, err := orderService.FindByID(context.Background(), 1, domain.FilterIncludeArchived)
+
+			Expect(result).To(BeNil())
+			Expect(err).To(Equal(domain.ErrOrderNotFound))
+		})
+
+		It("should append the archived orders to the live ones", func() {
+			mockOrderStore.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return([]domain.Order{{ID: 3}}, nil)
+			mockArchiveStore.EXPECT().GetAll(gomock.Any(), &domain.OrderFilters{
+				AnyStatus:       []domain.OrderStatus{domain.OrderStatusDone},
+				IncludeArchived: true,
+			}).Return([]domain.Order{{ID: 1}}, nil)
+
+			result, err := orderService.FindMany(context.Background(), domain.FilterByStatus(domain.OrderStatusDone), domain.FilterIncludeArchived)
+
+			Expect(err).To(Succeed())
+			Expect(result).To(Equal([]domain.Order{{ID: 3}, {ID: 1}}))
+		})
+
+		It("should not read the archive by default", func() {
+			mockOrderStore.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return([]domain.Order{{ID: 3}}, nil)
+
+			result, err := orderService.FindMany(context.Background())
+
+			Expect(err).To(Succeed())
+			Expect(result).To(HaveLen(1))
+		})
+	})
+
 	Context("UpdateStatus", func() {
 		It("should update order status successfully", func() {
 			order := &domain.Order{ID: 1, Status: domain.OrderStatusPending}
 
-			mockOrderStore.EXPECT().FindByID(uint(1)).Return(order, nil)
-			mockOrderStore.EXPECT().Save(gomock.Any()).Return(order, nil)
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(order, nil)
+			mockOrderStore.EXPECT().Save(gomock.Any(), gomock.Any()).Return(order, nil)
 
 			var wg sync.WaitGroup
 			wg.Add(1)
-			mockStatusStore.EXPECT().AddCurrentStatus(order).Do(func(o *domain.Order) {
+			mockStatusStore.EXPECT().AddCurrentStatus(gomock.Any(), order).Do(func(_ context.Context, o *domain.Order) {
 				wg.Done()
 			})
 
-			updatedOrder, err := orderService.UpdateStatus(1, domain.OrderStatusPreparing)
+			updatedOrder, err := orderService.UpdateStatus(context.Background(), 1, domain.OrderStatusPreparing)
 
 			Expect(err).To(BeNil())
 			Expect(updatedOrder).NotTo(BeNil())
@@ -141,9 +478,9 @@ var _ = Describe("OrderService", func() {
 		})
 
 		It("should return an error if order does not exist", func() {
-			mockOrderStore.EXPECT().FindByID(uint(1)).Return(nil, nil)
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(nil, nil)
 
-			order, err := orderService.UpdateStatus(1, domain.OrderStatusPreparing)
+			order, err := orderService.UpdateStatus(context.Background(), 1, domain.OrderStatusPreparing)
 
 			Expect(order).To(BeNil())
 			Expect(err).To(Equal(domain.ErrOrderNotFound))
@@ -151,9 +488,9 @@ var _ = Describe("OrderService", func() {
 
 		It("should return an error if order is completed", func() {
 			order := &domain.Order{ID: 1, Status: domain.OrderStatusDone}
-			mockOrderStore.EXPECT().FindByID(uint(1)).Return(order, nil)
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(order, nil)
 
-			result, err := orderService.UpdateStatus(1, domain.OrderStatusPreparing)
+			result, err := orderService.UpdateStatus(context.Background(), 1, domain.OrderStatusPreparing)
 
 			Expect(result).To(BeNil())
 			Expect(err).To(Equal(domain.ErrCompleteOrderUpdate))
@@ -162,37 +499,226 @@ var _ = Describe("OrderService", func() {
 
 		It("should return an error if status transition is invalid", func() {
 			order := &domain.Order{ID: 1, Status: domain.OrderStatusReady}
-			mockOrderStore.EXPECT().FindByID(uint(1)).Return(order, nil)
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(order, nil)
 
-			result, err := orderService.UpdateStatus(1, domain.OrderStatusPreparing)
+			result, err := orderService.UpdateStatus(context.Background(), 1, domain.OrderStatusPreparing)
 
 			Expect(result).To(BeNil())
 			Expect(err).To(Equal(domain.ErrInvalidOrderUpdate))
 		})
 
-		It("should remove order from queue when cancelled", func() {
+		It("should refuse to cancel orders without a reason", func() {
 			order := &domain.Order{ID: 1, Status: domain.OrderStatusPending}
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(order, nil)
+
+			result, err := orderService.UpdateStatus(context.Background(), 1, domain.OrderStatusCancelled)
+
+			Expect(result).To(BeNil())
+			Expect(err).To(Equal(domain.ErrCancellationWithoutReason))
+		})
+	})
+
+	Context("Cancel", func() {
+		var (
+			clock   *fakeclock.Clock
+			request domain.CancelOrder
+		)
 
-			mockOrderStore.EXPECT().FindByID(uint(1)).Return(order, nil)
-			mockOrderStore.EXPECT().Save(gomock.Any()).Return(order, nil)
+		BeforeEach(func() {
+			clock = fakeclock.New(time.Date(2025, 2, 10, 12, 0, 0, 0, time.UTC))
+			request = domain.CancelOrder{Reason: domain.CancellationReasonCustomerRequest}
+			orderService = services.NewOrderService(mockOrderStore, mockPriorityQueue, mockStatusStore, services.WithClock(clock))
+		})
 
+		expectBackgroundWork := func(order *domain.Order) *sync.WaitGroup {
 			var wg sync.WaitGroup
 			wg.Add(2)
-			mockStatusStore.EXPECT().AddCurrentStatus(order).Do(func(o *domain.Order) {
-				wg.Done()
+			mockStatusStore.EXPECT().AddCurrentStatus(gomock.Any(), gomock.Any()).Do(func(_ context.Context, o *domain.Order) { wg.Done() })
+			mockPriorityQueue.EXPECT().Remove(gomock.Any(), order.ID).Do(func(_ context.Context, id uint) { wg.Done() })
+			return &wg
+		}
+
+		It("should let cooks cancel pending orders without waste", func() {
+			order := &domain.Order{ID: 1, Status: domain.OrderStatusPending, NewOrder: domain.NewOrder{Dishes: []domain.Dish{{Name: "Pizza"}}}}
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(order, nil)
+			mockOrderStore.EXPECT().Cancel(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (*domain.Order, error) {
+				return &o, nil
 			})
-			mockPriorityQueue.EXPECT().Remove(order.ID).Do(func(id uint) {
-				wg.Done()
+			wg := expectBackgroundWork(order)
+
+			result, err := orderService.Cancel(context.Background(), 1, request, domain.StaffRoleCook)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(domain.OrderStatusCancelled))
+			Expect(result.Cancellation).To(Equal(&domain.Cancellation{
+				CancelOrder:    request,
+				CancelledBy:    domain.StaffRoleCook,
+				PreviousStatus: domain.OrderStatusPending,
+				CancelledAt:    clock.Now(),
+				Waste:          []domain.Dish{},
+				Refund:         domain.Refund{Type: domain.RefundFull},
+			}))
+
+			wg.Wait()
+		})
+
+		It("should only let managers cancel orders in preparation, recording their waste", func() {
+			order := &domain.Order{ID: 1, Status: domain.OrderStatusPreparing, NewOrder: domain.NewOrder{Dishes: []domain.Dish{{Name: "Pizza"}}}}
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(order, nil).Times(2)
+
+			_, err := orderService.Cancel(context.Background(), 1, request, domain.StaffRoleCook)
+			Expect(err).To(Equal(domain.ErrCancellationNotAllowed))
+
+			mockOrderStore.EXPECT().Cancel(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (*domain.Order, error) {
+				return &o, nil
 			})
+			wg := expectBackgroundWork(order)
 
-			updatedOrder, err := orderService.UpdateStatus(1, domain.OrderStatusCancelled)
+			result, err := orderService.Cancel(context.Background(), 1, request, domain.StaffRoleManager)
 
-			Expect(err).To(BeNil())
-			Expect(updatedOrder).NotTo(BeNil())
-			Expect(updatedOrder.Status).To(Equal(domain.OrderStatusCancelled))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Cancellation.Waste).To(Equal([]domain.Dish{{Name: "Pizza"}}))
+			Expect(result.Cancellation.Refund.Type).To(Equal(domain.RefundPartial))
 
 			wg.Wait()
 		})
+
+		It("should follow the configured policy", func() {
+			policy, err := domain.DefaultCancellationPolicy().Override("preparing=cook")
+			Expect(err).ToNot(HaveOccurred())
+			orderService = services.NewOrderService(mockOrderStore, mockPriorityQueue, mockStatusStore, services.WithCancellationPolicy(policy))
+
+			order := &domain.Order{ID: 1, Status: domain.OrderStatusPreparing}
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(order, nil)
+			mockOrderStore.EXPECT().Cancel(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (*domain.Order, error) {
+				return &o, nil
+			})
+			wg := expectBackgroundWork(order)
+
+			_, err = orderService.Cancel(context.Background(), 1, request, domain.StaffRoleCook)
+
+			Expect(err).ToNot(HaveOccurred())
+			wg.Wait()
+		})
+
+		It("should refuse callers without a role", func() {
+			order := &domain.Order{ID: 1, Status: domain.OrderStatusPending}
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(order, nil)
+
+			_, err := orderService.Cancel(context.Background(), 1, request, "")
+
+			Expect(err).To(Equal(domain.ErrCancellationNotAllowed))
+		})
+
+		It("should require a known reason and a note for other reasons", func() {
+			_, err := orderService.Cancel(context.Background(), 1, domain.CancelOrder{Reason: "bored"}, domain.StaffRoleManager)
+			Expect(err).To(Equal(domain.ErrInvalidCancellation))
+
+			_, err = orderService.Cancel(context.Background(), 1, domain.CancelOrder{Reason: domain.CancellationReasonOther}, domain.StaffRoleManager)
+			Expect(err).To(Equal(domain.ErrInvalidCancellation))
+		})
+
+		It("should not cancel completed orders", func() {
+			order := &domain.Order{ID: 1, Status: domain.OrderStatusDone}
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(order, nil)
+
+			_, err := orderService.Cancel(context.Background(), 1, request, domain.StaffRoleManager)
+
+			Expect(err).To(Equal(domain.ErrCompleteOrderUpdate))
+		})
+	})
+
+	Context("UpdateStatus for delivery orders", func() {
+		DescribeTable("status transitions", func(source domain.OrderSource, from, to domain.OrderStatus, valid bool) {
+			order := &domain.Order{ID: 1, Status: from, NewOrder: domain.NewOrder{Source: source}}
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(order, nil)
+
+			if !valid {
+				result, err := orderService.UpdateStatus(context.Background(), 1, to)
+				Expect(result).To(BeNil())
+				Expect(err).To(Equal(domain.ErrInvalidOrderUpdate))
+				return
+			}
+
+			// They already left the kitchen queue while waiting for their courier
+			var wg sync.WaitGroup
+			wg.Add(1)
+			mockOrderStore.EXPECT().Save(gomock.Any(), gomock.Any()).Return(order, nil)
+			mockStatusStore.EXPECT().AddCurrentStatus(gomock.Any(), order).Do(func(_ context.Context, o *domain.Order) {
+				wg.Done()
+			})
+
+			result, err := orderService.UpdateStatus(context.Background(), 1, to)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(to))
+
+			wg.Wait()
+		},
+			Entry("delivery orders go out for delivery", domain.OrderSourceDelivery, domain.OrderStatusAwaitingCourier, domain.OrderStatusOutForDelivery, true),
+			Entry("delivery orders are delivered", domain.OrderSourceDelivery, domain.OrderStatusOutForDelivery, domain.OrderStatusDelivered, true),
+			Entry("delivery orders can fail", domain.OrderSourceDelivery, domain.OrderStatusOutForDelivery, domain.OrderStatusDeliveryFailed, true),
+			Entry("delivery orders can't be done", domain.OrderSourceDelivery, domain.OrderStatusReady, domain.OrderStatusDone, false),
+			Entry("delivery orders can't go back", domain.OrderSourceDelivery, domain.OrderStatusOutForDelivery, domain.OrderStatusAwaitingCourier, false),
+			Entry("other orders can't be delivered", domain.OrderSourcePhone, domain.OrderStatusReady, domain.OrderStatusDelivered, false),
+			Entry("other orders can't wait for a courier", domain.OrderSourceInPerson, domain.OrderStatusReady, domain.OrderStatusAwaitingCourier, false),
+		)
+	})
+
+	It("should take delivery orders out of the kitchen queue once they wait for a courier", func() {
+		order := &domain.Order{ID: 1, Status: domain.OrderStatusReady, NewOrder: domain.NewOrder{Source: domain.OrderSourceDelivery}}
+		mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(order, nil)
+		mockOrderStore.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (*domain.Order, error) {
+			return &o, nil
+		})
+
+		var wg sync.WaitGroup
+		wg.Add(2)
+		mockStatusStore.EXPECT().AddCurrentStatus(gomock.Any(), gomock.Any()).Do(func(_ context.Context, o *domain.Order) { wg.Done() })
+		mockPriorityQueue.EXPECT().Remove(gomock.Any(), uint(1)).Do(func(_ context.Context, id uint) { wg.Done() })
+
+		result, err := orderService.UpdateStatus(context.Background(), 1, domain.OrderStatusAwaitingCourier)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Status).To(Equal(domain.OrderStatusAwaitingCourier))
+
+		wg.Wait()
+	})
+
+	Context("AssignCourier", func() {
+		It("should store the courier of delivery orders", func() {
+			order := &domain.Order{ID: 1, Status: domain.OrderStatusReady, NewOrder: domain.NewOrder{Source: domain.OrderSourceDelivery}}
+
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(order, nil)
+			mockOrderStore.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (*domain.Order, error) {
+				return &o, nil
+			})
+
+			result, err := orderService.AssignCourier(context.Background(), 1, domain.Courier{Name: "Carla"})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Courier.Name).To(Equal("Carla"))
+			Expect(result.Courier.AssignedAt).ToNot(BeNil())
+		})
+
+		It("should reject orders that are not deliveries", func() {
+			order := &domain.Order{ID: 1, Status: domain.OrderStatusReady, NewOrder: domain.NewOrder{Source: domain.OrderSourceInPerson}}
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(order, nil)
+
+			result, err := orderService.AssignCourier(context.Background(), 1, domain.Courier{Name: "Carla"})
+
+			Expect(result).To(BeNil())
+			Expect(err).To(Equal(domain.ErrNotDeliveryOrder))
+		})
+
+		It("should reject completed orders", func() {
+			order := &domain.Order{ID: 1, Status: domain.OrderStatusDelivered, NewOrder: domain.NewOrder{Source: domain.OrderSourceDelivery}}
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(order, nil)
+
+			result, err := orderService.AssignCourier(context.Background(), 1, domain.Courier{Name: "Carla"})
+
+			Expect(result).To(BeNil())
+			Expect(err).To(Equal(domain.ErrCompleteOrderUpdate))
+		})
 	})
 
 	Context("UpdateContent", func() {
@@ -206,21 +732,21 @@ var _ = Describe("OrderService", func() {
 			It("should update when the order is in a valid state", func() {
 				fakeOrder := &domain.Order{
 					ID:     fakeID,
-					Status: domain.OrderStatusPreparing,
+					Status: domain.OrderStatusPending,
 				}
-				mockOrderStore.EXPECT().FindByID(fakeID).Return(fakeOrder, nil)
+				mockOrderStore.EXPECT().FindByID(gomock.Any(), fakeID).Return(fakeOrder, nil)
 
 				updatedOrder := &domain.Order{
 					ID:     fakeID,
-					Status: domain.OrderStatusPreparing,
+					Status: domain.OrderStatusPending,
 					NewOrder: domain.NewOrder{
 						Dishes: dishes,
 					},
 				}
 
-				mockOrderStore.EXPECT().Save(gomock.Any()).Return(updatedOrder, nil)
+				mockOrderStore.EXPECT().ReplaceDishes(gomock.Any(), gomock.Any()).Return(updatedOrder, nil)
 
-				result, err := orderService.UpdateDishes(fakeID, dishes)
+				result, err := orderService.UpdateDishes(context.Background(), fakeID, dishes)
 
 				Expect(err).ToNot(HaveOccurred())
 				Expect(result).ToNot(BeNil())
@@ -232,31 +758,520 @@ var _ = Describe("OrderService", func() {
 					ID:     fakeID,
 					Status: status,
 				}
-				mockOrderStore.EXPECT().FindByID(fakeID).Return(fakeOrder, nil)
+				mockOrderStore.EXPECT().FindByID(gomock.Any(), fakeID).Return(fakeOrder, nil)
 
-				result, err := orderService.UpdateDishes(fakeID, dishes)
+				result, err := orderService.UpdateDishes(context.Background(), fakeID, dishes)
 				Expect(result).To(BeNil())
 				Expect(err).To(Equal(domain.ErrInvalidOrderUpdate))
 			},
+				Entry("should error once the kitchen started on them", domain.OrderStatusPreparing),
 				Entry("should error for ready", domain.OrderStatusReady),
 				Entry("should error for done", domain.OrderStatusDone),
 				Entry("should error for cancelled", domain.OrderStatusCancelled),
 			)
 
 			It("should error when the order doesn't exist", func() {
-				mockOrderStore.EXPECT().FindByID(fakeID).Return(nil, nil)
+				mockOrderStore.EXPECT().FindByID(gomock.Any(), fakeID).Return(nil, nil)
 
-				result, err := orderService.UpdateDishes(fakeID, dishes)
+				result, err := orderService.UpdateDishes(context.Background(), fakeID, dishes)
 				Expect(result).To(BeNil())
 				Expect(err).To(Equal(domain.ErrOrderNotFound))
 			})
 		})
 		When("an empty set of dishes is provided", func() {
 			It("should return an error", func() {
-				result, err := orderService.UpdateDishes(123, nil)
+				result, err := orderService.UpdateDishes(context.Background(), 123, nil)
 				Expect(result).To(BeNil())
 				Expect(err).To(Equal(domain.ErrInvalidOrderUpdate))
 			})
 		})
 	})
+	Context("Dish changes", func() {
+		const fakeID uint = 123
+
+		var order *domain.Order
+
+		BeforeEach(func() {
+			order = &domain.Order{
+				ID:     fakeID,
+				Status: domain.OrderStatusPreparing,
+				NewOrder: domain.NewOrder{
+					Dishes: []domain.Dish{
+						{ID: 1, Name: "Pizza", Status: domain.DishStatusPending},
+						{ID: 2, Name: "Salad", Status: domain.DishStatusReady},
+					},
+				},
+			}
+		})
+
+		It("should add dishes to orders in preparation", func() {
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), fakeID).Return(order, nil).Times(2)
+			mockOrderStore.EXPECT().ChangeDish(gomock.Any(), fakeID, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ uint, change domain.DishChange) (*domain.DishChange, error) {
+					Expect(change.Action).To(Equal(domain.DishActionAdded))
+					Expect(change.Dish).To(Equal("Soup"))
+					Expect(change.OrderStatus).To(Equal(domain.OrderStatusPreparing))
+					change.DishID = 3
+					return &change, nil
+				})
+
+			result, err := orderService.AddDish(context.Background(), fakeID, domain.Dish{Name: "Soup"})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(order))
+		})
+
+		It("should void dishes with their reason", func() {
+			request := domain.VoidDish{Reason: domain.CancellationReasonOutOfStock}
+
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), fakeID).Return(order, nil).Times(2)
+			mockOrderStore.EXPECT().ChangeDish(gomock.Any(), fakeID, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ uint, change domain.DishChange) (*domain.DishChange, error) {
+					Expect(change.DishID).To(Equal(uint(2)))
+					Expect(change.Dish).To(Equal("Salad"))
+					Expect(change.PreviousStatus).To(Equal(domain.DishStatusReady))
+					Expect(change.Reason).To(Equal(domain.CancellationReasonOutOfStock))
+					return &change, nil
+				})
+
+			_, err := orderService.VoidDish(context.Background(), fakeID, 2, request)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should reject voids without a valid reason", func() {
+			_, err := orderService.VoidDish(context.Background(), fakeID, 2, domain.VoidDish{Reason: domain.CancellationReasonPaymentFailed})
+			Expect(err).To(Equal(domain.ErrInvalidDishVoid))
+		})
+
+		It("should not void the last dish", func() {
+			order.Dishes[0].Status = domain.DishStatusVoided
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), fakeID).Return(order, nil)
+
+			_, err := orderService.VoidDish(context.Background(), fakeID, 2, domain.VoidDish{Reason: domain.CancellationReasonDuplicate})
+			Expect(err).To(Equal(domain.ErrLastDishVoided))
+		})
+
+		DescribeTable("rejecting changes the statuses don't allow",
+			func(status domain.OrderStatus, action func() error, expected error) {
+				order.Status = status
+				mockOrderStore.EXPECT().FindByID(gomock.Any(), fakeID).Return(order, nil)
+
+				Expect(action()).To(Equal(expected))
+			},
+			Entry("adding to ready orders", domain.OrderStatusReady, func() error {
+				_, err := orderService.AddDish(context.Background(), fakeID, domain.Dish{Name: "Soup"})
+				return err
+			}, domain.ErrDishChangeNotAllowed),
+			Entry("marking dishes ready before preparation", domain.OrderStatusPending, func() error {
+				_, err := orderService.MarkDishReady(context.Background(), fakeID, 1)
+				return err
+			}, domain.ErrDishChangeNotAllowed),
+			Entry("marking ready dishes ready again", domain.OrderStatusPreparing, func() error {
+				_, err := orderService.MarkDishReady(context.Background(), fakeID, 2)
+				return err
+			}, domain.ErrDishChangeNotAllowed),
+			Entry("changing unknown dishes", domain.OrderStatusPreparing, func() error {
+				_, err := orderService.MarkDishReady(context.Background(), fakeID, 9)
+				return err
+			}, domain.ErrDishNotFound),
+			Entry("changing completed orders", domain.OrderStatusDone, func() error {
+				_, err := orderService.MarkDishReady(context.Background(), fakeID, 1)
+				return err
+			}, domain.ErrCompleteOrderUpdate),
+		)
+
+		It("should list the dish history of existing orders", func() {
+			history := []domain.DishChange{{ID: 1, DishID: 2, Action: domain.DishActionReady}}
+
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), fakeID).Return(order, nil)
+			mockOrderStore.EXPECT().GetDishHistory(gomock.Any(), fakeID).Return(history, nil)
+
+			result, err := orderService.FindDishHistory(context.Background(), fakeID)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(history))
+		})
+	})
+
+	Context("Menu catalog checks", func() {
+		var mockCatalog *mocks.MockMenuCatalog
+
+		padThai := &domain.MenuItem{Name: "Pad Thai", Allergens: []domain.Allergen{domain.AllergenPeanuts, domain.AllergenEggs}}
+
+		BeforeEach(func() {
+			mockCatalog = mocks.NewMockMenuCatalog(gomock.NewController(GinkgoT()))
+			orderService = services.NewOrderService(
+				mockOrderStore,
+				mockPriorityQueue,
+				mockStatusStore,
+				services.WithMenuCatalog(mockCatalog),
+			)
+		})
+
+		It("should reject dishes with the allergen of a severe allergy", func() {
+			mockCatalog.EXPECT().FindItem(gomock.Any(), "Pad Thai").Return(padThai, nil)
+
+			order, err := orderService.CreateOrder(context.Background(), domain.NewOrder{
+				Dishes:    []domain.Dish{{Name: "Pad Thai"}},
+				Allergies: []domain.Allergy{{Allergen: domain.AllergenPeanuts, Severe: true}},
+			})
+
+			Expect(order).To(BeNil())
+			Expect(err).To(MatchError(domain.ErrAllergenConflict))
+		})
+
+		It("should create orders with the warnings of other allergies", func() {
+			mockCatalog.EXPECT().FindItem(gomock.Any(), "Pad Thai").Return(padThai, nil)
+			mockCatalog.EXPECT().FindItem(gomock.Any(), "Mystery Curry").Return(nil, nil)
+
+			var wg sync.WaitGroup
+			wg.Add(2)
+			mockOrderStore.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (*domain.Order, error) {
+				o.ID = 1
+				return &o, nil
+			})
+			mockStatusStore.EXPECT().AddCurrentStatus(gomock.Any(), gomock.Any()).Do(func(_ context.Context, o *domain.Order) { wg.Done() })
+			mockPriorityQueue.EXPECT().Add(gomock.Any(), gomock.Any()).Do(func(_ context.Context, o *domain.Order) { wg.Done() })
+
+			order, err := orderService.CreateOrder(context.Background(), domain.NewOrder{
+				Dishes:    []domain.Dish{{Name: "Pad Thai"}, {Name: "Mystery Curry"}},
+				Allergies: []domain.Allergy{{Allergen: domain.AllergenEggs}},
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order.Warnings).To(HaveLen(2))
+			Expect(order.Warnings[0].Allergen).To(Equal(domain.AllergenEggs))
+			Expect(order.Warnings[1].Dish).To(Equal("Mystery Curry"))
+
+			wg.Wait()
+		})
+
+		It("should not look up orders without dietary needs", func() {
+			var wg sync.WaitGroup
+			wg.Add(2)
+			mockOrderStore.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (*domain.Order, error) {
+				return &o, nil
+			})
+			mockStatusStore.EXPECT().AddCurrentStatus(gomock.Any(), gomock.Any()).Do(func(_ context.Context, o *domain.Order) { wg.Done() })
+			mockPriorityQueue.EXPECT().Add(gomock.Any(), gomock.Any()).Do(func(_ context.Context, o *domain.Order) { wg.Done() })
+
+			order, err := orderService.CreateOrder(context.Background(), domain.NewOrder{Dishes: []domain.Dish{{Name: "Pad Thai"}}})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order.Warnings).To(BeEmpty())
+
+			wg.Wait()
+		})
+
+		It("should reject unknown allergens", func() {
+			order, err := orderService.CreateOrder(context.Background(), domain.NewOrder{
+				Dishes:    []domain.Dish{{Name: "Pad Thai"}},
+				Allergies: []domain.Allergy{{Allergen: "chocolate"}},
+			})
+
+			Expect(order).To(BeNil())
+			Expect(err).To(MatchError(domain.ErrInvalidOrderDiet))
+		})
+
+		It("should reject added dishes with the allergen of a severe allergy", func() {
+			existing := &domain.Order{
+				ID:     1,
+				Status: domain.OrderStatusPreparing,
+				NewOrder: domain.NewOrder{
+					Dishes:    []domain.Dish{{ID: 1, Name: "Rice", Status: domain.DishStatusPending}},
+					Allergies: []domain.Allergy{{Allergen: domain.AllergenPeanuts, Severe: true}},
+				},
+			}
+
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(existing, nil)
+			mockCatalog.EXPECT().FindItem(gomock.Any(), "Pad Thai").Return(padThai, nil)
+
+			order, err := orderService.AddDish(context.Background(), 1, domain.Dish{Name: "Pad Thai"})
+
+			Expect(order).To(BeNil())
+			Expect(err).To(MatchError(domain.ErrAllergenConflict))
+		})
+	})
+
+	Context("dish limit", func() {
+		dishes := []domain.Dish{{Name: "Taco"}, {Name: "Taco"}, {Name: "Taco"}}
+
+		BeforeEach(func() {
+			orderService = services.NewOrderService(mockOrderStore, mockPriorityQueue, mockStatusStore, services.WithMaxDishes(2))
+		})
+
+		It("should reject new orders over the limit", func() {
+			order, err := orderService.CreateOrder(context.Background(), domain.NewOrder{Dishes: dishes})
+
+			Expect(order).To(BeNil())
+			Expect(err).To(MatchError(domain.ErrTooManyDishes))
+		})
+
+		It("should reject replacing the dishes past the limit", func() {
+			order, err := orderService.UpdateDishes(context.Background(), 1, dishes)
+
+			Expect(order).To(BeNil())
+			Expect(err).To(MatchError(domain.ErrTooManyDishes))
+		})
+
+		It("should reject adding a dish past the limit, leaving out the voided ones", func() {
+			existing := &domain.Order{
+				ID:     1,
+				Status: domain.OrderStatusPreparing,
+				NewOrder: domain.NewOrder{Dishes: []domain.Dish{
+					{ID: 1, Name: "Taco", Status: domain.DishStatusPending},
+					{ID: 2, Name: "Taco", Status: domain.DishStatusVoided},
+					{ID: 3, Name: "Taco", Status: domain.DishStatusReady},
+				}},
+			}
+
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(existing, nil)
+
+			order, err := orderService.AddDish(context.Background(), 1, domain.Dish{Name: "Soda"})
+
+			Expect(order).To(BeNil())
+			Expect(err).To(MatchError(domain.ErrTooManyDishes))
+		})
+	})
+
+	Context("CreateOrder at tables", func() {
+		var (
+			mockTableStore *mocks.MockTableStore
+			tableID        uint
+		)
+
+		BeforeEach(func() {
+			tableID = 4
+			mockTableStore = mocks.NewMockTableStore(gomock.NewController(GinkgoT()))
+			orderService = services.NewOrderService(
+				mockOrderStore,
+				mockPriorityQueue,
+				mockStatusStore,
+				services.WithTableStore(mockTableStore),
+			)
+		})
+
+		It("should link the order to the open session of its table", func() {
+			table := &domain.Table{ID: tableID, NewTable: domain.NewTable{Name: "T4", Seats: 4}}
+			mockTableStore.EXPECT().FindByID(gomock.Any(), tableID).Return(table, nil)
+			mockTableStore.EXPECT().FindOpenSession(gomock.Any(), tableID).Return(&domain.TableSession{ID: 9, TableID: tableID}, nil)
+
+			var wg sync.WaitGroup
+			wg.Add(2)
+			mockOrderStore.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (*domain.Order, error) {
+				return &o, nil
+			})
+			mockStatusStore.EXPECT().AddCurrentStatus(gomock.Any(), gomock.Any()).Do(func(_ context.Context, o *domain.Order) { wg.Done() })
+			mockPriorityQueue.EXPECT().Add(gomock.Any(), gomock.Any()).Do(func(_ context.Context, o *domain.Order) { wg.Done() })
+
+			order, err := orderService.CreateOrder(context.Background(), domain.NewOrder{
+				Source:  domain.OrderSourceInPerson,
+				Dishes:  []domain.Dish{{Name: "Wine"}},
+				TableID: &tableID,
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*order.TableID).To(Equal(tableID))
+			Expect(*order.TableSessionID).To(Equal(uint(9)))
+
+			wg.Wait()
+		})
+
+		It("should reject tables nobody is seated at", func() {
+			table := &domain.Table{ID: tableID, NewTable: domain.NewTable{Name: "T4", Seats: 4}}
+			mockTableStore.EXPECT().FindByID(gomock.Any(), tableID).Return(table, nil)
+			mockTableStore.EXPECT().FindOpenSession(gomock.Any(), tableID).Return(nil, nil)
+
+			order, err := orderService.CreateOrder(context.Background(), domain.NewOrder{
+				Source:  domain.OrderSourceInPerson,
+				Dishes:  []domain.Dish{{Name: "Wine"}},
+				TableID: &tableID,
+			})
+
+			Expect(order).To(BeNil())
+			Expect(err).To(Equal(domain.ErrTableNotSeated))
+		})
+
+		It("should reject unknown tables", func() {
+			mockTableStore.EXPECT().FindByID(gomock.Any(), tableID).Return(nil, nil)
+
+			_, err := orderService.CreateOrder(context.Background(), domain.NewOrder{
+				Source:  domain.OrderSourceInPerson,
+				Dishes:  []domain.Dish{{Name: "Wine"}},
+				TableID: &tableID,
+			})
+
+			Expect(err).To(Equal(domain.ErrUnknownOrderTable))
+		})
+
+		It("should reject tables for other sources", func() {
+			_, err := orderService.CreateOrder(context.Background(), domain.NewOrder{
+				Source:  domain.OrderSourcePhone,
+				Dishes:  []domain.Dish{{Name: "Wine"}},
+				TableID: &tableID,
+			})
+
+			Expect(err).To(Equal(domain.ErrNotDineInOrder))
+		})
+	})
+
+	Context("Order events", func() {
+		var (
+			clock         *fakeclock.Clock
+			mockPublisher *mocks.MockEventPublisher
+			published     []domain.OrderEvent
+		)
+
+		BeforeEach(func() {
+			clock = fakeclock.New(time.Date(2025, 2, 10, 12, 0, 0, 0, time.UTC))
+			mockPublisher = mocks.NewMockEventPublisher(gomock.NewController(GinkgoT()))
+			published = nil
+
+			mockPublisher.EXPECT().Publish(gomock.Any()).Do(func(event domain.OrderEvent) {
+				published = append(published, event)
+			}).AnyTimes()
+
+			orderService = services.NewOrderService(
+				mockOrderStore,
+				mockPriorityQueue,
+				mockStatusStore,
+				services.WithClock(clock),
+				services.WithEventPublisher(mockPublisher),
+			)
+		})
+
+		It("should publish created orders", func() {
+			order := &domain.Order{ID: 1, Status: domain.OrderStatusPending}
+
+			var wg sync.WaitGroup
+			wg.Add(2)
+			mockOrderStore.EXPECT().Save(gomock.Any(), gomock.Any()).Return(order, nil)
+			mockStatusStore.EXPECT().AddCurrentStatus(gomock.Any(), order).Do(func(_ context.Context, o *domain.Order) { wg.Done() })
+			mockPriorityQueue.EXPECT().Add(gomock.Any(), order).Do(func(_ context.Context, o *domain.Order) { wg.Done() })
+
+			_, err := orderService.CreateOrder(context.Background(), domain.NewOrder{Dishes: []domain.Dish{{Name: "Pizza"}}})
+			Expect(err).ToNot(HaveOccurred())
+			wg.Wait()
+
+			Expect(published).To(HaveLen(1))
+			Expect(published[0].Type).To(Equal(domain.OrderEventCreated))
+			Expect(published[0].Order.ID).To(Equal(uint(1)))
+			Expect(published[0].Timestamp).To(Equal(clock.Now()))
+		})
+
+		It("should publish status changes and cancellations with their refund", func() {
+			order := &domain.Order{ID: 1, Status: domain.OrderStatusPending, NewOrder: domain.NewOrder{
+				Source:   domain.OrderSourceDelivery,
+				Delivery: &domain.DeliveryDetails{FeeCents: 500},
+			}}
+
+			var wg sync.WaitGroup
+			wg.Add(2)
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(order, nil)
+			mockOrderStore.EXPECT().Cancel(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (*domain.Order, error) {
+				return &o, nil
+			})
+			mockStatusStore.EXPECT().AddCurrentStatus(gomock.Any(), gomock.Any()).Do(func(_ context.Context, o *domain.Order) { wg.Done() })
+			mockPriorityQueue.EXPECT().Remove(gomock.Any(), order.ID).Do(func(_ context.Context, id uint) { wg.Done() })
+
+			request := domain.CancelOrder{Reason: domain.CancellationReasonOutOfStock}
+			_, err := orderService.Cancel(context.Background(), 1, request, domain.StaffRoleCook)
+			Expect(err).ToNot(HaveOccurred())
+			wg.Wait()
+
+			Expect(published).To(HaveLen(2))
+			Expect(published[0].Type).To(Equal(domain.OrderEventStatusChanged))
+			Expect(published[1].Type).To(Equal(domain.OrderEventCancelled))
+			Expect(published[1].Order.Cancellation.Reason).To(Equal(domain.CancellationReasonOutOfStock))
+			Expect(published[1].Order.Cancellation.Refund).To(Equal(domain.Refund{Type: domain.RefundFull, DeliveryFeeCents: 500}))
+		})
+
+		It("should publish updated orders", func() {
+			order := &domain.Order{ID: 1, Status: domain.OrderStatusPending}
+			dishes := []domain.Dish{{Name: "Soup"}}
+
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(order, nil)
+			mockOrderStore.EXPECT().ReplaceDishes(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (*domain.Order, error) {
+				return &o, nil
+			})
+
+			_, err := orderService.UpdateDishes(context.Background(), 1, dishes)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(published).To(HaveLen(1))
+			Expect(published[0].Type).To(Equal(domain.OrderEventUpdated))
+			Expect(published[0].Order.Dishes).To(Equal(domain.NewDishes(dishes)))
+		})
+
+		It("should not publish failed updates", func() {
+			order := &domain.Order{ID: 1, Status: domain.OrderStatusPending}
+
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(order, nil)
+			mockOrderStore.EXPECT().ReplaceDishes(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+
+			_, err := orderService.UpdateDishes(context.Background(), 1, []domain.Dish{{Name: "Soup"}})
+			Expect(err).To(HaveOccurred())
+
+			Expect(published).To(BeEmpty())
+		})
+	})
+
+	Context("Order metrics", func() {
+		var mockMetrics *mocks.MockOrderMetrics
+
+		BeforeEach(func() {
+			mockMetrics = mocks.NewMockOrderMetrics(gomock.NewController(GinkgoT()))
+
+			orderService = services.NewOrderService(
+				mockOrderStore,
+				mockPriorityQueue,
+				mockStatusStore,
+				services.WithMetrics(mockMetrics),
+			)
+		})
+
+		It("should count created orders by source", func() {
+			order := &domain.Order{ID: 1, Status: domain.OrderStatusPending, NewOrder: domain.NewOrder{Source: domain.OrderSourcePhone}}
+
+			var wg sync.WaitGroup
+			wg.Add(2)
+			mockOrderStore.EXPECT().Save(gomock.Any(), gomock.Any()).Return(order, nil)
+			mockStatusStore.EXPECT().AddCurrentStatus(gomock.Any(), order).Do(func(_ context.Context, o *domain.Order) { wg.Done() })
+			mockPriorityQueue.EXPECT().Add(gomock.Any(), order).Do(func(_ context.Context, o *domain.Order) { wg.Done() })
+			mockMetrics.EXPECT().OrderCreated(domain.OrderSourcePhone)
+
+			_, err := orderService.CreateOrder(context.Background(), domain.NewOrder{Source: domain.OrderSourcePhone, Dishes: []domain.Dish{{Name: "Pizza"}}})
+			Expect(err).ToNot(HaveOccurred())
+			wg.Wait()
+		})
+
+		It("should count status transitions and cancellations", func() {
+			order := &domain.Order{ID: 1, Status: domain.OrderStatusPreparing, NewOrder: domain.NewOrder{Source: domain.OrderSourceDelivery}}
+
+			var wg sync.WaitGroup
+			wg.Add(2)
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(order, nil)
+			mockOrderStore.EXPECT().Cancel(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (*domain.Order, error) {
+				return &o, nil
+			})
+			mockStatusStore.EXPECT().AddCurrentStatus(gomock.Any(), gomock.Any()).Do(func(_ context.Context, o *domain.Order) { wg.Done() })
+			mockPriorityQueue.EXPECT().Remove(gomock.Any(), order.ID).Do(func(_ context.Context, id uint) { wg.Done() })
+			mockMetrics.EXPECT().StatusChanged(domain.OrderStatusPreparing, domain.OrderStatusCancelled)
+			mockMetrics.EXPECT().OrderCancelled(domain.OrderSourceDelivery, domain.CancellationReasonKitchenError)
+
+			request := domain.CancelOrder{Reason: domain.CancellationReasonKitchenError}
+			_, err := orderService.Cancel(context.Background(), 1, request, domain.StaffRoleManager)
+			Expect(err).ToNot(HaveOccurred())
+			wg.Wait()
+		})
+
+		It("should count prioritizations only when they succeed", func() {
+			mockPriorityQueue.EXPECT().ShuffleAfter(gomock.Any(), uint(3), uint(1)).Return(nil)
+			mockPriorityQueue.EXPECT().ShuffleAfter(gomock.Any(), uint(4), uint(1)).Return(domain.ErrOrderNotFound)
+			mockMetrics.EXPECT().OrderPrioritized().Times(1)
+
+			Expect(orderService.Prioritize(context.Background(), 3, 1)).To(Succeed())
+			Expect(orderService.Prioritize(context.Background(), 4, 1)).To(MatchError(domain.ErrOrderNotFound))
+		})
+	})
 })
@@ -1,8 +1,15 @@
 package services
 
-import "github.com/danbrato999/yuno-gveloz/domain"
+import (
+	"context"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+)
 
 type OrderStatusStore interface {
-	AddCurrentStatus(order *domain.Order) error
-	GetHistory(id uint) ([]domain.OrderStatusHistory, error)
+	AddCurrentStatus(ctx context.Context, order *domain.Order) error
+	// AddHistory records the status changes of an order as they happened, for the imported orders
+	AddHistory(ctx context.Context, id uint, history []domain.OrderStatusHistory) error
+	GetHistory(ctx context.Context, id uint) ([]domain.OrderStatusHistory, error)
+	GetHistories(ctx context.Context, ids []uint) (map[uint][]domain.OrderStatusHistory, error)
 }
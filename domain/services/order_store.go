@@ -1,9 +1,35 @@
 package services
 
-import "github.com/danbrato999/yuno-gveloz/domain"
+import (
+	"context"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+)
 
 type OrderStore interface {
-	Save(order domain.Order) (*domain.Order, error)
-	FindByID(id uint) (*domain.Order, error)
-	GetAll(filters *domain.OrderFilters) ([]domain.Order, error)
+	// Save only writes the dishes of new orders, stored ones change through ReplaceDishes and ChangeDish.
+	// New orders with an external reference that is already stored fail with ErrDuplicateExternalOrder
+	Save(ctx context.Context, order domain.Order) (*domain.Order, error)
+	// ReplaceDishes replaces the whole dish list of a stored order, only while the order is still in its status
+	ReplaceDishes(ctx context.Context, order domain.Order) (*domain.Order, error)
+	FindByID(ctx context.Context, id uint) (*domain.Order, error)
+	FindByExternalID(ctx context.Context, provider string, externalID string) (*domain.Order, error)
+	GetAll(ctx context.Context, filters *domain.OrderFilters) ([]domain.Order, error)
+	// Count ignores the page of the filters
+	Count(ctx context.Context, filters *domain.OrderFilters) (int, error)
+	// Iterate goes through the filtered orders a batch at a time by id, leaving out the sorts and the page,
+	// and stops at the first error fn returns
+	Iterate(ctx context.Context, filters *domain.OrderFilters, batchSize int, fn func(batch []domain.Order) error) error
+	// MarkLate flags the order as late only if it's still in the given status, returning whether it was flagged
+	MarkLate(ctx context.Context, id uint, status domain.OrderStatus, at time.Time) (bool, error)
+	// Cancel saves the cancelled order along with its cancellation and waste records, all at once
+	Cancel(ctx context.Context, order domain.Order) (*domain.Order, error)
+	// GetWaste lists the waste recorded between the given times, oldest first
+	GetWaste(ctx context.Context, from time.Time, to time.Time) ([]domain.WasteRecord, error)
+	// ChangeDish applies the change to a single dish and records it in the dish history, only while the
+	// order and the dish are still in the statuses the change was made for
+	ChangeDish(ctx context.Context, orderID uint, change domain.DishChange) (*domain.DishChange, error)
+	// GetDishHistory lists the changes of the dishes of an order, oldest first
+	GetDishHistory(ctx context.Context, orderID uint) ([]domain.DishChange, error)
 }
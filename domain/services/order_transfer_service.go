@@ -0,0 +1,200 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const DefaultExportBatchSize = 500
+
+// OrderImportReader yields the rows of an import file one at a time, returning io.EOF after the
+// last one. Any other error means the file can't be read any further
+type OrderImportReader interface {
+	Next() (domain.ImportRow, error)
+}
+
+type OrderTransferService interface {
+	// Import validates every row, saving the valid ones unless it's a dry run. Imported orders
+	// don't trigger order events, as they are usually history
+	Import(ctx context.Context, reader OrderImportReader, dryRun bool) (*domain.ImportReport, error)
+	// Export hands the filtered orders to fn a batch at a time, so they never need to fit in memory
+	Export(ctx context.Context, fn func(batch []domain.OrderWithStatusHistory) error, filters ...domain.OrderFilterFn) error
+}
+
+type orderTransferServiceImpl struct {
+	orderStore    OrderStore
+	statusStore   OrderStatusStore
+	priorityQueue PriorityQueue
+	customerStore CustomerStore
+	batchSize     int
+	maxDishes     int
+}
+
+type OrderTransferOption func(s *orderTransferServiceImpl)
+
+// WithImportMaxDishes rejects the imported orders with more dishes, like WithMaxDishes does for new orders
+func WithImportMaxDishes(maxDishes int) OrderTransferOption {
+	return func(s *orderTransferServiceImpl) {
+		s.maxDishes = maxDishes
+	}
+}
+
+// NewOrderTransferService accepts a nil customer store, skipping the validation of the order customers
+func NewOrderTransferService(
+	orderStore OrderStore,
+	statusStore OrderStatusStore,
+	priorityQueue PriorityQueue,
+	customerStore CustomerStore,
+	options ...OrderTransferOption,
+) OrderTransferService {
+	service := &orderTransferServiceImpl{
+		orderStore:    orderStore,
+		statusStore:   statusStore,
+		priorityQueue: priorityQueue,
+		customerStore: customerStore,
+		batchSize:     DefaultExportBatchSize,
+	}
+
+	for _, option := range options {
+		option(service)
+	}
+
+	return service
+}
+
+func (s *orderTransferServiceImpl) Import(
+	ctx context.Context,
+	reader OrderImportReader,
+	dryRun bool,
+) (_ *domain.ImportReport, err error) {
+	ctx, span := tracer.Start(ctx, "OrderTransferService.Import", trace.WithAttributes(attribute.Bool("import.dry_run", dryRun)))
+	defer func() { endSpan(span, err) }()
+
+	report := &domain.ImportReport{DryRun: dryRun, Errors: []domain.ImportRowError{}}
+	defer func() {
+		span.SetAttributes(attribute.Int("import.imported", report.Imported), attribute.Int("import.failed", report.Failed))
+	}()
+
+	for {
+		// Dry runs never reach the stores, so nothing else would notice the request going away
+		if err = ctx.Err(); err != nil {
+			return report, err
+		}
+
+		row, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return report, nil
+		}
+
+		if err != nil {
+			return report, err
+		}
+
+		report.Total++
+
+		if row.ParseError != nil {
+			report.AddError(row.Number, row.ParseError)
+			continue
+		}
+
+		order, err := s.prepareImport(ctx, row.Order)
+		if err != nil {
+			if !isImportRowError(err) {
+				return report, err
+			}
+
+			report.AddError(row.Number, err)
+			continue
+		}
+
+		if !dryRun {
+			if err = s.save(ctx, order); err != nil {
+				return report, err
+			}
+		}
+
+		report.Imported++
+	}
+}
+
+func (s *orderTransferServiceImpl) Export(
+	ctx context.Context,
+	fn func(batch []domain.OrderWithStatusHistory) error,
+	filters ...domain.OrderFilterFn,
+) (err error) {
+	ctx, span := tracer.Start(ctx, "OrderTransferService.Export")
+	defer func() { endSpan(span, err) }()
+
+	return s.orderStore.Iterate(ctx, applyFilters(filters), s.batchSize, func(batch []domain.Order) error {
+		ids := make([]uint, len(batch))
+		for i, order := range batch {
+			ids[i] = order.ID
+		}
+
+		histories, err := s.statusStore.GetHistories(ctx, ids)
+		if err != nil {
+			return err
+		}
+
+		result := make([]domain.OrderWithStatusHistory, len(batch))
+		for i, order := range batch {
+			result[i] = domain.OrderWithStatusHistory{
+				Order:         order,
+				StatusHistory: histories[order.ID],
+			}
+		}
+
+		return fn(result)
+	})
+}
+
+func (s *orderTransferServiceImpl) prepareImport(
+	ctx context.Context,
+	imported domain.ImportedOrder,
+) (domain.OrderWithStatusHistory, error) {
+	order, err := imported.ToOrder()
+	if err != nil {
+		return order, err
+	}
+
+	if err = domain.CheckDishLimit(len(order.Dishes), s.maxDishes); err != nil {
+		return order, fmt.Errorf("%w: %s", domain.ErrInvalidImportedOrder, err.Error())
+	}
+
+	order.NewOrder, err = resolveOrderCustomer(ctx, s.customerStore, order.NewOrder)
+	return order, err
+}
+
+// Imported orders skip the kitchen queue unless they are still in the kitchen
+func (s *orderTransferServiceImpl) save(ctx context.Context, order domain.OrderWithStatusHistory) error {
+	result, err := s.orderStore.Save(ctx, order.Order)
+	if err != nil {
+		return err
+	}
+
+	if len(order.StatusHistory) > 0 {
+		err = s.statusStore.AddHistory(ctx, result.ID, order.StatusHistory)
+	} else {
+		err = s.statusStore.AddCurrentStatus(ctx, result)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if !result.Status.InKitchen() {
+		return nil
+	}
+
+	return s.priorityQueue.Add(ctx, result)
+}
+
+func isImportRowError(err error) bool {
+	return errors.Is(err, domain.ErrInvalidImportedOrder) || errors.Is(err, domain.ErrUnknownOrderCustomer)
+}
@@ -0,0 +1,260 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+type rowsReader struct {
+	rows []domain.ImportRow
+	err  error
+}
+
+func (r *rowsReader) Next() (domain.ImportRow, error) {
+	if len(r.rows) == 0 {
+		if r.err != nil {
+			return domain.ImportRow{}, r.err
+		}
+
+		return domain.ImportRow{}, io.EOF
+	}
+
+	row := r.rows[0]
+	r.rows = r.rows[1:]
+	return row, nil
+}
+
+var _ = Describe("OrderTransferService", func() {
+	var (
+		mockOrderStore    *mocks.MockOrderStore
+		mockStatusStore   *mocks.MockOrderStatusStore
+		mockPriorityQueue *mocks.MockPriorityQueue
+		mockCustomerStore *mocks.MockCustomerStore
+		transferService   services.OrderTransferService
+		orderTime         time.Time
+	)
+
+	BeforeEach(func() {
+		mockCtrl := gomock.NewController(GinkgoT())
+		mockOrderStore = mocks.NewMockOrderStore(mockCtrl)
+		mockStatusStore = mocks.NewMockOrderStatusStore(mockCtrl)
+		mockPriorityQueue = mocks.NewMockPriorityQueue(mockCtrl)
+		mockCustomerStore = mocks.NewMockCustomerStore(mockCtrl)
+		transferService = services.NewOrderTransferService(mockOrderStore, mockStatusStore, mockPriorityQueue, mockCustomerStore)
+		orderTime = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	})
+
+	importedOrder := func(status domain.OrderStatus) domain.ImportedOrder {
+		return domain.ImportedOrder{
+			NewOrder: domain.NewOrder{
+				Time:   orderTime,
+				Dishes: []domain.Dish{{Name: "Pizza"}},
+				Source: domain.OrderSourceInPerson,
+			},
+			Status: status,
+		}
+	}
+
+	Context("Import", func() {
+		It("should save the valid rows and report the invalid ones", func() {
+			customerID := uint(9)
+			unknownCustomer := importedOrder(domain.OrderStatusDone)
+			unknownCustomer.CustomerID = &customerID
+
+			reader := &rowsReader{rows: []domain.ImportRow{
+				{Number: 2, Order: importedOrder("")},
+				{Number: 3, ParseError: errors.New("invalid time")},
+				{Number: 4, Order: importedOrder(domain.OrderStatusScheduled)},
+				{Number: 5, Order: unknownCustomer},
+				{Number: 6, Order: importedOrder(domain.OrderStatusPreparing)},
+			}}
+
+			mockCustomerStore.EXPECT().FindByID(gomock.Any(), customerID).Return(nil, nil)
+
+			done := &domain.Order{ID: 1, Status: domain.OrderStatusDone}
+			preparing := &domain.Order{ID: 2, Status: domain.OrderStatusPreparing}
+
+			gomock.InOrder(
+				mockOrderStore.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, order domain.Order) (*domain.Order, error) {
+					Expect(order.Status).To(Equal(domain.OrderStatusDone))
+					Expect(order.Time).To(Equal(orderTime))
+					return done, nil
+				}),
+				mockOrderStore.EXPECT().Save(gomock.Any(), gomock.Any()).Return(preparing, nil),
+			)
+			mockStatusStore.EXPECT().AddCurrentStatus(gomock.Any(), done).Return(nil)
+			mockStatusStore.EXPECT().AddCurrentStatus(gomock.Any(), preparing).Return(nil)
+			mockPriorityQueue.EXPECT().Add(gomock.Any(), preparing).Return(nil)
+
+			report, err := transferService.Import(context.Background(), reader, false)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report.Total).To(Equal(5))
+			Expect(report.Imported).To(Equal(2))
+			Expect(report.Failed).To(Equal(3))
+			Expect(report.Errors).To(HaveLen(3))
+			Expect(report.Errors[0]).To(Equal(domain.ImportRowError{Row: 3, Error: "invalid time"}))
+			Expect(report.Errors[1].Row).To(Equal(4))
+			Expect(report.Errors[2]).To(Equal(domain.ImportRowError{Row: 5, Error: domain.ErrUnknownOrderCustomer.Error()}))
+		})
+
+		It("should keep the delivery orders waiting for their courier out of the kitchen queue", func() {
+			awaiting := importedOrder(domain.OrderStatusAwaitingCourier)
+			awaiting.Source = domain.OrderSourceDelivery
+			outForDelivery := importedOrder(domain.OrderStatusOutForDelivery)
+			outForDelivery.Source = domain.OrderSourceDelivery
+
+			reader := &rowsReader{rows: []domain.ImportRow{
+				{Number: 2, Order: awaiting},
+				{Number: 3, Order: outForDelivery},
+			}}
+
+			mockOrderStore.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, order domain.Order) (*domain.Order, error) {
+				return &order, nil
+			}).Times(2)
+			mockStatusStore.EXPECT().AddCurrentStatus(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+			report, err := transferService.Import(context.Background(), reader, false)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report.Imported).To(Equal(2))
+		})
+
+		It("should keep the creation time and status history of exported orders", func() {
+			doneAt := orderTime.Add(15 * time.Minute)
+			history := []domain.OrderStatusHistory{
+				{Status: domain.OrderStatusPending, Timestamp: &orderTime},
+				{Status: domain.OrderStatusDone, Timestamp: &doneAt},
+			}
+
+			exported := importedOrder("")
+			exported.CreatedAt = &orderTime
+			exported.StatusHistory = history
+
+			reader := &rowsReader{rows: []domain.ImportRow{{Number: 2, Order: exported}}}
+
+			mockOrderStore.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, order domain.Order) (*domain.Order, error) {
+				Expect(order.CreatedAt).To(Equal(&orderTime))
+				Expect(order.Status).To(Equal(domain.OrderStatusDone))
+				order.ID = 7
+				return &order, nil
+			})
+			mockStatusStore.EXPECT().AddHistory(gomock.Any(), uint(7), history).Return(nil)
+
+			report, err := transferService.Import(context.Background(), reader, false)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report.Imported).To(Equal(1))
+		})
+
+		It("should only validate the rows in a dry run", func() {
+			reader := &rowsReader{rows: []domain.ImportRow{
+				{Number: 2, Order: importedOrder(domain.OrderStatusDone)},
+				{Number: 3, Order: importedOrder("lost")},
+			}}
+
+			report, err := transferService.Import(context.Background(), reader, true)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report.DryRun).To(BeTrue())
+			Expect(report.Imported).To(Equal(1))
+			Expect(report.Failed).To(Equal(1))
+		})
+
+		It("should report the rows with too many dishes", func() {
+			transferService = services.NewOrderTransferService(
+				mockOrderStore,
+				mockStatusStore,
+				mockPriorityQueue,
+				mockCustomerStore,
+				services.WithImportMaxDishes(1),
+			)
+
+			crowded := importedOrder(domain.OrderStatusDone)
+			crowded.Dishes = append(crowded.Dishes, domain.Dish{Name: "Soda"})
+
+			report, err := transferService.Import(context.Background(), &rowsReader{rows: []domain.ImportRow{{Number: 2, Order: crowded}}}, true)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report.Failed).To(Equal(1))
+			Expect(report.Errors[0].Error).To(ContainSubstring("orders can't have more than 1 dishes"))
+		})
+
+		It("should stop once the context is cancelled", func() {
+			reader := &rowsReader{rows: []domain.ImportRow{{Number: 2, Order: importedOrder(domain.OrderStatusDone)}}}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			report, err := transferService.Import(ctx, reader, true)
+
+			Expect(err).To(MatchError(context.Canceled))
+			Expect(report.Total).To(Equal(0))
+		})
+
+		It("should stop when the file can't be read", func() {
+			testErr := errors.New("unexpected EOF")
+			reader := &rowsReader{
+				rows: []domain.ImportRow{{Number: 2, Order: importedOrder(domain.OrderStatusDone)}},
+				err:  testErr,
+			}
+
+			report, err := transferService.Import(context.Background(), reader, true)
+
+			Expect(err).To(Equal(testErr))
+			Expect(report.Total).To(Equal(1))
+		})
+
+		It("should stop when an order can't be saved", func() {
+			testErr := errors.New("database is locked")
+			reader := &rowsReader{rows: []domain.ImportRow{
+				{Number: 2, Order: importedOrder(domain.OrderStatusDone)},
+				{Number: 3, Order: importedOrder(domain.OrderStatusDone)},
+			}}
+
+			mockOrderStore.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil, testErr)
+
+			report, err := transferService.Import(context.Background(), reader, false)
+
+			Expect(err).To(Equal(testErr))
+			Expect(report.Imported).To(Equal(0))
+		})
+	})
+
+	Context("Export", func() {
+		It("should hand the orders with their history a batch at a time", func() {
+			history := []domain.OrderStatusHistory{{Status: domain.OrderStatusDone, Timestamp: &orderTime}}
+
+			mockOrderStore.EXPECT().
+				Iterate(gomock.Any(), &domain.OrderFilters{AnyStatus: []domain.OrderStatus{domain.OrderStatusDone}}, services.DefaultExportBatchSize, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ *domain.OrderFilters, _ int, fn func([]domain.Order) error) error {
+					Expect(fn([]domain.Order{{ID: 1}, {ID: 2}})).To(Succeed())
+					return fn([]domain.Order{{ID: 3}})
+				})
+			mockStatusStore.EXPECT().GetHistories(gomock.Any(), []uint{1, 2}).Return(map[uint][]domain.OrderStatusHistory{1: history}, nil)
+			mockStatusStore.EXPECT().GetHistories(gomock.Any(), []uint{3}).Return(map[uint][]domain.OrderStatusHistory{}, nil)
+
+			var batches [][]domain.OrderWithStatusHistory
+
+			err := transferService.Export(context.Background(), func(batch []domain.OrderWithStatusHistory) error {
+				batches = append(batches, batch)
+				return nil
+			}, domain.FilterByStatus(domain.OrderStatusDone))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(batches).To(HaveLen(2))
+			Expect(batches[0][0].StatusHistory).To(Equal(history))
+			Expect(batches[0][1].StatusHistory).To(BeNil())
+			Expect(batches[1][0].ID).To(Equal(uint(3)))
+		})
+	})
+})
@@ -1,9 +1,16 @@
 package services
 
-import "github.com/danbrato999/yuno-gveloz/domain"
+import (
+	"context"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+)
 
 type PriorityQueue interface {
-	Add(order *domain.Order) error
-	ShuffleAfter(id, targetID uint) error
-	Remove(id uint) error
+	Add(ctx context.Context, order *domain.Order) error
+	ShuffleAfter(ctx context.Context, id, targetID uint) error
+	Remove(ctx context.Context, id uint) error
+	GetPositions(ctx context.Context) ([]domain.QueuePosition, error)
+	GetPosition(ctx context.Context, id uint) (*domain.QueuePosition, error)
+	Length(ctx context.Context) (int64, error)
 }
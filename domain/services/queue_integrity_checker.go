@@ -0,0 +1,14 @@
+package services
+
+import (
+	"context"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+)
+
+type QueueIntegrityChecker interface {
+	Verify(ctx context.Context) (*domain.QueueIntegrityReport, error)
+	// Repair renumbers the queue densely keeping the relative order of the queued orders,
+	// and returns the report of the issues found before repairing
+	Repair(ctx context.Context) (*domain.QueueIntegrityReport, error)
+}
@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type QueueService interface {
+	GetQueue(ctx context.Context) ([]domain.QueuedOrder, error)
+	GetOrderPosition(ctx context.Context, id uint) (*domain.QueuedOrder, error)
+}
+
+type queueServiceImpl struct {
+	orderStore    OrderStore
+	priorityQueue PriorityQueue
+	clock         domain.Clock
+}
+
+func NewQueueService(store OrderStore, priorityQueue PriorityQueue, clock domain.Clock) QueueService {
+	return &queueServiceImpl{
+		orderStore:    store,
+		priorityQueue: priorityQueue,
+		clock:         clock,
+	}
+}
+
+func (s *queueServiceImpl) GetQueue(ctx context.Context) (_ []domain.QueuedOrder, err error) {
+	ctx, span := tracer.Start(ctx, "QueueService.GetQueue")
+	defer func() { endSpan(span, err) }()
+
+	positions, err := s.priorityQueue.GetPositions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := &domain.OrderFilters{}
+	domain.FilterActive(filters)
+	filters.AnyStatus = domain.KitchenStatuses
+
+	orders, err := s.orderStore.GetAll(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	ordersByID := make(map[uint]domain.Order, len(orders))
+	for _, order := range orders {
+		ordersByID[order.ID] = order
+	}
+
+	now := s.clock.Now()
+	result := make([]domain.QueuedOrder, 0, len(positions))
+
+	for _, position := range positions {
+		order, ok := ordersByID[position.OrderID]
+
+		// Positions of the orders that left the kitchen may linger until they are removed
+		if !ok {
+			continue
+		}
+
+		result = append(result, toQueuedOrder(order, position, now))
+	}
+
+	return result, nil
+}
+
+func (s *queueServiceImpl) GetOrderPosition(ctx context.Context, id uint) (_ *domain.QueuedOrder, err error) {
+	ctx, span := tracer.Start(ctx, "QueueService.GetOrderPosition", trace.WithAttributes(orderIDAttribute(id)))
+	defer func() { endSpan(span, err) }()
+
+	order, err := s.orderStore.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if order == nil {
+		return nil, domain.ErrOrderNotFound
+	}
+
+	position, err := s.priorityQueue.GetPosition(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if position == nil {
+		return nil, domain.ErrOrderNotQueued
+	}
+
+	result := toQueuedOrder(*order, *position, s.clock.Now())
+	return &result, nil
+}
+
+func toQueuedOrder(order domain.Order, position domain.QueuePosition, now time.Time) domain.QueuedOrder {
+	result := domain.QueuedOrder{
+		Order:           order,
+		Position:        position.Position,
+		QueuedAt:        position.QueuedAt,
+		PositionHistory: position.History,
+	}
+
+	if position.QueuedAt != nil {
+		result.WaitingSeconds = int64(now.Sub(*position.QueuedAt).Seconds())
+	}
+
+	return result
+}
@@ -0,0 +1,122 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/fakeclock"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("QueueService", func() {
+	var (
+		mockOrderStore    *mocks.MockOrderStore
+		mockPriorityQueue *mocks.MockPriorityQueue
+		queueService      services.QueueService
+		clock             *fakeclock.Clock
+		queuedAt          time.Time
+	)
+
+	BeforeEach(func() {
+		mockCtrl := gomock.NewController(GinkgoT())
+		mockOrderStore = mocks.NewMockOrderStore(mockCtrl)
+		mockPriorityQueue = mocks.NewMockPriorityQueue(mockCtrl)
+		clock = fakeclock.New(time.Date(2025, 2, 10, 12, 0, 0, 0, time.UTC))
+		queueService = services.NewQueueService(mockOrderStore, mockPriorityQueue, clock)
+		queuedAt = clock.Now().Add(-2 * time.Minute)
+	})
+
+	Context("GetQueue", func() {
+		It("should return active orders in queue order", func() {
+			positions := []domain.QueuePosition{
+				{OrderID: 2, Position: 1, QueuedAt: &queuedAt},
+				{OrderID: 1, Position: 2, QueuedAt: &queuedAt},
+			}
+			orders := []domain.Order{
+				{ID: 1, Status: domain.OrderStatusPending},
+				{ID: 2, Status: domain.OrderStatusPreparing},
+			}
+
+			mockPriorityQueue.EXPECT().GetPositions(gomock.Any()).Return(positions, nil)
+			mockOrderStore.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return(orders, nil)
+
+			result, err := queueService.GetQueue(context.Background())
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(HaveLen(2))
+			Expect(result[0].ID).To(Equal(uint(2)))
+			Expect(result[0].Position).To(Equal(uint(1)))
+			Expect(result[1].ID).To(Equal(uint(1)))
+			Expect(result[1].WaitingSeconds).To(Equal(int64(120)))
+		})
+
+		It("should skip positions of orders that are no longer active", func() {
+			positions := []domain.QueuePosition{
+				{OrderID: 1, Position: 1},
+				{OrderID: 2, Position: 2},
+			}
+
+			mockPriorityQueue.EXPECT().GetPositions(gomock.Any()).Return(positions, nil)
+			mockOrderStore.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return([]domain.Order{{ID: 2}}, nil)
+
+			result, err := queueService.GetQueue(context.Background())
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(HaveLen(1))
+			Expect(result[0].ID).To(Equal(uint(2)))
+		})
+
+		It("should return an error if the queue cannot be read", func() {
+			mockPriorityQueue.EXPECT().GetPositions(gomock.Any()).Return(nil, errors.New("queue error"))
+
+			result, err := queueService.GetQueue(context.Background())
+
+			Expect(result).To(BeNil())
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("GetOrderPosition", func() {
+		It("should return the order with its position", func() {
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&domain.Order{ID: 1}, nil)
+			mockPriorityQueue.EXPECT().GetPosition(gomock.Any(), uint(1)).Return(&domain.QueuePosition{
+				OrderID:  1,
+				Position: 4,
+				QueuedAt: &queuedAt,
+				History:  []domain.PositionChange{{Position: 5}, {Position: 4}},
+			}, nil)
+
+			result, err := queueService.GetOrderPosition(context.Background(), 1)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Position).To(Equal(uint(4)))
+			Expect(result.PositionHistory).To(HaveLen(2))
+			Expect(result.WaitingSeconds).To(Equal(int64(120)))
+		})
+
+		It("should return an error if the order does not exist", func() {
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(nil, nil)
+
+			result, err := queueService.GetOrderPosition(context.Background(), 1)
+
+			Expect(result).To(BeNil())
+			Expect(err).To(Equal(domain.ErrOrderNotFound))
+		})
+
+		It("should return an error if the order is not queued", func() {
+			mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&domain.Order{ID: 1}, nil)
+			mockPriorityQueue.EXPECT().GetPosition(gomock.Any(), uint(1)).Return(nil, nil)
+
+			result, err := queueService.GetOrderPosition(context.Background(), 1)
+
+			Expect(result).To(BeNil())
+			Expect(err).To(Equal(domain.ErrOrderNotQueued))
+		})
+	})
+})
@@ -0,0 +1,49 @@
+package services
+
+import (
+	"context"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+)
+
+type ReadinessCheck struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+type ReadinessChecker interface {
+	// CheckReadiness runs every check, the service is only ready when all of them pass
+	CheckReadiness(ctx context.Context) domain.ReadinessReport
+}
+
+type readinessChecker struct {
+	checks []ReadinessCheck
+}
+
+func NewReadinessChecker(checks ...ReadinessCheck) ReadinessChecker {
+	return &readinessChecker{
+		checks: checks,
+	}
+}
+
+func (r *readinessChecker) CheckReadiness(ctx context.Context) domain.ReadinessReport {
+	ctx, span := tracer.Start(ctx, "ReadinessChecker.CheckReadiness")
+	defer span.End()
+
+	report := domain.ReadinessReport{
+		Ready:  true,
+		Checks: make([]domain.ReadinessCheckResult, len(r.checks)),
+	}
+
+	for i, check := range r.checks {
+		report.Checks[i] = domain.ReadinessCheckResult{Name: check.Name, Ready: true}
+
+		if err := check.Check(ctx); err != nil {
+			report.Ready = false
+			report.Checks[i].Ready = false
+			report.Checks[i].Error = err.Error()
+		}
+	}
+
+	return report
+}
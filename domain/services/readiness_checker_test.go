@@ -0,0 +1,40 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReadinessChecker", func() {
+	passing := services.ReadinessCheck{
+		Name:  "database",
+		Check: func(_ context.Context) error { return nil },
+	}
+
+	It("should be ready when every check passes", func() {
+		report := services.NewReadinessChecker(passing).CheckReadiness(context.Background())
+
+		Expect(report.Ready).To(BeTrue())
+		Expect(report.Checks).To(Equal([]domain.ReadinessCheckResult{{Name: "database", Ready: true}}))
+	})
+
+	It("should report the failed checks", func() {
+		failing := services.ReadinessCheck{
+			Name:  "workers",
+			Check: func(_ context.Context) error { return errors.New("stopped workers: order_archiver") },
+		}
+
+		report := services.NewReadinessChecker(passing, failing).CheckReadiness(context.Background())
+
+		Expect(report.Ready).To(BeFalse())
+		Expect(report.Checks).To(Equal([]domain.ReadinessCheckResult{
+			{Name: "database", Ready: true},
+			{Name: "workers", Ready: false, Error: "stopped workers: order_archiver"},
+		}))
+	})
+})
@@ -1,12 +1,16 @@
 package services_test
 
 import (
+	"log/slog"
 	"testing"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
 
+// Log lines are only printed for the failed tests
+var testLogger = slog.New(slog.NewTextHandler(GinkgoWriter, nil))
+
 func TestServices(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Services Suite")
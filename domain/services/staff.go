@@ -0,0 +1,20 @@
+package services
+
+import (
+	"context"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+)
+
+type staffRoleKey struct{}
+
+// WithStaffRole marks the context as acting for a staff member, once a transport has identified them
+func WithStaffRole(ctx context.Context, role domain.StaffRole) context.Context {
+	return context.WithValue(ctx, staffRoleKey{}, role)
+}
+
+// StaffRoleFrom is empty when the caller wasn't identified as staff
+func StaffRoleFrom(ctx context.Context) domain.StaffRole {
+	role, _ := ctx.Value(staffRoleKey{}).(domain.StaffRole)
+	return role
+}
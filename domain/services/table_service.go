@@ -0,0 +1,241 @@
+package services
+
+import (
+	"context"
+	"strings"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+)
+
+type TableService interface {
+	CreateTable(ctx context.Context, request domain.NewTable) (*domain.Table, error)
+	FindMany(ctx context.Context) ([]domain.Table, error)
+	// GetFloorPlan returns the tables grouped by area, along with their open sessions
+	GetFloorPlan(ctx context.Context) ([]domain.FloorArea, error)
+	SeatTable(ctx context.Context, tableID uint, request domain.SeatTable) (*domain.TableSessionView, error)
+	FindCurrentSession(ctx context.Context, tableID uint) (*domain.TableSessionView, error)
+	FindSession(ctx context.Context, id uint) (*domain.TableSessionView, error)
+	// CloseSession clears the table once every order of its open session is finished
+	CloseSession(ctx context.Context, tableID uint) (*domain.TableSessionView, error)
+}
+
+type tableServiceImpl struct {
+	tableStore TableStore
+	orderStore OrderStore
+	clock      domain.Clock
+}
+
+func NewTableService(tableStore TableStore, orderStore OrderStore, clock domain.Clock) TableService {
+	return &tableServiceImpl{
+		tableStore: tableStore,
+		orderStore: orderStore,
+		clock:      clock,
+	}
+}
+
+func (s *tableServiceImpl) CreateTable(ctx context.Context, request domain.NewTable) (*domain.Table, error) {
+	request.Name = strings.TrimSpace(request.Name)
+	request.Area = strings.TrimSpace(request.Area)
+
+	existing, err := s.tableStore.FindByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing != nil {
+		return nil, domain.ErrDuplicateTable
+	}
+
+	return s.tableStore.Save(ctx, domain.Table{NewTable: request})
+}
+
+func (s *tableServiceImpl) FindMany(ctx context.Context) ([]domain.Table, error) {
+	return s.tableStore.GetAll(ctx)
+}
+
+func (s *tableServiceImpl) GetFloorPlan(ctx context.Context) ([]domain.FloorArea, error) {
+	tables, err := s.tableStore.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.tableStore.GetOpenSessions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionIDs := make([]uint, len(sessions))
+	for i, session := range sessions {
+		sessionIDs[i] = session.ID
+	}
+
+	var orders []domain.Order
+	if len(sessions) > 0 {
+		orders, err = s.orderStore.GetAll(ctx, tableSessionFilters(sessionIDs...))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sessionOrders := make(map[uint][]domain.Order, len(sessions))
+	for _, order := range orders {
+		sessionOrders[*order.TableSessionID] = append(sessionOrders[*order.TableSessionID], order)
+	}
+
+	tableSessions := make(map[uint]domain.TableSession, len(sessions))
+	for _, session := range sessions {
+		tableSessions[session.TableID] = session
+	}
+
+	var areas []domain.FloorArea
+
+	// Tables come sorted by area, so each area is a run of consecutive tables
+	for _, table := range tables {
+		if len(areas) == 0 || areas[len(areas)-1].Name != table.Area {
+			areas = append(areas, domain.FloorArea{Name: table.Area})
+		}
+
+		planTable := domain.FloorPlanTable{Table: table}
+
+		if session, ok := tableSessions[table.ID]; ok {
+			view := domain.NewTableSessionView(table, session, sessionOrders[session.ID])
+			planTable.Session = &view
+		}
+
+		area := &areas[len(areas)-1]
+		area.Tables = append(area.Tables, planTable)
+	}
+
+	return areas, nil
+}
+
+func (s *tableServiceImpl) SeatTable(
+	ctx context.Context,
+	tableID uint,
+	request domain.SeatTable,
+) (*domain.TableSessionView, error) {
+	table, err := s.findTable(ctx, tableID)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.tableStore.OpenSession(ctx, domain.TableSession{
+		TableID:  table.ID,
+		Covers:   request.Covers,
+		Status:   domain.TableSessionStatusOpen,
+		OpenedAt: s.clock.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	view := domain.NewTableSessionView(*table, *session, []domain.Order{})
+	return &view, nil
+}
+
+func (s *tableServiceImpl) FindCurrentSession(ctx context.Context, tableID uint) (*domain.TableSessionView, error) {
+	table, session, err := s.findOpenSession(ctx, tableID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.sessionView(ctx, *table, *session)
+}
+
+func (s *tableServiceImpl) FindSession(ctx context.Context, id uint) (*domain.TableSessionView, error) {
+	session, err := s.tableStore.FindSession(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if session == nil {
+		return nil, domain.ErrTableSessionNotFound
+	}
+
+	table, err := s.findTable(ctx, session.TableID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.sessionView(ctx, *table, *session)
+}
+
+func (s *tableServiceImpl) CloseSession(ctx context.Context, tableID uint) (*domain.TableSessionView, error) {
+	table, session, err := s.findOpenSession(ctx, tableID)
+	if err != nil {
+		return nil, err
+	}
+
+	orders, err := s.sessionOrders(ctx, session.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !domain.CanCloseTableSession(orders) {
+		return nil, domain.ErrTableSessionInProgress
+	}
+
+	closed, err := s.tableStore.CloseSession(ctx, session.ID, s.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	view := domain.NewTableSessionView(*table, *closed, orders)
+	return &view, nil
+}
+
+func (s *tableServiceImpl) findTable(ctx context.Context, id uint) (*domain.Table, error) {
+	table, err := s.tableStore.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if table == nil {
+		return nil, domain.ErrTableNotFound
+	}
+
+	return table, nil
+}
+
+func (s *tableServiceImpl) findOpenSession(ctx context.Context, tableID uint) (*domain.Table, *domain.TableSession, error) {
+	table, err := s.findTable(ctx, tableID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	session, err := s.tableStore.FindOpenSession(ctx, tableID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if session == nil {
+		return nil, nil, domain.ErrTableNotSeated
+	}
+
+	return table, session, nil
+}
+
+func (s *tableServiceImpl) sessionOrders(ctx context.Context, sessionID uint) ([]domain.Order, error) {
+	return s.orderStore.GetAll(ctx, tableSessionFilters(sessionID))
+}
+
+func tableSessionFilters(ids ...uint) *domain.OrderFilters {
+	filters := &domain.OrderFilters{}
+	domain.FilterByTableSession(ids...)(filters)
+
+	return filters
+}
+
+func (s *tableServiceImpl) sessionView(
+	ctx context.Context,
+	table domain.Table,
+	session domain.TableSession,
+) (*domain.TableSessionView, error) {
+	orders, err := s.sessionOrders(ctx, session.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	view := domain.NewTableSessionView(table, session, orders)
+	return &view, nil
+}
@@ -0,0 +1,190 @@
+package services_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/fakeclock"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("TableService", func() {
+	var (
+		mockTableStore *mocks.MockTableStore
+		mockOrderStore *mocks.MockOrderStore
+		clock          *fakeclock.Clock
+		tableService   services.TableService
+		table          *domain.Table
+		session        *domain.TableSession
+	)
+
+	sessionOrders := func(id uint) *domain.OrderFilters {
+		return &domain.OrderFilters{TableSessionIDs: []uint{id}}
+	}
+
+	BeforeEach(func() {
+		mockCtrl := gomock.NewController(GinkgoT())
+		mockTableStore = mocks.NewMockTableStore(mockCtrl)
+		mockOrderStore = mocks.NewMockOrderStore(mockCtrl)
+		clock = fakeclock.New(time.Date(2025, 2, 10, 20, 0, 0, 0, time.UTC))
+		tableService = services.NewTableService(mockTableStore, mockOrderStore, clock)
+
+		table = &domain.Table{ID: 1, NewTable: domain.NewTable{Name: "T1", Area: "terrace", Seats: 4}}
+		session = &domain.TableSession{ID: 7, TableID: 1, Covers: 3, Status: domain.TableSessionStatusOpen, OpenedAt: clock.Now()}
+	})
+
+	Context("CreateTable", func() {
+		It("should store the table with a trimmed name", func() {
+			mockTableStore.EXPECT().FindByName(gomock.Any(), "T2").Return(nil, nil)
+			mockTableStore.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, t domain.Table) (*domain.Table, error) {
+				t.ID = 2
+				return &t, nil
+			})
+
+			result, err := tableService.CreateTable(context.Background(), domain.NewTable{Name: " T2 ", Seats: 2})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.ID).To(Equal(uint(2)))
+			Expect(result.Name).To(Equal("T2"))
+		})
+
+		It("should reject a name used by another table", func() {
+			mockTableStore.EXPECT().FindByName(gomock.Any(), "T1").Return(table, nil)
+
+			result, err := tableService.CreateTable(context.Background(), domain.NewTable{Name: "T1", Seats: 2})
+
+			Expect(result).To(BeNil())
+			Expect(err).To(Equal(domain.ErrDuplicateTable))
+		})
+	})
+
+	Context("SeatTable", func() {
+		It("should open a session with the covers", func() {
+			mockTableStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(table, nil)
+			mockTableStore.EXPECT().OpenSession(gomock.Any(), domain.TableSession{
+				TableID:  1,
+				Covers:   3,
+				Status:   domain.TableSessionStatusOpen,
+				OpenedAt: clock.Now(),
+			}).Return(session, nil)
+
+			result, err := tableService.SeatTable(context.Background(), 1, domain.SeatTable{Covers: 3})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.TableSession).To(Equal(*session))
+			Expect(result.FoodStatus).To(Equal(domain.TableFoodStatusNoOrders))
+		})
+
+		It("should reject unknown tables", func() {
+			mockTableStore.EXPECT().FindByID(gomock.Any(), uint(9)).Return(nil, nil)
+
+			_, err := tableService.SeatTable(context.Background(), 9, domain.SeatTable{Covers: 3})
+
+			Expect(err).To(Equal(domain.ErrTableNotFound))
+		})
+	})
+
+	Context("FindCurrentSession", func() {
+		It("should aggregate the orders of the open session", func() {
+			orders := []domain.Order{
+				{ID: 1, Status: domain.OrderStatusDone, NewOrder: domain.NewOrder{Dishes: []domain.Dish{{Name: "Wine"}}}},
+				{ID: 2, Status: domain.OrderStatusReady, NewOrder: domain.NewOrder{Dishes: []domain.Dish{{Name: "Steak"}}}},
+			}
+
+			mockTableStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(table, nil)
+			mockTableStore.EXPECT().FindOpenSession(gomock.Any(), uint(1)).Return(session, nil)
+			mockOrderStore.EXPECT().GetAll(gomock.Any(), sessionOrders(7)).Return(orders, nil)
+
+			result, err := tableService.FindCurrentSession(context.Background(), 1)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Orders).To(Equal(orders))
+			Expect(result.FoodStatus).To(Equal(domain.TableFoodStatusReady))
+			Expect(result.ReadyDishes).To(Equal(2))
+		})
+
+		It("should fail for tables without an open session", func() {
+			mockTableStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(table, nil)
+			mockTableStore.EXPECT().FindOpenSession(gomock.Any(), uint(1)).Return(nil, nil)
+
+			_, err := tableService.FindCurrentSession(context.Background(), 1)
+
+			Expect(err).To(Equal(domain.ErrTableNotSeated))
+		})
+	})
+
+	Context("CloseSession", func() {
+		BeforeEach(func() {
+			mockTableStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(table, nil)
+			mockTableStore.EXPECT().FindOpenSession(gomock.Any(), uint(1)).Return(session, nil)
+		})
+
+		It("should close the session once every order is finished", func() {
+			closedAt := clock.Now()
+			closed := *session
+			closed.Status = domain.TableSessionStatusClosed
+			closed.ClosedAt = &closedAt
+
+			mockOrderStore.EXPECT().GetAll(gomock.Any(), sessionOrders(7)).Return([]domain.Order{{ID: 1, Status: domain.OrderStatusDone}}, nil)
+			mockTableStore.EXPECT().CloseSession(gomock.Any(), uint(7), closedAt).Return(&closed, nil)
+
+			result, err := tableService.CloseSession(context.Background(), 1)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(domain.TableSessionStatusClosed))
+			Expect(result.FoodStatus).To(Equal(domain.TableFoodStatusServed))
+		})
+
+		It("should keep the session open while orders are in progress", func() {
+			mockOrderStore.EXPECT().GetAll(gomock.Any(), sessionOrders(7)).Return([]domain.Order{{ID: 1, Status: domain.OrderStatusReady}}, nil)
+
+			_, err := tableService.CloseSession(context.Background(), 1)
+
+			Expect(err).To(Equal(domain.ErrTableSessionInProgress))
+		})
+	})
+
+	Context("GetFloorPlan", func() {
+		It("should group the tables by area with their open sessions", func() {
+			sessionID := session.ID
+			tables := []domain.Table{
+				{ID: 3, NewTable: domain.NewTable{Name: "B1", Area: "bar", Seats: 2}},
+				*table,
+				{ID: 2, NewTable: domain.NewTable{Name: "T2", Area: "terrace", Seats: 6}},
+			}
+			orders := []domain.Order{{ID: 1, Status: domain.OrderStatusPreparing, TableSessionID: &sessionID}}
+
+			mockTableStore.EXPECT().GetAll(gomock.Any()).Return(tables, nil)
+			mockTableStore.EXPECT().GetOpenSessions(gomock.Any()).Return([]domain.TableSession{*session}, nil)
+			mockOrderStore.EXPECT().GetAll(gomock.Any(), sessionOrders(7)).Return(orders, nil)
+
+			areas, err := tableService.GetFloorPlan(context.Background())
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(areas).To(HaveLen(2))
+			Expect(areas[0].Name).To(Equal("bar"))
+			Expect(areas[0].Tables).To(HaveLen(1))
+			Expect(areas[0].Tables[0].Session).To(BeNil())
+			Expect(areas[1].Name).To(Equal("terrace"))
+			Expect(areas[1].Tables).To(HaveLen(2))
+			Expect(areas[1].Tables[0].Session.Orders).To(Equal(orders))
+			Expect(areas[1].Tables[0].Session.FoodStatus).To(Equal(domain.TableFoodStatusInProgress))
+			Expect(areas[1].Tables[1].Session).To(BeNil())
+		})
+
+		It("should not look up orders when no table is seated", func() {
+			mockTableStore.EXPECT().GetAll(gomock.Any()).Return([]domain.Table{*table}, nil)
+			mockTableStore.EXPECT().GetOpenSessions(gomock.Any()).Return([]domain.TableSession{}, nil)
+
+			areas, err := tableService.GetFloorPlan(context.Background())
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(areas).To(HaveLen(1))
+		})
+	})
+})
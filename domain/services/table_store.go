@@ -0,0 +1,23 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+)
+
+type TableStore interface {
+	Save(ctx context.Context, table domain.Table) (*domain.Table, error)
+	FindByID(ctx context.Context, id uint) (*domain.Table, error)
+	FindByName(ctx context.Context, name string) (*domain.Table, error)
+	// GetAll returns the tables sorted by area and name
+	GetAll(ctx context.Context) ([]domain.Table, error)
+	// OpenSession fails with domain.ErrTableOccupied when the table already has an open session
+	OpenSession(ctx context.Context, session domain.TableSession) (*domain.TableSession, error)
+	FindSession(ctx context.Context, id uint) (*domain.TableSession, error)
+	FindOpenSession(ctx context.Context, tableID uint) (*domain.TableSession, error)
+	GetOpenSessions(ctx context.Context) ([]domain.TableSession, error)
+	// CloseSession fails with domain.ErrTableNotSeated when the session is no longer open
+	CloseSession(ctx context.Context, id uint, closedAt time.Time) (*domain.TableSession, error)
+}
@@ -0,0 +1,36 @@
+package services
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("github.com/danbrato999/yuno-gveloz/domain/services")
+
+func orderIDAttribute(id uint) attribute.KeyValue {
+	return attribute.Int64("order.id", int64(id))
+}
+
+// detach is used for the work a request leaves running in the background. Its span starts a new
+// trace linked to the request one, since it usually ends after the request
+func detach(ctx context.Context, name string) (context.Context, trace.Span) {
+	return tracer.Start(
+		context.WithoutCancel(ctx),
+		name,
+		trace.WithNewRoot(),
+		trace.WithLinks(trace.LinkFromContext(ctx)),
+	)
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
@@ -0,0 +1,105 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	spanRecorder    = tracetest.NewSpanRecorder()
+	installRecorder sync.Once
+)
+
+func findEndedSpan(spans []sdktrace.ReadOnlySpan, name string) sdktrace.ReadOnlySpan {
+	for _, span := range spans {
+		if span.Name() == name {
+			return span
+		}
+	}
+
+	return nil
+}
+
+var _ = Describe("Tracing", func() {
+	var (
+		mockOrderStore    *mocks.MockOrderStore
+		mockStatusStore   *mocks.MockOrderStatusStore
+		mockPriorityQueue *mocks.MockPriorityQueue
+		orderService      services.OrderService
+		recorded          int
+	)
+
+	// The global tracer provider only delegates to the first provider it's given, so the spans of
+	// every test end in the same recorder and each test only looks at the ones ended after it started
+	endedSpans := func() []sdktrace.ReadOnlySpan {
+		return spanRecorder.Ended()[recorded:]
+	}
+
+	BeforeEach(func() {
+		installRecorder.Do(func() {
+			otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder)))
+		})
+
+		recorded = len(spanRecorder.Ended())
+
+		mockCtrl := gomock.NewController(GinkgoT())
+		mockOrderStore = mocks.NewMockOrderStore(mockCtrl)
+		mockPriorityQueue = mocks.NewMockPriorityQueue(mockCtrl)
+		mockStatusStore = mocks.NewMockOrderStatusStore(mockCtrl)
+		orderService = services.NewOrderService(mockOrderStore, mockPriorityQueue, mockStatusStore)
+	})
+
+	It("should link the background work to the span that started it", func() {
+		order := &domain.Order{ID: 1, Status: domain.OrderStatusPending}
+
+		mockOrderStore.EXPECT().Save(gomock.Any(), gomock.Any()).Return(order, nil)
+		mockStatusStore.EXPECT().AddCurrentStatus(gomock.Any(), order).Return(nil)
+		mockPriorityQueue.EXPECT().Add(gomock.Any(), order).Return(nil)
+
+		_, err := orderService.CreateOrder(context.Background(), domain.NewOrder{Dishes: []domain.Dish{{Name: "Pizza"}}})
+		Expect(err).ToNot(HaveOccurred())
+
+		createSpan := findEndedSpan(endedSpans(), "OrderService.CreateOrder")
+		Expect(createSpan).ToNot(BeNil())
+
+		linkedSpans := func() []string {
+			var names []string
+			for _, span := range endedSpans() {
+				links := span.Links()
+				if len(links) == 1 && links[0].SpanContext.SpanID() == createSpan.SpanContext().SpanID() {
+					Expect(span.Parent().IsValid()).To(BeFalse())
+					Expect(span.SpanContext().TraceID()).ToNot(Equal(createSpan.SpanContext().TraceID()))
+					names = append(names, span.Name())
+				}
+			}
+
+			return names
+		}
+
+		Eventually(linkedSpans).Should(ConsistOf("OrderService.addCurrentStatus", "OrderService.addToQueue"))
+	})
+
+	It("should record the errors in the span", func() {
+		mockOrderStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(nil, errors.New("db error"))
+
+		_, err := orderService.FindByID(context.Background(), 1)
+		Expect(err).To(HaveOccurred())
+
+		span := findEndedSpan(endedSpans(), "OrderService.FindByID")
+		Expect(span).ToNot(BeNil())
+		Expect(span.Status().Code).To(Equal(codes.Error))
+		Expect(span.Status().Description).To(Equal("db error"))
+	})
+})
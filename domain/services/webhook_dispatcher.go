@@ -0,0 +1,332 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+)
+
+const WebhookEventHeader = "X-Gveloz-Event"
+const WebhookSignatureHeader = "X-Gveloz-Signature"
+
+const webhookEventsBuffer = 100
+
+// DefaultWebhookBacklogLimit matches the events buffer, since going past it means the
+// deliveries are not keeping up with the orders
+const DefaultWebhookBacklogLimit = webhookEventsBuffer
+
+type WebhookRetryPolicy struct {
+	MaxAttempts int
+	// Wait before the first retry, doubled after every failed attempt
+	InitialBackoff time.Duration
+}
+
+var DefaultWebhookRetryPolicy = WebhookRetryPolicy{
+	MaxAttempts:    5,
+	InitialBackoff: time.Second,
+}
+
+// WebhookDispatcher sends the order events to the webhooks subscribed to them, keeping
+// the deliveries that failed after every retry as dead letters. Every webhook gets its
+// events one at a time, in the order they were published
+type WebhookDispatcher struct {
+	webhookStore WebhookStore
+	subscriber   EventSubscriber
+	client       *http.Client
+	clock        domain.Clock
+	retryPolicy  WebhookRetryPolicy
+	logger       *slog.Logger
+	pending      atomic.Int64
+	// Events missed because the dispatcher fell behind, and how many of them the readiness check saw
+	dropped        atomic.Int64
+	droppedChecked atomic.Int64
+
+	// Subscribers are loaded with the first event, and again after ReloadWebhooks
+	webhooksMu     sync.Mutex
+	webhooks       []domain.Webhook
+	webhooksLoaded bool
+
+	queuesMu sync.Mutex
+	queues   map[uint]*webhookQueue
+	senders  sync.WaitGroup
+}
+
+type webhookDelivery struct {
+	webhook   domain.Webhook
+	eventType domain.OrderEventType
+	payload   []byte
+}
+
+// webhookQueue holds the deliveries of a single webhook, sent by one goroutine that
+// stops once the queue is empty
+type webhookQueue struct {
+	deliveries []webhookDelivery
+}
+
+func NewWebhookDispatcher(
+	webhookStore WebhookStore,
+	subscriber EventSubscriber,
+	client *http.Client,
+	clock domain.Clock,
+	retryPolicy WebhookRetryPolicy,
+	logger *slog.Logger,
+) *WebhookDispatcher {
+	if retryPolicy.MaxAttempts < 1 {
+		retryPolicy.MaxAttempts = 1
+	}
+
+	return &WebhookDispatcher{
+		webhookStore: webhookStore,
+		subscriber:   subscriber,
+		client:       client,
+		clock:        clock,
+		retryPolicy:  retryPolicy,
+		logger:       logger,
+		queues:       make(map[uint]*webhookQueue),
+	}
+}
+
+// Run blocks until the context is cancelled, and the deliveries still queued are dead lettered
+func (d *WebhookDispatcher) Run(ctx context.Context) {
+	events, unsubscribe := d.subscriber.Subscribe(webhookEventsBuffer, OnDropped(func(event domain.OrderEvent) {
+		d.dropped.Add(1)
+		d.logger.WarnContext(
+			ctx,
+			"webhook events buffer full, dropped order event",
+			slog.String("event", string(event.Type)),
+			orderIDLogAttr(event.Order.ID),
+		)
+	}))
+	defer unsubscribe()
+	defer d.senders.Wait()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+
+			if err := d.Dispatch(ctx, event); err != nil {
+				d.logger.ErrorContext(
+					ctx,
+					"failed to dispatch order event",
+					slog.String("event", string(event.Type)),
+					orderIDLogAttr(event.Order.ID),
+					slog.Any("error", err),
+				)
+			}
+		}
+	}
+}
+
+// Backlog returns the number of deliveries not done yet, including the ones waiting to be retried
+func (d *WebhookDispatcher) Backlog() int {
+	return int(d.pending.Load())
+}
+
+// Dropped returns the number of events never delivered because the events buffer was full
+func (d *WebhookDispatcher) Dropped() int {
+	return int(d.dropped.Load())
+}
+
+// BacklogCheck fails while the backlog is over the limit, and once after any event was dropped
+// since the previous check
+func (d *WebhookDispatcher) BacklogCheck(limit int) ReadinessCheck {
+	return ReadinessCheck{
+		Name: "webhook_backlog",
+		Check: func(_ context.Context) error {
+			if backlog := d.Backlog(); backlog > limit {
+				return fmt.Errorf("%d webhook events pending, over the limit of %d", backlog, limit)
+			}
+
+			dropped := d.dropped.Load()
+			if missed := dropped - d.droppedChecked.Swap(dropped); missed > 0 {
+				return fmt.Errorf("%d webhook events dropped since the last check, the events buffer was full", missed)
+			}
+
+			return nil
+		},
+	}
+}
+
+// ReloadWebhooks makes the next event load the subscribers again, after they were created or deleted
+func (d *WebhookDispatcher) ReloadWebhooks() {
+	d.webhooksMu.Lock()
+	defer d.webhooksMu.Unlock()
+
+	d.webhooksLoaded = false
+}
+
+// Dispatch queues the event for every webhook subscribed to its type, behind the events
+// still being delivered to them
+func (d *WebhookDispatcher) Dispatch(ctx context.Context, event domain.OrderEvent) error {
+	webhooks, err := d.subscribers(ctx)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	for _, webhook := range webhooks {
+		if !webhook.Accepts(event.Type) {
+			continue
+		}
+
+		d.enqueue(ctx, webhookDelivery{webhook: webhook, eventType: event.Type, payload: payload})
+	}
+
+	return nil
+}
+
+func (d *WebhookDispatcher) subscribers(ctx context.Context) ([]domain.Webhook, error) {
+	d.webhooksMu.Lock()
+	defer d.webhooksMu.Unlock()
+
+	if !d.webhooksLoaded {
+		webhooks, err := d.webhookStore.GetAll(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.webhooks = webhooks
+		d.webhooksLoaded = true
+	}
+
+	return d.webhooks, nil
+}
+
+func (d *WebhookDispatcher) enqueue(ctx context.Context, delivery webhookDelivery) {
+	d.pending.Add(1)
+
+	d.queuesMu.Lock()
+	defer d.queuesMu.Unlock()
+
+	if queue, ok := d.queues[delivery.webhook.ID]; ok {
+		queue.deliveries = append(queue.deliveries, delivery)
+		return
+	}
+
+	queue := &webhookQueue{deliveries: []webhookDelivery{delivery}}
+	d.queues[delivery.webhook.ID] = queue
+
+	d.senders.Add(1)
+	go d.drain(ctx, delivery.webhook.ID, queue)
+}
+
+func (d *WebhookDispatcher) drain(ctx context.Context, webhookID uint, queue *webhookQueue) {
+	defer d.senders.Done()
+
+	for {
+		d.queuesMu.Lock()
+		if len(queue.deliveries) == 0 {
+			delete(d.queues, webhookID)
+			d.queuesMu.Unlock()
+			return
+		}
+
+		delivery := queue.deliveries[0]
+		queue.deliveries = queue.deliveries[1:]
+		d.queuesMu.Unlock()
+
+		d.deliver(ctx, delivery.webhook, delivery.eventType, delivery.payload)
+		d.pending.Add(-1)
+	}
+}
+
+func (d *WebhookDispatcher) deliver(ctx context.Context, webhook domain.Webhook, eventType domain.OrderEventType, payload []byte) {
+	backoff := d.retryPolicy.InitialBackoff
+	attempts := 0
+	var err error
+
+	for attempts < d.retryPolicy.MaxAttempts {
+		attempts++
+
+		if err = d.send(ctx, webhook, eventType, payload); err == nil {
+			return
+		}
+
+		if attempts == d.retryPolicy.MaxAttempts || !sleep(ctx, backoff) {
+			break
+		}
+
+		backoff *= 2
+	}
+
+	deadLetter := domain.WebhookDeadLetter{
+		WebhookID: webhook.ID,
+		EventType: eventType,
+		Payload:   string(payload),
+		Attempts:  attempts,
+		LastError: err.Error(),
+		FailedAt:  d.clock.Now(),
+	}
+
+	// Deliveries given up on at shutdown are still dead lettered
+	if _, err := d.webhookStore.SaveDeadLetter(context.WithoutCancel(ctx), deadLetter); err != nil {
+		d.logger.ErrorContext(ctx, "failed to store webhook dead letter", slog.Uint64("webhook_id", uint64(webhook.ID)), slog.Any("error", err))
+	}
+}
+
+func (d *WebhookDispatcher) send(ctx context.Context, webhook domain.Webhook, eventType domain.OrderEventType, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventHeader, string(eventType))
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(webhook.Secret, payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// SignWebhookPayload returns the HMAC-SHA256 signature sent along the payload, so the
+// receivers can check it was sent by us
+func SignWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func sleep(ctx context.Context, duration time.Duration) bool {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,284 @@
+package services_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/fakeclock"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+type receivedWebhook struct {
+	Event     string
+	Signature string
+	Body      []byte
+}
+
+var _ = Describe("WebhookDispatcher", func() {
+	var (
+		mockWebhookStore *mocks.MockWebhookStore
+		clock            *fakeclock.Clock
+		dispatcher       *services.WebhookDispatcher
+		server           *httptest.Server
+		mu               sync.Mutex
+		received         []receivedWebhook
+		failures         int
+		event            domain.OrderEvent
+	)
+
+	BeforeEach(func() {
+		mockWebhookStore = mocks.NewMockWebhookStore(gomock.NewController(GinkgoT()))
+		clock = fakeclock.New(time.Date(2025, 2, 10, 12, 0, 0, 0, time.UTC))
+		received = nil
+		failures = 0
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			received = append(received, receivedWebhook{
+				Event:     r.Header.Get(services.WebhookEventHeader),
+				Signature: r.Header.Get(services.WebhookSignatureHeader),
+				Body:      body,
+			})
+
+			if failures > 0 {
+				failures--
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		DeferCleanup(server.Close)
+
+		dispatcher = services.NewWebhookDispatcher(
+			mockWebhookStore,
+			services.NewEventBus(),
+			server.Client(),
+			clock,
+			services.WebhookRetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond},
+			testLogger,
+		)
+
+		event = domain.OrderEvent{
+			Type:      domain.OrderEventCreated,
+			Order:     domain.Order{ID: 7, Status: domain.OrderStatusPending},
+			Timestamp: clock.Now(),
+		}
+	})
+
+	webhookFor := func(id uint, eventTypes ...domain.OrderEventType) domain.Webhook {
+		return domain.Webhook{
+			ID: id,
+			NewWebhook: domain.NewWebhook{
+				URL:        server.URL,
+				Secret:     "s3cr3t",
+				EventTypes: eventTypes,
+			},
+		}
+	}
+
+	receivedWebhooks := func() []receivedWebhook {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return append([]receivedWebhook(nil), received...)
+	}
+
+	It("should send signed payloads to the subscribed webhooks", func() {
+		mockWebhookStore.EXPECT().GetAll(gomock.Any()).Return([]domain.Webhook{
+			webhookFor(1, domain.OrderEventCreated),
+			webhookFor(2, domain.OrderEventCancelled),
+		}, nil)
+
+		Expect(dispatcher.Dispatch(context.Background(), event)).To(Succeed())
+
+		Eventually(receivedWebhooks).Should(HaveLen(1))
+		Consistently(receivedWebhooks, 50*time.Millisecond).Should(HaveLen(1))
+		Expect(received[0].Event).To(Equal(string(domain.OrderEventCreated)))
+		Expect(received[0].Signature).To(Equal(services.SignWebhookPayload("s3cr3t", received[0].Body)))
+
+		var payload domain.OrderEvent
+		Expect(json.Unmarshal(received[0].Body, &payload)).To(Succeed())
+		Expect(payload.Type).To(Equal(domain.OrderEventCreated))
+		Expect(payload.Order.ID).To(Equal(uint(7)))
+	})
+
+	It("should retry failed deliveries", func() {
+		failures = 2
+		mockWebhookStore.EXPECT().GetAll(gomock.Any()).Return([]domain.Webhook{webhookFor(1, domain.OrderEventCreated)}, nil)
+
+		Expect(dispatcher.Dispatch(context.Background(), event)).To(Succeed())
+
+		Eventually(receivedWebhooks).Should(HaveLen(3))
+		Eventually(dispatcher.Backlog).Should(BeZero())
+	})
+
+	It("should deliver the events to each webhook in order, waiting for their retries", func() {
+		failures = 1
+		mockWebhookStore.EXPECT().GetAll(gomock.Any()).Return([]domain.Webhook{webhookFor(1, domain.OrderEventCreated)}, nil)
+
+		for id := uint(1); id <= 3; id++ {
+			event.Order.ID = id
+			Expect(dispatcher.Dispatch(context.Background(), event)).To(Succeed())
+		}
+
+		Eventually(receivedWebhooks).Should(HaveLen(4))
+
+		var orderIDs []uint
+		for _, webhook := range receivedWebhooks() {
+			var payload domain.OrderEvent
+			Expect(json.Unmarshal(webhook.Body, &payload)).To(Succeed())
+			orderIDs = append(orderIDs, payload.Order.ID)
+		}
+
+		Expect(orderIDs).To(Equal([]uint{1, 1, 2, 3}))
+	})
+
+	It("should load the webhooks again only once they changed", func() {
+		gomock.InOrder(
+			mockWebhookStore.EXPECT().GetAll(gomock.Any()).Return([]domain.Webhook{webhookFor(1, domain.OrderEventCreated)}, nil),
+			mockWebhookStore.EXPECT().GetAll(gomock.Any()).Return([]domain.Webhook{
+				webhookFor(1, domain.OrderEventCreated),
+				webhookFor(2, domain.OrderEventCreated),
+			}, nil),
+		)
+
+		Expect(dispatcher.Dispatch(context.Background(), event)).To(Succeed())
+		Expect(dispatcher.Dispatch(context.Background(), event)).To(Succeed())
+		Eventually(receivedWebhooks).Should(HaveLen(2))
+
+		dispatcher.ReloadWebhooks()
+
+		Expect(dispatcher.Dispatch(context.Background(), event)).To(Succeed())
+		Eventually(receivedWebhooks).Should(HaveLen(4))
+	})
+
+	It("should dead letter deliveries failing after every retry", func() {
+		failures = 3
+		mockWebhookStore.EXPECT().GetAll(gomock.Any()).Return([]domain.Webhook{webhookFor(1, domain.OrderEventCreated)}, nil)
+		deadLetters := make(chan domain.WebhookDeadLetter, 1)
+		mockWebhookStore.EXPECT().SaveDeadLetter(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, deadLetter domain.WebhookDeadLetter) (*domain.WebhookDeadLetter, error) {
+			deadLetters <- deadLetter
+			return &deadLetter, nil
+		})
+
+		Expect(dispatcher.Dispatch(context.Background(), event)).To(Succeed())
+
+		var deadLetter domain.WebhookDeadLetter
+		Eventually(deadLetters).Should(Receive(&deadLetter))
+		Expect(receivedWebhooks()).To(HaveLen(3))
+		Expect(deadLetter.WebhookID).To(Equal(uint(1)))
+		Expect(deadLetter.EventType).To(Equal(domain.OrderEventCreated))
+		Expect(deadLetter.Attempts).To(Equal(3))
+		Expect(deadLetter.LastError).To(ContainSubstring("503"))
+		Expect(deadLetter.FailedAt).To(Equal(clock.Now()))
+		Expect(deadLetter.Payload).To(Equal(string(received[0].Body)))
+	})
+
+	It("should deliver the published events while running", func() {
+		bus := services.NewEventBus()
+		dispatcher = services.NewWebhookDispatcher(
+			mockWebhookStore,
+			bus,
+			server.Client(),
+			clock,
+			services.DefaultWebhookRetryPolicy,
+			testLogger,
+		)
+		// The event is published until the dispatcher subscribes to the bus
+		mockWebhookStore.EXPECT().GetAll(gomock.Any()).Return([]domain.Webhook{webhookFor(1, domain.OrderEventCreated)}, nil).AnyTimes()
+		// Deliveries still in flight are dead lettered once the dispatcher stops
+		mockWebhookStore.EXPECT().SaveDeadLetter(gomock.Any(), gomock.Any()).AnyTimes()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go dispatcher.Run(ctx)
+
+		Eventually(func() int {
+			bus.Publish(event)
+
+			mu.Lock()
+			defer mu.Unlock()
+			return len(received)
+		}).Should(BeNumerically(">", 0))
+	})
+
+	It("should count the events waiting for their retries as backlog", func() {
+		failures = 1000
+		bus := services.NewEventBus()
+		dispatcher = services.NewWebhookDispatcher(
+			mockWebhookStore,
+			bus,
+			server.Client(),
+			clock,
+			services.WebhookRetryPolicy{MaxAttempts: 2, InitialBackoff: time.Hour},
+			testLogger,
+		)
+		mockWebhookStore.EXPECT().GetAll(gomock.Any()).Return([]domain.Webhook{webhookFor(1, domain.OrderEventCreated)}, nil).AnyTimes()
+		mockWebhookStore.EXPECT().SaveDeadLetter(gomock.Any(), gomock.Any()).AnyTimes()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go dispatcher.Run(ctx)
+
+		Eventually(func() int {
+			bus.Publish(event)
+			return dispatcher.Backlog()
+		}).Should(BeNumerically(">", 0))
+
+		Expect(dispatcher.BacklogCheck(0).Check(ctx)).To(MatchError(ContainSubstring("over the limit of 0")))
+		Expect(dispatcher.BacklogCheck(services.DefaultWebhookBacklogLimit).Check(ctx)).To(Succeed())
+
+		cancel()
+
+		Eventually(dispatcher.Backlog).Should(BeZero())
+	})
+
+	It("should fail the readiness check after dropping events", func() {
+		bus := services.NewEventBus()
+		dispatcher = services.NewWebhookDispatcher(
+			mockWebhookStore,
+			bus,
+			server.Client(),
+			clock,
+			services.DefaultWebhookRetryPolicy,
+			testLogger,
+		)
+		// Loading the subscribers blocks the dispatcher until the events buffer is full
+		loading := make(chan struct{})
+		mockWebhookStore.EXPECT().GetAll(gomock.Any()).DoAndReturn(func(ctx context.Context) ([]domain.Webhook, error) {
+			<-loading
+			return nil, nil
+		}).AnyTimes()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		defer close(loading)
+		go dispatcher.Run(ctx)
+
+		Eventually(func() int {
+			for range services.DefaultWebhookBacklogLimit {
+				bus.Publish(event)
+			}
+
+			return dispatcher.Dropped()
+		}).Should(BeNumerically(">", 0))
+
+		check := dispatcher.BacklogCheck(services.DefaultWebhookBacklogLimit)
+		Expect(check.Check(ctx)).To(MatchError(ContainSubstring("dropped since the last check")))
+		Expect(check.Check(ctx)).To(Succeed())
+	})
+})
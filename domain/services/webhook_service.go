@@ -0,0 +1,126 @@
+package services
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+)
+
+type WebhookService interface {
+	CreateWebhook(ctx context.Context, request domain.NewWebhook) (*domain.Webhook, error)
+	FindByID(ctx context.Context, id uint) (*domain.Webhook, error)
+	FindMany(ctx context.Context) ([]domain.Webhook, error)
+	DeleteWebhook(ctx context.Context, id uint) error
+	GetDeadLetters(ctx context.Context) ([]domain.WebhookDeadLetter, error)
+}
+
+type webhookServiceImpl struct {
+	webhookStore WebhookStore
+	onChange     func()
+}
+
+type WebhookServiceOption func(s *webhookServiceImpl)
+
+// WithWebhooksChanged is called after a webhook is created or deleted, so the dispatcher reloads them
+func WithWebhooksChanged(onChange func()) WebhookServiceOption {
+	return func(s *webhookServiceImpl) {
+		s.onChange = onChange
+	}
+}
+
+func NewWebhookService(webhookStore WebhookStore, opts ...WebhookServiceOption) WebhookService {
+	s := &webhookServiceImpl{
+		webhookStore: webhookStore,
+		onChange:     func() {},
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+func (s *webhookServiceImpl) CreateWebhook(ctx context.Context, request domain.NewWebhook) (*domain.Webhook, error) {
+	if err := validateWebhook(request); err != nil {
+		return nil, err
+	}
+
+	webhook, err := s.webhookStore.Save(ctx, domain.Webhook{NewWebhook: request})
+	if err != nil {
+		return nil, err
+	}
+
+	s.onChange()
+
+	return hideSecret(*webhook), nil
+}
+
+func (s *webhookServiceImpl) FindByID(ctx context.Context, id uint) (*domain.Webhook, error) {
+	webhook, err := s.webhookStore.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if webhook == nil {
+		return nil, domain.ErrWebhookNotFound
+	}
+
+	return hideSecret(*webhook), nil
+}
+
+func (s *webhookServiceImpl) FindMany(ctx context.Context) ([]domain.Webhook, error) {
+	webhooks, err := s.webhookStore.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, webhook := range webhooks {
+		webhooks[i] = *hideSecret(webhook)
+	}
+
+	return webhooks, nil
+}
+
+func (s *webhookServiceImpl) DeleteWebhook(ctx context.Context, id uint) error {
+	if _, err := s.FindByID(ctx, id); err != nil {
+		return err
+	}
+
+	if err := s.webhookStore.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	s.onChange()
+
+	return nil
+}
+
+func (s *webhookServiceImpl) GetDeadLetters(ctx context.Context) ([]domain.WebhookDeadLetter, error) {
+	return s.webhookStore.GetDeadLetters(ctx)
+}
+
+func validateWebhook(request domain.NewWebhook) error {
+	target, err := url.Parse(request.URL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return domain.ErrInvalidWebhook
+	}
+
+	if request.Secret == "" || len(request.EventTypes) == 0 {
+		return domain.ErrInvalidWebhook
+	}
+
+	for _, eventType := range request.EventTypes {
+		if !eventType.IsValid() {
+			return domain.ErrInvalidWebhook
+		}
+	}
+
+	return nil
+}
+
+func hideSecret(webhook domain.Webhook) *domain.Webhook {
+	webhook.Secret = ""
+	return &webhook
+}
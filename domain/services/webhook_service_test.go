@@ -0,0 +1,113 @@
+package services_test
+
+import (
+	"context"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("WebhookService", func() {
+	var (
+		mockWebhookStore *mocks.MockWebhookStore
+		webhookService   services.WebhookService
+		newWebhook       domain.NewWebhook
+	)
+
+	BeforeEach(func() {
+		mockWebhookStore = mocks.NewMockWebhookStore(gomock.NewController(GinkgoT()))
+		webhookService = services.NewWebhookService(mockWebhookStore)
+		newWebhook = domain.NewWebhook{
+			URL:        "https://partner.example.com/hooks",
+			Secret:     "s3cr3t",
+			EventTypes: []domain.OrderEventType{domain.OrderEventCreated},
+		}
+	})
+
+	Describe("CreateWebhook", func() {
+		It("should store the webhook without returning its secret", func() {
+			mockWebhookStore.EXPECT().Save(gomock.Any(), domain.Webhook{NewWebhook: newWebhook}).DoAndReturn(func(_ context.Context, w domain.Webhook) (*domain.Webhook, error) {
+				w.ID = 1
+				return &w, nil
+			})
+
+			webhook, err := webhookService.CreateWebhook(context.Background(), newWebhook)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(webhook.ID).To(Equal(uint(1)))
+			Expect(webhook.Secret).To(BeEmpty())
+		})
+
+		DescribeTable("invalid webhooks", func(update func(w *domain.NewWebhook)) {
+			update(&newWebhook)
+
+			webhook, err := webhookService.CreateWebhook(context.Background(), newWebhook)
+
+			Expect(webhook).To(BeNil())
+			Expect(err).To(Equal(domain.ErrInvalidWebhook))
+		},
+			Entry("with a relative url", func(w *domain.NewWebhook) { w.URL = "/hooks" }),
+			Entry("with an unsupported scheme", func(w *domain.NewWebhook) { w.URL = "ftp://partner.example.com" }),
+			Entry("without a secret", func(w *domain.NewWebhook) { w.Secret = "" }),
+			Entry("without event types", func(w *domain.NewWebhook) { w.EventTypes = nil }),
+			Entry("with unknown event types", func(w *domain.NewWebhook) {
+				w.EventTypes = []domain.OrderEventType{"order.eaten"}
+			}),
+		)
+	})
+
+	It("should tell the dispatcher once the webhooks change", func() {
+		changes := 0
+		webhookService = services.NewWebhookService(mockWebhookStore, services.WithWebhooksChanged(func() { changes++ }))
+
+		mockWebhookStore.EXPECT().Save(gomock.Any(), gomock.Any()).Return(&domain.Webhook{ID: 1, NewWebhook: newWebhook}, nil)
+		mockWebhookStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&domain.Webhook{ID: 1, NewWebhook: newWebhook}, nil)
+		mockWebhookStore.EXPECT().Delete(gomock.Any(), uint(1)).Return(nil)
+
+		_, err := webhookService.CreateWebhook(context.Background(), newWebhook)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(webhookService.DeleteWebhook(context.Background(), 1)).To(Succeed())
+
+		Expect(changes).To(Equal(2))
+	})
+
+	Describe("FindByID", func() {
+		It("should hide the secret", func() {
+			mockWebhookStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&domain.Webhook{ID: 1, NewWebhook: newWebhook}, nil)
+
+			webhook, err := webhookService.FindByID(context.Background(), 1)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(webhook.URL).To(Equal(newWebhook.URL))
+			Expect(webhook.Secret).To(BeEmpty())
+		})
+
+		It("should return an error if the webhook doesn't exist", func() {
+			mockWebhookStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(nil, nil)
+
+			webhook, err := webhookService.FindByID(context.Background(), 1)
+
+			Expect(webhook).To(BeNil())
+			Expect(err).To(Equal(domain.ErrWebhookNotFound))
+		})
+	})
+
+	Describe("DeleteWebhook", func() {
+		It("should delete existing webhooks", func() {
+			mockWebhookStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&domain.Webhook{ID: 1, NewWebhook: newWebhook}, nil)
+			mockWebhookStore.EXPECT().Delete(gomock.Any(), uint(1)).Return(nil)
+
+			Expect(webhookService.DeleteWebhook(context.Background(), 1)).To(Succeed())
+		})
+
+		It("should return an error if the webhook doesn't exist", func() {
+			mockWebhookStore.EXPECT().FindByID(gomock.Any(), uint(1)).Return(nil, nil)
+
+			Expect(webhookService.DeleteWebhook(context.Background(), 1)).To(Equal(domain.ErrWebhookNotFound))
+		})
+	})
+})
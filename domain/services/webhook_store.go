@@ -0,0 +1,16 @@
+package services
+
+import (
+	"context"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+)
+
+type WebhookStore interface {
+	Save(ctx context.Context, webhook domain.Webhook) (*domain.Webhook, error)
+	FindByID(ctx context.Context, id uint) (*domain.Webhook, error)
+	GetAll(ctx context.Context) ([]domain.Webhook, error)
+	Delete(ctx context.Context, id uint) error
+	SaveDeadLetter(ctx context.Context, deadLetter domain.WebhookDeadLetter) (*domain.WebhookDeadLetter, error)
+	GetDeadLetters(ctx context.Context) ([]domain.WebhookDeadLetter, error)
+}
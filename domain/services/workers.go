@@ -0,0 +1,74 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Workers runs the background jobs, keeping track of the ones that stopped
+type Workers struct {
+	mu      sync.Mutex
+	running map[string]bool
+	logger  *slog.Logger
+}
+
+func NewWorkers(logger *slog.Logger) *Workers {
+	return &Workers{
+		running: make(map[string]bool),
+		logger:  logger,
+	}
+}
+
+// Go runs the job in the background until the context is cancelled
+func (w *Workers) Go(ctx context.Context, name string, run func(ctx context.Context)) {
+	w.mu.Lock()
+	w.running[name] = true
+	w.mu.Unlock()
+
+	go func() {
+		defer func() {
+			w.mu.Lock()
+			w.running[name] = false
+			w.mu.Unlock()
+
+			if ctx.Err() == nil {
+				w.logger.ErrorContext(ctx, "background worker stopped", slog.String("worker", name))
+			}
+		}()
+
+		run(ctx)
+	}()
+}
+
+// Stopped returns the names of the workers that are not running anymore
+func (w *Workers) Stopped() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var stopped []string
+	for name, running := range w.running {
+		if !running {
+			stopped = append(stopped, name)
+		}
+	}
+
+	slices.Sort(stopped)
+	return stopped
+}
+
+func (w *Workers) ReadinessCheck() ReadinessCheck {
+	return ReadinessCheck{
+		Name: "workers",
+		Check: func(_ context.Context) error {
+			if stopped := w.Stopped(); len(stopped) > 0 {
+				return fmt.Errorf("stopped workers: %s", strings.Join(stopped, ", "))
+			}
+
+			return nil
+		},
+	}
+}
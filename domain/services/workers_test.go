@@ -0,0 +1,52 @@
+package services_test
+
+import (
+	"context"
+
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Workers", func() {
+	var workers *services.Workers
+
+	BeforeEach(func() {
+		workers = services.NewWorkers(testLogger)
+	})
+
+	runUntilDone := func(ctx context.Context) {
+		<-ctx.Done()
+	}
+
+	It("should be ready while every worker runs", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		workers.Go(ctx, "order_scheduler", runUntilDone)
+		workers.Go(ctx, "order_archiver", runUntilDone)
+
+		Consistently(workers.Stopped).Should(BeEmpty())
+		Expect(workers.ReadinessCheck().Check(ctx)).To(Succeed())
+	})
+
+	It("should report the workers that stopped", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		workers.Go(ctx, "order_scheduler", runUntilDone)
+		workers.Go(ctx, "webhook_dispatcher", func(_ context.Context) {})
+
+		Eventually(workers.Stopped).Should(Equal([]string{"webhook_dispatcher"}))
+		Expect(workers.ReadinessCheck().Check(ctx)).To(MatchError("stopped workers: webhook_dispatcher"))
+	})
+
+	It("should stop being ready once shutting down", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		workers.Go(ctx, "order_scheduler", runUntilDone)
+		cancel()
+
+		Eventually(workers.Stopped).Should(Equal([]string{"order_scheduler"}))
+	})
+})
@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SLAPolicy defines how long an order can stay in a status before it's considered late
+type SLAPolicy struct {
+	StatusLimits map[OrderStatus]time.Duration
+	// Overrides the status limits for orders of a particular source
+	SourceLimits map[OrderSource]map[OrderStatus]time.Duration
+}
+
+func DefaultSLAPolicy() SLAPolicy {
+	return SLAPolicy{
+		StatusLimits: map[OrderStatus]time.Duration{
+			OrderStatusPending:   10 * time.Minute,
+			OrderStatusPreparing: 30 * time.Minute,
+			OrderStatusReady:     15 * time.Minute,
+		},
+		SourceLimits: map[OrderSource]map[OrderStatus]time.Duration{
+			OrderSourceDelivery: {
+				OrderStatusReady:           5 * time.Minute,
+				OrderStatusAwaitingCourier: 15 * time.Minute,
+				OrderStatusOutForDelivery:  45 * time.Minute,
+			},
+		},
+	}
+}
+
+func (p SLAPolicy) LimitFor(source OrderSource, status OrderStatus) (time.Duration, bool) {
+	if limit, ok := p.SourceLimits[source][status]; ok {
+		return limit, true
+	}
+
+	limit, ok := p.StatusLimits[status]
+	return limit, ok
+}
+
+// Override returns a copy of the policy with the limits of a comma separated list of
+// status=duration or source.status=duration entries, e.g. "pending=5m,delivery.preparing=20m"
+func (p SLAPolicy) Override(limits string) (SLAPolicy, error) {
+	result := SLAPolicy{
+		StatusLimits: make(map[OrderStatus]time.Duration, len(p.StatusLimits)),
+		SourceLimits: make(map[OrderSource]map[OrderStatus]time.Duration, len(p.SourceLimits)),
+	}
+
+	for status, limit := range p.StatusLimits {
+		result.StatusLimits[status] = limit
+	}
+
+	for source, statusLimits := range p.SourceLimits {
+		result.SourceLimits[source] = make(map[OrderStatus]time.Duration, len(statusLimits))
+
+		for status, limit := range statusLimits {
+			result.SourceLimits[source][status] = limit
+		}
+	}
+
+	for _, entry := range strings.Split(limits, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		key, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return result, fmt.Errorf("invalid SLA limit %q", entry)
+		}
+
+		limit, err := time.ParseDuration(value)
+		if err != nil {
+			return result, fmt.Errorf("invalid SLA limit %q: %w", entry, err)
+		}
+
+		source, status, hasSource := strings.Cut(key, ".")
+		if !hasSource {
+			status = source
+		}
+
+		if _, ok := statusWeights[OrderStatus(status)]; !ok {
+			return result, fmt.Errorf("invalid SLA limit %q: unknown status", entry)
+		}
+
+		if !hasSource {
+			result.StatusLimits[OrderStatus(status)] = limit
+			continue
+		}
+
+		if result.SourceLimits[OrderSource(source)] == nil {
+			result.SourceLimits[OrderSource(source)] = make(map[OrderStatus]time.Duration)
+		}
+
+		result.SourceLimits[OrderSource(source)][OrderStatus(status)] = limit
+	}
+
+	return result, nil
+}
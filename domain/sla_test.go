@@ -0,0 +1,74 @@
+package domain_test
+
+import (
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SLAPolicy", func() {
+	var policy domain.SLAPolicy
+
+	BeforeEach(func() {
+		policy = domain.SLAPolicy{
+			StatusLimits: map[domain.OrderStatus]time.Duration{
+				domain.OrderStatusPending:   10 * time.Minute,
+				domain.OrderStatusPreparing: 30 * time.Minute,
+			},
+			SourceLimits: map[domain.OrderSource]map[domain.OrderStatus]time.Duration{
+				domain.OrderSourceDelivery: {domain.OrderStatusPreparing: 20 * time.Minute},
+			},
+		}
+	})
+
+	Describe("LimitFor", func() {
+		DescribeTable("resolving limits", func(source domain.OrderSource, status domain.OrderStatus, expected time.Duration, found bool) {
+			limit, ok := policy.LimitFor(source, status)
+			Expect(ok).To(Equal(found))
+			Expect(limit).To(Equal(expected))
+		},
+			Entry("uses the status limit", domain.OrderSourcePhone, domain.OrderStatusPreparing, 30*time.Minute, true),
+			Entry("prefers the source limit", domain.OrderSourceDelivery, domain.OrderStatusPreparing, 20*time.Minute, true),
+			Entry("falls back to the status limit", domain.OrderSourceDelivery, domain.OrderStatusPending, 10*time.Minute, true),
+			Entry("has no limit for other statuses", domain.OrderSourcePhone, domain.OrderStatusReady, time.Duration(0), false),
+		)
+	})
+
+	Describe("Override", func() {
+		It("should override status and source limits", func() {
+			result, err := policy.Override("pending=5m, phone.preparing=15m")
+			Expect(err).ToNot(HaveOccurred())
+
+			limit, _ := result.LimitFor(domain.OrderSourceInPerson, domain.OrderStatusPending)
+			Expect(limit).To(Equal(5 * time.Minute))
+
+			limit, _ = result.LimitFor(domain.OrderSourcePhone, domain.OrderStatusPreparing)
+			Expect(limit).To(Equal(15 * time.Minute))
+		})
+
+		It("should not modify the original policy", func() {
+			_, err := policy.Override("pending=5m,delivery.preparing=1m")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(policy.StatusLimits[domain.OrderStatusPending]).To(Equal(10 * time.Minute))
+			Expect(policy.SourceLimits[domain.OrderSourceDelivery][domain.OrderStatusPreparing]).To(Equal(20 * time.Minute))
+		})
+
+		It("should keep the policy when there are no overrides", func() {
+			result, err := policy.Override("")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(policy))
+		})
+
+		DescribeTable("invalid limits", func(limits string) {
+			_, err := policy.Override(limits)
+			Expect(err).To(HaveOccurred())
+		},
+			Entry("without a duration", "pending"),
+			Entry("with an invalid duration", "pending=soon"),
+			Entry("with an unknown status", "eating=5m"),
+		)
+	})
+})
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+type StaffRole string
+
+const StaffRoleCook StaffRole = "cook"
+const StaffRoleManager StaffRole = "manager"
+
+// Higher ranked roles can do everything the lower ranked ones can
+var staffRoleRanks = map[StaffRole]int{
+	StaffRoleCook:    1,
+	StaffRoleManager: 2,
+}
+
+func (r StaffRole) IsValid() bool {
+	_, ok := staffRoleRanks[r]
+	return ok
+}
+
+// Includes tells whether the role has at least the permissions of the other one
+func (r StaffRole) Includes(other StaffRole) bool {
+	return r.IsValid() && staffRoleRanks[r] >= staffRoleRanks[other]
+}
+
+// StaffKeys maps the API keys handed to the staff to their roles
+type StaffKeys map[string]StaffRole
+
+// ParseStaffKeys reads a comma separated list of key=role entries, e.g. "k1=cook,k2=manager"
+func ParseStaffKeys(keys string) (StaffKeys, error) {
+	result := make(StaffKeys)
+
+	for _, entry := range strings.Split(keys, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		key, role, ok := strings.Cut(entry, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid staff key %q", entry)
+		}
+
+		if !StaffRole(role).IsValid() {
+			return nil, fmt.Errorf("invalid staff key %q: unknown role", entry)
+		}
+
+		result[key] = StaffRole(role)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+type NewTable struct {
+	Name string `json:"name" binding:"required,max=50"`
+	// Section of the floor plan the table is in, e.g. "terrace"
+	Area  string `json:"area,omitempty" binding:"max=50"`
+	Seats uint   `json:"seats" binding:"required,min=1"`
+}
+
+type Table struct {
+	ID uint `json:"id"`
+	NewTable
+}
+
+// SeatTable opens a session for the party seated at a table
+type SeatTable struct {
+	Covers uint `json:"covers" binding:"required,min=1"`
+}
+
+type TableSessionStatus string
+
+const TableSessionStatusOpen TableSessionStatus = "open"
+const TableSessionStatusClosed TableSessionStatus = "closed"
+
+// TableSession is a party seated at a table, from the moment they sit down until the table is
+// cleared. Every round of drinks, mains or desserts is another order of the session
+type TableSession struct {
+	ID       uint               `json:"id"`
+	TableID  uint               `json:"table_id"`
+	Covers   uint               `json:"covers"`
+	Status   TableSessionStatus `json:"status"`
+	OpenedAt time.Time          `json:"opened_at"`
+	ClosedAt *time.Time         `json:"closed_at,omitempty"`
+}
+
+// TableFoodStatus sums up the orders of a table session for the waiters
+type TableFoodStatus string
+
+const TableFoodStatusNoOrders TableFoodStatus = "no_orders"
+
+// The kitchen is still on some of the orders
+const TableFoodStatusInProgress TableFoodStatus = "in_progress"
+
+// Nothing is left in the kitchen and some orders are waiting to be served
+const TableFoodStatusReady TableFoodStatus = "ready"
+const TableFoodStatusServed TableFoodStatus = "served"
+
+type TableSessionView struct {
+	TableSession
+	Table      Table           `json:"table"`
+	FoodStatus TableFoodStatus `json:"food_status"`
+	// Number of orders in each status
+	OrderStatuses map[OrderStatus]int `json:"order_statuses"`
+	// Dishes of the orders, leaving out the cancelled and voided ones
+	Dishes      int     `json:"dishes"`
+	ReadyDishes int     `json:"ready_dishes"`
+	Orders      []Order `json:"orders"`
+}
+
+// NewTableSessionView aggregates the orders of a session. Cancelled orders are listed, but don't count
+// towards the food status
+func NewTableSessionView(table Table, session TableSession, orders []Order) TableSessionView {
+	view := TableSessionView{
+		TableSession:  session,
+		Table:         table,
+		FoodStatus:    TableFoodStatusNoOrders,
+		OrderStatuses: make(map[OrderStatus]int),
+		Orders:        orders,
+	}
+
+	for _, order := range orders {
+		view.OrderStatuses[order.Status]++
+
+		if order.Status == OrderStatusCancelled {
+			continue
+		}
+
+		orderReady := order.Status == OrderStatusReady || order.Status == OrderStatusDone
+
+		for _, dish := range ActiveDishes(order.Dishes) {
+			view.Dishes++
+
+			if orderReady || dish.Status == DishStatusReady {
+				view.ReadyDishes++
+			}
+		}
+
+		if status := tableFoodStatus(order.Status); tableFoodStatusWeights[status] > tableFoodStatusWeights[view.FoodStatus] {
+			view.FoodStatus = status
+		}
+	}
+
+	return view
+}
+
+// The food status of a session is the least advanced one of its orders
+var tableFoodStatusWeights = map[TableFoodStatus]int{
+	TableFoodStatusNoOrders:   0,
+	TableFoodStatusServed:     1,
+	TableFoodStatusReady:      2,
+	TableFoodStatusInProgress: 3,
+}
+
+func tableFoodStatus(status OrderStatus) TableFoodStatus {
+	switch status {
+	case OrderStatusDone:
+		return TableFoodStatusServed
+	case OrderStatusReady:
+		return TableFoodStatusReady
+	default:
+		return TableFoodStatusInProgress
+	}
+}
+
+// CanCloseTableSession tells whether every order of a session is finished
+func CanCloseTableSession(orders []Order) bool {
+	return !slices.ContainsFunc(orders, func(order Order) bool { return !order.Status.IsFinal() })
+}
+
+// FloorPlanTable is a table along with its open session, if any
+type FloorPlanTable struct {
+	Table
+	Session *TableSessionView `json:"session,omitempty"`
+}
+
+type FloorArea struct {
+	Name   string           `json:"name"`
+	Tables []FloorPlanTable `json:"tables"`
+}
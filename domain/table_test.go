@@ -0,0 +1,74 @@
+package domain_test
+
+import (
+	"github.com/danbrato999/yuno-gveloz/domain"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Table sessions", func() {
+	table := domain.Table{ID: 1, NewTable: domain.NewTable{Name: "T1", Seats: 4}}
+	session := domain.TableSession{ID: 7, TableID: 1, Covers: 3, Status: domain.TableSessionStatusOpen}
+
+	order := func(status domain.OrderStatus, dishes ...domain.Dish) domain.Order {
+		return domain.Order{Status: status, NewOrder: domain.NewOrder{Dishes: dishes}}
+	}
+
+	pending := domain.Dish{Name: "Soup", Status: domain.DishStatusPending}
+	ready := domain.Dish{Name: "Salad", Status: domain.DishStatusReady}
+	voided := domain.Dish{Name: "Fries", Status: domain.DishStatusVoided}
+
+	Describe("NewTableSessionView", func() {
+		DescribeTable("summing up the food status",
+			func(orders []domain.Order, status domain.TableFoodStatus) {
+				Expect(domain.NewTableSessionView(table, session, orders).FoodStatus).To(Equal(status))
+			},
+			Entry("without orders", []domain.Order{}, domain.TableFoodStatusNoOrders),
+			Entry("while the kitchen is on any order", []domain.Order{
+				order(domain.OrderStatusReady, pending),
+				order(domain.OrderStatusPreparing, pending),
+			}, domain.TableFoodStatusInProgress),
+			Entry("once every order left the kitchen", []domain.Order{
+				order(domain.OrderStatusDone, pending),
+				order(domain.OrderStatusReady, pending),
+			}, domain.TableFoodStatusReady),
+			Entry("once every order was served", []domain.Order{
+				order(domain.OrderStatusDone, pending),
+				order(domain.OrderStatusCancelled, pending),
+			}, domain.TableFoodStatusServed),
+			Entry("leaving out the cancelled orders", []domain.Order{
+				order(domain.OrderStatusCancelled, pending),
+			}, domain.TableFoodStatusNoOrders),
+		)
+
+		It("counts the orders by status and the ready dishes", func() {
+			view := domain.NewTableSessionView(table, session, []domain.Order{
+				order(domain.OrderStatusPreparing, pending, ready, voided),
+				order(domain.OrderStatusReady, pending),
+				order(domain.OrderStatusCancelled, ready),
+			})
+
+			Expect(view.ID).To(Equal(session.ID))
+			Expect(view.Table).To(Equal(table))
+			Expect(view.OrderStatuses).To(Equal(map[domain.OrderStatus]int{
+				domain.OrderStatusPreparing: 1,
+				domain.OrderStatusReady:     1,
+				domain.OrderStatusCancelled: 1,
+			}))
+			Expect(view.Dishes).To(Equal(3))
+			Expect(view.ReadyDishes).To(Equal(2))
+		})
+	})
+
+	Describe("CanCloseTableSession", func() {
+		It("needs every order to be finished", func() {
+			Expect(domain.CanCloseTableSession(nil)).To(BeTrue())
+			Expect(domain.CanCloseTableSession([]domain.Order{
+				order(domain.OrderStatusDone), order(domain.OrderStatusCancelled),
+			})).To(BeTrue())
+			Expect(domain.CanCloseTableSession([]domain.Order{
+				order(domain.OrderStatusDone), order(domain.OrderStatusReady),
+			})).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,37 @@
+package domain
+
+import "time"
+
+type NewWebhook struct {
+	URL string `json:"url" binding:"required,url"`
+	// Secret used to sign the payloads. It's never returned once the webhook is created
+	Secret     string           `json:"secret,omitempty" binding:"required"`
+	EventTypes []OrderEventType `json:"event_types" binding:"required,min=1"`
+}
+
+type Webhook struct {
+	ID uint `json:"id"`
+	NewWebhook
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+func (w Webhook) Accepts(eventType OrderEventType) bool {
+	for _, accepted := range w.EventTypes {
+		if accepted == eventType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WebhookDeadLetter is a delivery that kept failing after every retry
+type WebhookDeadLetter struct {
+	ID        uint           `json:"id"`
+	WebhookID uint           `json:"webhook_id"`
+	EventType OrderEventType `json:"event_type"`
+	Payload   string         `json:"payload"`
+	Attempts  int            `json:"attempts"`
+	LastError string         `json:"last_error"`
+	FailedAt  time.Time      `json:"failed_at"`
+}
@@ -0,0 +1,214 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	dbAdapter "github.com/danbrato999/yuno-gveloz/internal/gorm"
+	"github.com/spf13/cobra"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type rootOptions struct {
+	dbPath    string
+	batchSize int
+	clock     domain.Clock
+}
+
+func (o *rootOptions) open() (*gorm.DB, error) {
+	return dbAdapter.OpenDB(o.dbPath, logger.Default.LogMode(logger.Error))
+}
+
+// NewRootCommand builds the gveloz-admin command tree, working directly on the sqlite database
+func NewRootCommand(clock domain.Clock) *cobra.Command {
+	options := &rootOptions{clock: clock}
+
+	root := &cobra.Command{
+		Use:           "gveloz-admin",
+		Short:         "Maintain the El Gourmet Veloz database",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	root.PersistentFlags().StringVar(&options.dbPath, "db", dbAdapter.DBPath("main"), "path of the sqlite database")
+	root.PersistentFlags().IntVar(&options.batchSize, "batch-size", dbAdapter.DefaultMaintenanceBatchSize, "orders purged or archived per transaction")
+
+	root.AddCommand(
+		newMigrateCommand(options),
+		newCheckpointCommand(options),
+		newVacuumCommand(options),
+		newBackupCommand(options),
+		newCompletedOrdersCommand(options, "purge", "Delete the orders completed more than --older-than-days ago", (*dbAdapter.Maintenance).PurgeCompletedOrders),
+		newCompletedOrdersCommand(options, "archive", "Move the orders completed more than --older-than-days ago into the archive tables", (*dbAdapter.Maintenance).ArchiveCompletedOrders),
+		newSeedCommand(options),
+	)
+
+	return root
+}
+
+func newMigrateCommand(options *rootOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "migrate",
+		Short: "Create or update the database tables",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Opening the database runs the migrations
+			if _, err := options.open(); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "migrated %s\n", options.dbPath)
+			return nil
+		},
+	}
+}
+
+func newCheckpointCommand(options *rootOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "checkpoint",
+		Short: "Copy the write-ahead log into the database file and truncate it",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db, err := options.open()
+			if err != nil {
+				return err
+			}
+
+			result, err := dbAdapter.NewMaintenance(db, options.clock, options.batchSize).Checkpoint()
+			if err != nil {
+				return err
+			}
+
+			if result.Busy {
+				return fmt.Errorf("checkpoint blocked by other connections, %d of %d frames copied", result.Checkpointed, result.LogFrames)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "checkpointed %d of %d WAL frames\n", result.Checkpointed, result.LogFrames)
+			return nil
+		},
+	}
+}
+
+func newVacuumCommand(options *rootOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "vacuum",
+		Short: "Rebuild the database file to reclaim the space of deleted rows",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db, err := options.open()
+			if err != nil {
+				return err
+			}
+
+			if err = dbAdapter.NewMaintenance(db, options.clock, options.batchSize).Vacuum(); err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), "vacuumed", options.dbPath)
+			return nil
+		},
+	}
+}
+
+func newBackupCommand(options *rootOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "backup PATH",
+		Short: "Write a consistent copy of the database, safe to run while the server is up",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db, err := options.open()
+			if err != nil {
+				return err
+			}
+
+			if err = dbAdapter.NewMaintenance(db, options.clock, options.batchSize).Backup(args[0]); err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), "backed up to", args[0])
+			return nil
+		},
+	}
+}
+
+func newCompletedOrdersCommand(
+	options *rootOptions,
+	use string,
+	short string,
+	operation func(m *dbAdapter.Maintenance, ctx context.Context, olderThan time.Duration) (int, error),
+) *cobra.Command {
+	var days int
+
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if days < 0 {
+				return fmt.Errorf("--older-than-days cannot be negative")
+			}
+
+			db, err := options.open()
+			if err != nil {
+				return err
+			}
+
+			maintenance := dbAdapter.NewMaintenance(db, options.clock, options.batchSize)
+
+			count, err := operation(maintenance, cmd.Context(), time.Duration(days)*24*time.Hour)
+			if err != nil {
+				return fmt.Errorf("%s stopped after %d orders: %w", use, count, err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%s: %d orders\n", use, count)
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&days, "older-than-days", 0, "only orders completed more than this many days ago")
+	cmd.MarkFlagRequired("older-than-days")
+
+	return cmd
+}
+
+func newSeedCommand(options *rootOptions) *cobra.Command {
+	var count int
+
+	cmd := &cobra.Command{
+		Use:   "seed",
+		Short: "Create demo customers and orders",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db, err := options.open()
+			if err != nil {
+				return err
+			}
+
+			orderStore := dbAdapter.NewOrderStore(db, options.clock)
+			orderService := services.NewOrderService(
+				orderStore,
+				dbAdapter.NewOrderPriorityStore(db, options.clock),
+				dbAdapter.NewOrderStatusStore(db, options.clock),
+				services.WithCustomerStore(dbAdapter.NewCustomerStore(db)),
+				services.WithClock(options.clock),
+			)
+			customerService := services.NewCustomerService(dbAdapter.NewCustomerStore(db), orderStore)
+
+			orders, err := Seed(cmd.Context(), orderService, customerService, options.clock, count)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "seeded %d customers and %d orders\n", len(demoCustomers), len(orders))
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&count, "orders", 20, "number of demo orders")
+
+	return cmd
+}
@@ -0,0 +1,152 @@
+package admin_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/fakeclock"
+	"github.com/danbrato999/yuno-gveloz/internal/admin"
+	dbAdapter "github.com/danbrato999/yuno-gveloz/internal/gorm"
+	"github.com/danbrato999/yuno-gveloz/internal/gorm/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+var _ = Describe("Admin commands", func() {
+	var (
+		dir    string
+		dbPath string
+		clock  *fakeclock.Clock
+	)
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		dbPath = filepath.Join(dir, "main.db")
+		clock = fakeclock.New(time.Now())
+	})
+
+	run := func(args ...string) (string, error) {
+		var out bytes.Buffer
+
+		root := admin.NewRootCommand(clock)
+		root.SetArgs(append([]string{"--db", dbPath}, args...))
+		root.SetOut(&out)
+
+		err := root.Execute()
+		return out.String(), err
+	}
+
+	openDB := func() *gorm.DB {
+		db, err := dbAdapter.OpenDB(dbPath, logger.Default.LogMode(logger.Silent))
+		Expect(err).ToNot(HaveOccurred())
+
+		sqlDB, err := db.DB()
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(sqlDB.Close)
+
+		return db
+	}
+
+	count := func(model any) int64 {
+		db := openDB()
+
+		var total int64
+		Expect(db.Model(model).Count(&total).Error).ToNot(HaveOccurred())
+		return total
+	}
+
+	It("should create the database tables", func() {
+		out, err := run("migrate")
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out).To(ContainSubstring("migrated"))
+		Expect(dbPath).To(BeAnExistingFile())
+	})
+
+	It("should seed the demo data once per customer", func() {
+		_, err := run("seed", "--orders", "8")
+		Expect(err).ToNot(HaveOccurred())
+
+		out, err := run("seed", "--orders", "4")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out).To(Equal("seeded 3 customers and 4 orders\n"))
+
+		Expect(count(&models.Customer{})).To(BeNumerically("==", 3))
+		Expect(count(&models.Order{})).To(BeNumerically("==", 12))
+	})
+
+	It("should back up the database without overwriting files", func() {
+		_, err := run("seed", "--orders", "2")
+		Expect(err).ToNot(HaveOccurred())
+
+		backup := filepath.Join(dir, "backup.db")
+		_, err = run("backup", backup)
+		Expect(err).ToNot(HaveOccurred())
+
+		info, err := os.Stat(backup)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.Size()).To(BeNumerically(">", 0))
+
+		_, err = run("backup", backup)
+		Expect(err).To(MatchError(dbAdapter.ErrBackupExists))
+	})
+
+	It("should archive and purge only the old completed orders", func() {
+		_, err := run("seed", "--orders", "8")
+		Expect(err).ToNot(HaveOccurred())
+
+		out, err := run("archive", "--older-than-days", "30")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out).To(Equal("archive: 0 orders\n"))
+
+		clock.Advance(31 * 24 * time.Hour)
+
+		out, err = run("archive", "--older-than-days", "30", "--batch-size", "1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out).To(Equal("archive: 3 orders\n"))
+		Expect(count(&models.ArchivedOrder{})).To(BeNumerically("==", 3))
+
+		var remaining []models.Order
+		Expect(openDB().Find(&remaining).Error).ToNot(HaveOccurred())
+
+		for _, order := range remaining {
+			Expect(order.Status.IsFinal()).To(BeFalse())
+		}
+
+		out, err = run("purge", "--older-than-days", "30")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out).To(Equal("purge: 0 orders\n"))
+	})
+
+	It("should require the age of the orders to archive", func() {
+		_, err := run("purge")
+
+		Expect(err).To(MatchError(ContainSubstring("older-than-days")))
+	})
+
+	It("should checkpoint and vacuum the database", func() {
+		_, err := run("seed", "--orders", "2")
+		Expect(err).ToNot(HaveOccurred())
+
+		out, err := run("checkpoint")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out).To(HavePrefix("checkpointed"))
+
+		_, err = run("vacuum")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should seed orders in every source", func() {
+		_, err := run("seed", "--orders", "3")
+		Expect(err).ToNot(HaveOccurred())
+
+		var sources []domain.OrderSource
+		Expect(openDB().Model(&models.Order{}).Distinct().Pluck("source", &sources).Error).ToNot(HaveOccurred())
+		Expect(sources).To(ConsistOf(domain.OrderSourceInPerson, domain.OrderSourcePhone, domain.OrderSourceDelivery))
+	})
+})
@@ -0,0 +1,121 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+)
+
+var demoCustomers = []domain.NewCustomer{
+	{Name: "Ana Torres", Phone: "+573001112233", Email: "ana@example.com", LoyaltyTier: domain.LoyaltyTierVIP},
+	{Name: "Luis Gomez", Phone: "+573004445566", LoyaltyTier: domain.LoyaltyTierGold},
+	{Name: "Marta Ruiz", Phone: "+573007778899", LoyaltyTier: domain.LoyaltyTierRegular},
+}
+
+var demoMenu = []string{"Bandeja Paisa", "Ajiaco", "Empanadas", "Arepa", "Sancocho", "Limonada"}
+
+var demoCancellation = domain.CancelOrder{Reason: domain.CancellationReasonCustomerRequest}
+
+// Seed creates the demo customers, unless they already exist, and count orders spread across
+// sources and statuses. Everything goes through the services, so the orders get their status
+// history and queue positions like real ones
+func Seed(
+	ctx context.Context,
+	orderService services.OrderService,
+	customerService services.CustomerService,
+	clock domain.Clock,
+	count int,
+) ([]domain.Order, error) {
+	customers := make([]domain.Customer, len(demoCustomers))
+
+	for i, request := range demoCustomers {
+		customer, err := customerService.CreateCustomer(ctx, request)
+		if errors.Is(err, domain.ErrDuplicateCustomer) {
+			customer, err = customerService.FindByPhone(ctx, request.Phone)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("seeding customer %s: %w", request.Name, err)
+		}
+
+		customers[i] = *customer
+	}
+
+	orders := make([]domain.Order, 0, count)
+
+	for i := range count {
+		order, err := orderService.CreateOrder(ctx, demoOrder(i, customers, clock))
+		if err != nil {
+			return orders, fmt.Errorf("seeding order %d: %w", i+1, err)
+		}
+
+		for _, status := range demoStatuses(i, order.Source) {
+			if status == domain.OrderStatusCancelled {
+				order, err = orderService.Cancel(ctx, order.ID, demoCancellation, domain.StaffRoleManager)
+			} else {
+				order, err = orderService.UpdateStatus(ctx, order.ID, status)
+			}
+
+			if err != nil {
+				return orders, fmt.Errorf("seeding order %d: %w", i+1, err)
+			}
+		}
+
+		orders = append(orders, *order)
+	}
+
+	return orders, nil
+}
+
+func demoOrder(i int, customers []domain.Customer, clock domain.Clock) domain.NewOrder {
+	sources := []domain.OrderSource{domain.OrderSourceInPerson, domain.OrderSourcePhone, domain.OrderSourceDelivery}
+
+	order := domain.NewOrder{
+		Time:   clock.Now(),
+		Source: sources[i%len(sources)],
+		Dishes: []domain.Dish{
+			{Name: demoMenu[i%len(demoMenu)]},
+			{Name: demoMenu[(i+2)%len(demoMenu)]},
+		},
+	}
+
+	if order.Source != domain.OrderSourceInPerson {
+		customerID := customers[i%len(customers)].ID
+		order.CustomerID = &customerID
+	}
+
+	if order.Source == domain.OrderSourceDelivery {
+		customer := customers[i%len(customers)]
+		order.Delivery = &domain.DeliveryDetails{
+			Address:      fmt.Sprintf("Calle %d # %d-%d", 10+i, i+1, 20+i),
+			ContactName:  customer.Name,
+			ContactPhone: customer.Phone,
+			FeeCents:     500,
+		}
+	}
+
+	return order
+}
+
+// Roughly half of the orders stay in the queue, the rest are moved along or finished
+func demoStatuses(i int, source domain.OrderSource) []domain.OrderStatus {
+	switch i % 4 {
+	case 1:
+		return []domain.OrderStatus{domain.OrderStatusPreparing}
+	case 2:
+		if source == domain.OrderSourceDelivery {
+			return []domain.OrderStatus{domain.OrderStatusPreparing, domain.OrderStatusReady, domain.OrderStatusDelivered}
+		}
+
+		return []domain.OrderStatus{domain.OrderStatusPreparing, domain.OrderStatusReady, domain.OrderStatusDone}
+	case 3:
+		if i%8 == 7 {
+			return []domain.OrderStatus{domain.OrderStatusCancelled}
+		}
+	}
+
+	return nil
+}
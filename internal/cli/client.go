@@ -0,0 +1,161 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+)
+
+type ListFilters struct {
+	Active    bool
+	Scheduled bool
+	Late      bool
+}
+
+// Client calls the REST API defined in internal/gin/router.go
+type Client struct {
+	baseURL string
+	// Sent as X-API-Key when set, staff keys are needed to cancel orders
+	apiKey     string
+	httpClient *http.Client
+}
+
+type StatusError struct {
+	StatusCode int
+}
+
+func (e StatusError) Error() string {
+	return fmt.Sprintf("server responded with %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+func NewClient(baseURL string, apiKey string, httpClient *http.Client) *Client {
+	return &Client{
+		baseURL:    strings.TrimRight(baseURL, "/") + "/api/v1",
+		apiKey:     apiKey,
+		httpClient: httpClient,
+	}
+}
+
+func (c *Client) CreateOrder(order domain.NewOrder) (*domain.Order, error) {
+	var result domain.Order
+
+	if err := c.do(http.MethodPost, "/orders", order, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (c *Client) ListOrders(filters ListFilters) ([]domain.Order, error) {
+	query := url.Values{}
+
+	if filters.Active {
+		query.Set("active", "true")
+	}
+
+	if filters.Scheduled {
+		query.Set("scheduled", "true")
+	}
+
+	if filters.Late {
+		query.Set("late", "true")
+	}
+
+	path := "/orders"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	var result []domain.Order
+
+	if err := c.do(http.MethodGet, path, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (c *Client) GetOrder(id uint) (*domain.OrderWithStatusHistory, error) {
+	var result domain.OrderWithStatusHistory
+
+	if err := c.do(http.MethodGet, fmt.Sprintf("/orders/%d", id), nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (c *Client) UpdateStatus(id uint, status domain.OrderStatus) (*domain.Order, error) {
+	var result domain.Order
+
+	if err := c.do(http.MethodPut, fmt.Sprintf("/orders/%d/status/%s", id, url.PathEscape(string(status))), nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (c *Client) Cancel(id uint, request domain.CancelOrder) (*domain.Order, error) {
+	var result domain.Order
+
+	if err := c.do(http.MethodPost, fmt.Sprintf("/orders/%d/cancel", id), request, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (c *Client) Prioritize(id uint, afterID uint) error {
+	body := map[string]uint{"after_id": afterID}
+
+	return c.do(http.MethodPut, fmt.Sprintf("/orders/%d/prioritize", id), body, nil)
+}
+
+func (c *Client) do(method string, path string, body any, result any) error {
+	var reader io.Reader
+
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+
+		reader = bytes.NewReader(payload)
+	}
+
+	request, err := http.NewRequest(method, c.baseURL+path, reader)
+	if err != nil {
+		return err
+	}
+
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	if c.apiKey != "" {
+		request.Header.Set("X-API-Key", c.apiKey)
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode >= http.StatusBadRequest {
+		return StatusError{StatusCode: response.StatusCode}
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	return json.NewDecoder(response.Body).Decode(result)
+}
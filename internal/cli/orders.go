@@ -0,0 +1,320 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/spf13/cobra"
+)
+
+func newOrdersCommand(options *rootOptions) *cobra.Command {
+	orders := &cobra.Command{
+		Use:     "orders",
+		Aliases: []string{"order"},
+		Short:   "Manage orders",
+	}
+
+	orders.AddCommand(
+		newCreateOrderCommand(options),
+		newListOrdersCommand(options),
+		newShowOrderCommand(options),
+		newUpdateStatusCommand(options),
+		newCancelOrderCommand(options),
+		newPrioritizeOrderCommand(options),
+	)
+
+	return orders
+}
+
+func newCreateOrderCommand(options *rootOptions) *cobra.Command {
+	var (
+		file     string
+		order    domain.NewOrder
+		source   string
+		dishes   []string
+		customer uint
+		table    uint
+		orderAt  string
+		readyAt  string
+		delivery domain.DeliveryDetails
+		// Severe allergies reject the orders with dishes known to contain them
+		allergies       []string
+		severeAllergies []string
+		dietary         []string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create an order from a JSON file or from flags",
+		Example: `  gveloz orders create --source in_person --dish Pizza --dish Salad
+  gveloz orders create --source phone --dish "Pad Thai" --severe-allergy peanuts --notes "No cilantro"
+  gveloz orders create --file order.json
+  cat order.json | gveloz orders create --file -`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if file != "" {
+				if err := readOrderFile(cmd, file, &order); err != nil {
+					return err
+				}
+			} else {
+				if source == "" || len(dishes) == 0 {
+					return errors.New("--source and at least one --dish are required unless --file is given")
+				}
+
+				order.Source = domain.OrderSource(source)
+				order.Time = time.Now()
+
+				for _, dish := range dishes {
+					order.Dishes = append(order.Dishes, domain.Dish{Name: dish})
+				}
+
+				if cmd.Flags().Changed("customer-id") {
+					order.CustomerID = &customer
+				}
+
+				if cmd.Flags().Changed("table") {
+					order.TableID = &table
+				}
+
+				if orderAt != "" {
+					parsed, err := time.Parse(time.RFC3339, orderAt)
+					if err != nil {
+						return fmt.Errorf("invalid --time: %w", err)
+					}
+
+					order.Time = parsed
+				}
+
+				if readyAt != "" {
+					parsed, err := time.Parse(time.RFC3339, readyAt)
+					if err != nil {
+						return fmt.Errorf("invalid --ready-at: %w", err)
+					}
+
+					order.ReadyAt = &parsed
+				}
+
+				if delivery.Address != "" {
+					order.Delivery = &delivery
+				}
+
+				for _, allergen := range allergies {
+					order.Allergies = append(order.Allergies, domain.Allergy{Allergen: domain.Allergen(allergen)})
+				}
+
+				for _, allergen := range severeAllergies {
+					order.Allergies = append(order.Allergies, domain.Allergy{Allergen: domain.Allergen(allergen), Severe: true})
+				}
+
+				for _, flag := range dietary {
+					order.Dietary = append(order.Dietary, domain.DietaryFlag(flag))
+				}
+
+				if err := order.ValidateDiet(); err != nil {
+					return err
+				}
+			}
+
+			created, err := options.client().CreateOrder(order)
+			if err != nil {
+				return err
+			}
+
+			return render(cmd.OutOrStdout(), options.output, created, ordersTable(*created))
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&file, "file", "f", "", "JSON file with the order, - reads it from stdin")
+	flags.StringVar(&source, "source", "", "order source: in_person, delivery or phone")
+	flags.StringArrayVar(&dishes, "dish", nil, "dish name, can be repeated")
+	flags.UintVar(&customer, "customer-id", 0, "customer placing the order")
+	flags.StringVar(&order.CustomerPhone, "customer-phone", "", "phone of a known customer placing the order")
+	flags.UintVar(&table, "table", 0, "seated table the in person order is for")
+	flags.StringVar(&orderAt, "time", "", "time the order was placed in RFC3339, defaults to now")
+	flags.StringVar(&readyAt, "ready-at", "", "schedule the order to be ready at this RFC3339 time")
+	flags.StringVar(&delivery.Address, "address", "", "delivery address")
+	flags.StringVar(&delivery.ContactName, "contact-name", "", "delivery contact name")
+	flags.StringVar(&delivery.ContactPhone, "contact-phone", "", "delivery contact phone")
+	flags.UintVar(&delivery.FeeCents, "fee-cents", 0, "delivery fee in cents")
+	flags.StringVar(&order.Notes, "notes", "", "notes for the kitchen")
+	flags.StringArrayVar(&allergies, "allergy", nil, "allergen the customer is allergic to, e.g. milk, can be repeated")
+	flags.StringArrayVar(&severeAllergies, "severe-allergy", nil, "allergen the customer is severely allergic to, can be repeated")
+	flags.StringArrayVar(&dietary, "diet", nil, "dietary flag, e.g. vegan or gluten_free, can be repeated")
+	cmd.MarkFlagsMutuallyExclusive("file", "source")
+	cmd.MarkFlagsMutuallyExclusive("file", "dish")
+
+	return cmd
+}
+
+func newListOrdersCommand(options *rootOptions) *cobra.Command {
+	var filters ListFilters
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List orders, all of them unless filtered",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			orders, err := options.client().ListOrders(filters)
+			if err != nil {
+				return err
+			}
+
+			return render(cmd.OutOrStdout(), options.output, orders, ordersTable(orders...))
+		},
+	}
+
+	cmd.Flags().BoolVar(&filters.Active, "active", false, "only active orders, sorted by their queue position")
+	cmd.Flags().BoolVar(&filters.Scheduled, "scheduled", false, "only scheduled orders")
+	cmd.Flags().BoolVar(&filters.Late, "late", false, "only late orders")
+
+	return cmd
+}
+
+func newShowOrderCommand(options *rootOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "show ID",
+		Short: "Show an order with its status history",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseOrderID(args[0])
+			if err != nil {
+				return err
+			}
+
+			order, err := options.client().GetOrder(id)
+			if err != nil {
+				return err
+			}
+
+			return render(cmd.OutOrStdout(), options.output, order, ordersTable(order.Order), historyTable(order.StatusHistory))
+		},
+	}
+}
+
+func newUpdateStatusCommand(options *rootOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:     "status ID STATUS",
+		Short:   "Advance an order to a new status",
+		Example: "  gveloz orders status 12 preparing",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return updateStatus(cmd, options, args[0], domain.OrderStatus(args[1]))
+		},
+	}
+}
+
+func newCancelOrderCommand(options *rootOptions) *cobra.Command {
+	var (
+		reason string
+		note   string
+	)
+
+	cmd := &cobra.Command{
+		Use:     "cancel ID --reason REASON",
+		Short:   "Cancel an order, with the staff role of the API key",
+		Example: "  gveloz orders cancel 12 --reason out_of_stock --api-key $KEY",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseOrderID(args[0])
+			if err != nil {
+				return err
+			}
+
+			request := domain.CancelOrder{Reason: domain.CancellationReason(reason), Note: note}
+			if !request.IsValid() {
+				return fmt.Errorf("invalid cancellation reason %q, or missing note for other", reason)
+			}
+
+			order, err := options.client().Cancel(id, request)
+			if err != nil {
+				return err
+			}
+
+			return render(cmd.OutOrStdout(), options.output, order, ordersTable(*order))
+		},
+	}
+
+	cmd.Flags().StringVar(&reason, "reason", "", "customer_request, out_of_stock, kitchen_error, duplicate, payment_failed or other")
+	cmd.Flags().StringVar(&note, "note", "", "explanation of the cancellation, required for the other reason")
+	cmd.MarkFlagRequired("reason")
+
+	return cmd
+}
+
+func newPrioritizeOrderCommand(options *rootOptions) *cobra.Command {
+	var afterID uint
+
+	cmd := &cobra.Command{
+		Use:   "prioritize ID --after AFTER_ID",
+		Short: "Move an order in the kitchen queue right after another one",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseOrderID(args[0])
+			if err != nil {
+				return err
+			}
+
+			if err = options.client().Prioritize(id, afterID); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "order %d moved after order %d\n", id, afterID)
+			return nil
+		},
+	}
+
+	cmd.Flags().UintVar(&afterID, "after", 0, "order that should be prepared right before this one")
+	cmd.MarkFlagRequired("after")
+
+	return cmd
+}
+
+func updateStatus(cmd *cobra.Command, options *rootOptions, rawID string, status domain.OrderStatus) error {
+	id, err := parseOrderID(rawID)
+	if err != nil {
+		return err
+	}
+
+	order, err := options.client().UpdateStatus(id, status)
+	if err != nil {
+		return err
+	}
+
+	return render(cmd.OutOrStdout(), options.output, order, ordersTable(*order))
+}
+
+func readOrderFile(cmd *cobra.Command, file string, order *domain.NewOrder) error {
+	var reader io.Reader = cmd.InOrStdin()
+
+	if file != "-" {
+		opened, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+
+		defer opened.Close()
+		reader = opened
+	}
+
+	if err := json.NewDecoder(reader).Decode(order); err != nil {
+		return fmt.Errorf("invalid order JSON: %w", err)
+	}
+
+	return nil
+}
+
+func parseOrderID(value string) (uint, error) {
+	id, err := strconv.ParseUint(value, 10, 64)
+	if err != nil || id == 0 {
+		return 0, fmt.Errorf("invalid order id %q", value)
+	}
+
+	return uint(id), nil
+}
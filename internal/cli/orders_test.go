@@ -0,0 +1,195 @@
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
+	"github.com/danbrato999/yuno-gveloz/internal/cli"
+	internalGin "github.com/danbrato999/yuno-gveloz/internal/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Orders commands", func() {
+	var (
+		mockService *mocks.MockOrderService
+		server      *httptest.Server
+		orderTime   time.Time
+		pizzaOrder  domain.Order
+	)
+
+	BeforeEach(func() {
+		mockService = mocks.NewMockOrderService(gomock.NewController(GinkgoT()))
+		server = httptest.NewServer(internalGin.GetServer(internalGin.Services{
+			Orders:    mockService,
+			StaffKeys: domain.StaffKeys{"m4n4g3r": domain.StaffRoleManager},
+		}))
+		DeferCleanup(server.Close)
+
+		orderTime = time.Date(2025, 2, 10, 12, 0, 0, 0, time.UTC)
+		pizzaOrder = domain.Order{
+			ID:     1,
+			Status: domain.OrderStatusPending,
+			NewOrder: domain.NewOrder{
+				Time:   orderTime,
+				Dishes: []domain.Dish{{Name: "Pizza"}, {Name: "Salad"}},
+				Source: domain.OrderSourceInPerson,
+			},
+			Alerts: []string{"diet: vegan"},
+		}
+	})
+
+	run := func(args ...string) (string, error) {
+		var out bytes.Buffer
+
+		root := cli.NewRootCommand(http.DefaultClient)
+		root.SetArgs(append([]string{"--server", server.URL}, args...))
+		root.SetOut(&out)
+		root.SetIn(strings.NewReader(""))
+
+		err := root.Execute()
+		return out.String(), err
+	}
+
+	Describe("create", func() {
+		It("should create the order from flags", func() {
+			mockService.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request domain.NewOrder) (*domain.Order, error) {
+				Expect(request.Source).To(Equal(domain.OrderSourceInPerson))
+				Expect(request.Dishes).To(Equal([]domain.Dish{{Name: "Pizza"}, {Name: "Salad"}}))
+				Expect(request.Time).To(BeTemporally("==", orderTime))
+				Expect(request.Allergies).To(Equal([]domain.Allergy{{Allergen: domain.AllergenPeanuts, Severe: true}}))
+				Expect(request.Dietary).To(Equal([]domain.DietaryFlag{domain.DietaryVegan}))
+				Expect(*request.TableID).To(Equal(uint(4)))
+				return &pizzaOrder, nil
+			})
+
+			out, err := run("orders", "create", "--source", "in_person", "--dish", "Pizza", "--dish", "Salad",
+				"--time", "2025-02-10T12:00:00Z", "--severe-allergy", "peanuts", "--diet", "vegan", "--table", "4")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out).To(ContainSubstring("Pizza; Salad"))
+		})
+
+		It("should create the order from a JSON file", func() {
+			file := filepath.Join(GinkgoT().TempDir(), "order.json")
+			Expect(os.WriteFile(file, []byte(`{"time": "2025-02-10T12:00:00Z", "dishes": [{"name": "Pizza"}], "source": "phone"}`), 0o600)).To(Succeed())
+
+			mockService.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request domain.NewOrder) (*domain.Order, error) {
+				Expect(request.Source).To(Equal(domain.OrderSourcePhone))
+				return &domain.Order{ID: 2, Status: domain.OrderStatusPending, NewOrder: request}, nil
+			})
+
+			out, err := run("orders", "create", "--file", file, "-o", "json")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out).To(ContainSubstring(`"id": 2`))
+		})
+
+		It("should require a source and dishes without a file", func() {
+			_, err := run("orders", "create", "--source", "phone")
+
+			Expect(err).To(MatchError(ContainSubstring("--dish")))
+		})
+	})
+
+	Describe("list", func() {
+		It("should print the active orders as a table", func() {
+			mockService.EXPECT().FindMany(gomock.Any(), gomock.Len(1)).Return([]domain.Order{pizzaOrder}, nil)
+
+			out, err := run("orders", "list", "--active")
+
+			Expect(err).ToNot(HaveOccurred())
+			lines := strings.Split(strings.TrimSpace(out), "\n")
+			Expect(lines).To(HaveLen(2))
+			Expect(lines[0]).To(MatchRegexp(`^ID\s+STATUS\s+SOURCE\s+DISHES`))
+			Expect(lines[1]).To(MatchRegexp(`^1\s+pending\s+in_person\s+Pizza; Salad`))
+		})
+
+		It("should print the orders as CSV", func() {
+			mockService.EXPECT().FindMany(gomock.Any()).Return([]domain.Order{pizzaOrder}, nil)
+
+			out, err := run("orders", "list", "-o", "csv")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out).To(Equal("ID,STATUS,SOURCE,DISHES,CUSTOMER,TIME,READY AT,LATE,ALERTS\n" +
+				"1,pending,in_person,Pizza; Salad,,2025-02-10T12:00:00Z,,false,diet: vegan\n"))
+		})
+
+		It("should reject unknown output formats", func() {
+			_, err := run("orders", "list", "-o", "yaml")
+
+			Expect(err).To(MatchError(ContainSubstring("unknown output")))
+		})
+	})
+
+	Describe("show", func() {
+		It("should print the order with its history", func() {
+			mockService.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&domain.OrderWithStatusHistory{
+				Order:         pizzaOrder,
+				StatusHistory: []domain.OrderStatusHistory{{Status: domain.OrderStatusPending, Timestamp: &orderTime}},
+			}, nil)
+
+			out, err := run("orders", "show", "1")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out).To(MatchRegexp(`STATUS\s+TIMESTAMP\npending\s+2025-02-10T12:00:00Z`))
+		})
+
+		It("should report missing orders", func() {
+			mockService.EXPECT().FindByID(gomock.Any(), uint(9)).Return(nil, domain.ErrOrderNotFound)
+
+			_, err := run("orders", "show", "9")
+
+			Expect(err).To(MatchError(cli.StatusError{StatusCode: http.StatusNotFound}))
+		})
+	})
+
+	Describe("status and cancel", func() {
+		It("should advance the order status", func() {
+			pizzaOrder.Status = domain.OrderStatusPreparing
+			mockService.EXPECT().UpdateStatus(gomock.Any(), uint(1), domain.OrderStatusPreparing).Return(&pizzaOrder, nil)
+
+			out, err := run("orders", "status", "1", "preparing")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out).To(ContainSubstring("preparing"))
+		})
+
+		It("should cancel the order with the role of the API key", func() {
+			pizzaOrder.Status = domain.OrderStatusCancelled
+			request := domain.CancelOrder{Reason: domain.CancellationReasonOther, Note: "Customer left"}
+			mockService.EXPECT().Cancel(gomock.Any(), uint(1), request, domain.StaffRoleManager).Return(&pizzaOrder, nil)
+
+			out, err := run("orders", "cancel", "1", "--reason", "other", "--note", "Customer left", "--api-key", "m4n4g3r")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out).To(ContainSubstring("cancelled"))
+		})
+
+		It("should require a valid reason", func() {
+			_, err := run("orders", "cancel", "1", "--reason", "bored")
+
+			Expect(err).To(MatchError(ContainSubstring("invalid cancellation reason")))
+		})
+	})
+
+	Describe("prioritize", func() {
+		It("should move the order after another one", func() {
+			mockService.EXPECT().Prioritize(gomock.Any(), uint(3), uint(1)).Return(nil)
+
+			out, err := run("orders", "prioritize", "3", "--after", "1")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out).To(Equal("order 3 moved after order 1\n"))
+		})
+	})
+})
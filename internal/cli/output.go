@@ -0,0 +1,148 @@
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+)
+
+const (
+	OutputTable = "table"
+	OutputJSON  = "json"
+	OutputCSV   = "csv"
+)
+
+var outputFormats = []string{OutputTable, OutputJSON, OutputCSV}
+
+type table struct {
+	headers []string
+	rows    [][]string
+}
+
+var orderHeaders = []string{"ID", "STATUS", "SOURCE", "DISHES", "CUSTOMER", "TIME", "READY AT", "LATE", "ALERTS"}
+
+func validOutput(format string) bool {
+	for _, value := range outputFormats {
+		if format == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// render writes the raw value as JSON, or its tables for the other formats
+func render(out io.Writer, format string, value any, tables ...table) error {
+	switch format {
+	case OutputJSON:
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(value)
+	case OutputCSV:
+		return renderCSV(out, tables)
+	default:
+		return renderTables(out, tables)
+	}
+}
+
+func renderTables(out io.Writer, tables []table) error {
+	for i, t := range tables {
+		if i > 0 {
+			fmt.Fprintln(out)
+		}
+
+		writer := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(writer, strings.Join(t.headers, "\t"))
+
+		for _, row := range t.rows {
+			fmt.Fprintln(writer, strings.Join(row, "\t"))
+		}
+
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func renderCSV(out io.Writer, tables []table) error {
+	for i, t := range tables {
+		if i > 0 {
+			fmt.Fprintln(out)
+		}
+
+		writer := csv.NewWriter(out)
+		writer.Write(t.headers)
+		writer.WriteAll(t.rows)
+
+		if err := writer.Error(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func ordersTable(orders ...domain.Order) table {
+	result := table{
+		headers: orderHeaders,
+		rows:    make([][]string, len(orders)),
+	}
+
+	for i, order := range orders {
+		// Voided dishes are no longer made, so they are left out of the table
+		active := domain.ActiveDishes(order.Dishes)
+		dishes := make([]string, len(active))
+		for j, dish := range active {
+			dishes[j] = dish.Name
+		}
+
+		customer := ""
+		if order.CustomerID != nil {
+			customer = strconv.FormatUint(uint64(*order.CustomerID), 10)
+		}
+
+		result.rows[i] = []string{
+			strconv.FormatUint(uint64(order.ID), 10),
+			string(order.Status),
+			string(order.Source),
+			strings.Join(dishes, "; "),
+			customer,
+			formatTime(&order.Time),
+			formatTime(order.ReadyAt),
+			strconv.FormatBool(order.Late),
+			strings.Join(order.Alerts, "; "),
+		}
+	}
+
+	return result
+}
+
+func historyTable(history []domain.OrderStatusHistory) table {
+	result := table{
+		headers: []string{"STATUS", "TIMESTAMP"},
+		rows:    make([][]string, len(history)),
+	}
+
+	for i, change := range history {
+		result.rows[i] = []string{string(change.Status), formatTime(change.Timestamp)}
+	}
+
+	return result
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
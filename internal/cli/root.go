@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+const DefaultServer = "http://localhost:9001"
+
+type rootOptions struct {
+	server     string
+	apiKey     string
+	output     string
+	httpClient *http.Client
+}
+
+func (o *rootOptions) client() *Client {
+	return NewClient(o.server, o.apiKey, o.httpClient)
+}
+
+// NewRootCommand builds the gveloz command tree. The server and API key default to GVELOZ_SERVER
+// and GVELOZ_API_KEY when set
+func NewRootCommand(httpClient *http.Client) *cobra.Command {
+	options := &rootOptions{httpClient: httpClient}
+
+	server := os.Getenv("GVELOZ_SERVER")
+	if server == "" {
+		server = DefaultServer
+	}
+
+	root := &cobra.Command{
+		Use:           "gveloz",
+		Short:         "Operate the El Gourmet Veloz order service",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if !validOutput(options.output) {
+				return fmt.Errorf("unknown output %q, expected one of %s", options.output, strings.Join(outputFormats, ", "))
+			}
+
+			return nil
+		},
+	}
+
+	root.PersistentFlags().StringVar(&options.server, "server", server, "base URL of the order service")
+	root.PersistentFlags().StringVar(&options.apiKey, "api-key", os.Getenv("GVELOZ_API_KEY"), "staff API key, needed to cancel orders")
+	root.PersistentFlags().StringVarP(&options.output, "output", "o", OutputTable, "output format: table, json or csv")
+
+	root.AddCommand(newOrdersCommand(options))
+	return root
+}
@@ -0,0 +1,40 @@
+package gin
+
+import (
+	"net/http"
+
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/gin-gonic/gin"
+)
+
+type AdminHandler struct {
+	queueChecker services.QueueIntegrityChecker
+}
+
+func NewAdminHandler(queueChecker services.QueueIntegrityChecker) *AdminHandler {
+	return &AdminHandler{
+		queueChecker: queueChecker,
+	}
+}
+
+func (a *AdminHandler) VerifyQueue(c *gin.Context) {
+	report, err := a.queueChecker.Verify(c.Request.Context())
+
+	if err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+func (a *AdminHandler) RepairQueue(c *gin.Context) {
+	report, err := a.queueChecker.Repair(c.Request.Context())
+
+	if err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
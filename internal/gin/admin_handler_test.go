@@ -0,0 +1,86 @@
+package gin_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
+	internalGin "github.com/danbrato999/yuno-gveloz/internal/gin"
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("AdminHandler", func() {
+	var (
+		ctrl             *gomock.Controller
+		mockQueueChecker *mocks.MockQueueIntegrityChecker
+		router           *gin.Engine
+		recorder         *httptest.ResponseRecorder
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockQueueChecker = mocks.NewMockQueueIntegrityChecker(ctrl)
+		recorder = httptest.NewRecorder()
+		router = internalGin.GetServer(internalGin.Services{
+			QueueChecker: mockQueueChecker,
+			StaffKeys:    domain.StaffKeys{"k1tch3n": domain.StaffRoleCook, "fl00r": domain.StaffRoleManager},
+		})
+	})
+
+	managerRequest := func(method string, url string) *http.Request {
+		req, _ := http.NewRequest(method, url, nil)
+		req.Header.Set(internalGin.APIKeyHeader, "fl00r")
+		return req
+	}
+
+	Describe("Verify Queue", func() {
+		It("should return the integrity report", func() {
+			mockQueueChecker.EXPECT().Verify(gomock.Any()).Return(&domain.QueueIntegrityReport{Gaps: []uint{2}}, nil)
+
+			router.ServeHTTP(recorder, managerRequest(http.MethodGet, "/api/v1/admin/queue/integrity"))
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"healthy":false`))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"gaps":[2]`))
+		})
+
+		It("should return 500 when verification fails", func() {
+			mockQueueChecker.EXPECT().Verify(gomock.Any()).Return(nil, errors.New("error"))
+
+			router.ServeHTTP(recorder, managerRequest(http.MethodGet, "/api/v1/admin/queue/integrity"))
+
+			Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+
+	Describe("Repair Queue", func() {
+		It("should return the issues found before repairing", func() {
+			mockQueueChecker.EXPECT().Repair(gomock.Any()).Return(&domain.QueueIntegrityReport{Duplicates: []uint{3}}, nil)
+
+			router.ServeHTTP(recorder, managerRequest(http.MethodPost, "/api/v1/admin/queue/repair"))
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"duplicates":[3]`))
+		})
+
+		It("should reject the requests without a staff key", func() {
+			req, _ := http.NewRequest(http.MethodPost, "/api/v1/admin/queue/repair", nil)
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+		})
+
+		It("should only let the managers repair the queue", func() {
+			req, _ := http.NewRequest(http.MethodPost, "/api/v1/admin/queue/repair", nil)
+			req.Header.Set(internalGin.APIKeyHeader, "k1tch3n")
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusForbidden))
+		})
+	})
+})
@@ -0,0 +1,164 @@
+package gin
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/gin-gonic/gin"
+)
+
+type CustomersHandler struct {
+	customerService services.CustomerService
+}
+
+func NewCustomersHandler(customerService services.CustomerService) *CustomersHandler {
+	return &CustomersHandler{
+		customerService: customerService,
+	}
+}
+
+func (h *CustomersHandler) Create(c *gin.Context) {
+	var body domain.NewCustomer
+
+	if err := bindJSON(c, &body); err != nil {
+		return
+	}
+
+	customer, err := h.customerService.CreateCustomer(c.Request.Context(), body)
+
+	if err != nil {
+		abortWithCustomerError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, customer)
+}
+
+func (h *CustomersHandler) List(c *gin.Context) {
+	var queryParams struct {
+		Phone string `form:"phone"`
+	}
+
+	if err := c.BindQuery(&queryParams); err != nil {
+		return
+	}
+
+	if queryParams.Phone == "" {
+		customers, err := h.customerService.FindMany(c.Request.Context())
+
+		if err != nil {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		c.JSON(http.StatusOK, customers)
+		return
+	}
+
+	customer, err := h.customerService.FindByPhone(c.Request.Context(), queryParams.Phone)
+
+	if errors.Is(err, domain.ErrCustomerNotFound) {
+		c.JSON(http.StatusOK, []domain.Customer{})
+		return
+	}
+
+	if err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.JSON(http.StatusOK, []domain.Customer{*customer})
+}
+
+func (h *CustomersHandler) Find(c *gin.Context) {
+	customerID, err := strconv.Atoi(c.Param("id"))
+
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	customer, err := h.customerService.FindByID(c.Request.Context(), uint(customerID))
+
+	if err != nil {
+		abortWithCustomerError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, customer)
+}
+
+func (h *CustomersHandler) Update(c *gin.Context) {
+	customerID, err := strconv.Atoi(c.Param("id"))
+
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	var body domain.NewCustomer
+
+	if err := bindJSON(c, &body); err != nil {
+		return
+	}
+
+	customer, err := h.customerService.UpdateCustomer(c.Request.Context(), uint(customerID), body)
+
+	if err != nil {
+		abortWithCustomerError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, customer)
+}
+
+func (h *CustomersHandler) Delete(c *gin.Context) {
+	customerID, err := strconv.Atoi(c.Param("id"))
+
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	if err := h.customerService.DeleteCustomer(c.Request.Context(), uint(customerID)); err != nil {
+		abortWithCustomerError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *CustomersHandler) ListOrders(c *gin.Context) {
+	customerID, err := strconv.Atoi(c.Param("id"))
+
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	orders, err := h.customerService.GetOrderHistory(c.Request.Context(), uint(customerID))
+
+	if err != nil {
+		abortWithCustomerError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, orders)
+}
+
+func abortWithCustomerError(c *gin.Context, err error) {
+	status := unexpectedErrorStatus(err)
+
+	if errors.Is(err, domain.ErrCustomerNotFound) {
+		status = http.StatusNotFound
+	}
+
+	if errors.Is(err, domain.ErrDuplicateCustomer) {
+		status = http.StatusConflict
+	}
+
+	c.AbortWithStatus(status)
+}
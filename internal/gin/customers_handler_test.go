@@ -0,0 +1,152 @@
+package gin_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
+	internalGin "github.com/danbrato999/yuno-gveloz/internal/gin"
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+const customersAPIUri = "/api/v1/customers"
+
+var _ = Describe("CustomersHandler", func() {
+	var (
+		ctrl                *gomock.Controller
+		mockCustomerService *mocks.MockCustomerService
+		router              *gin.Engine
+		recorder            *httptest.ResponseRecorder
+		customer            *domain.Customer
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockCustomerService = mocks.NewMockCustomerService(ctrl)
+		recorder = httptest.NewRecorder()
+		router = internalGin.GetServer(internalGin.Services{Customers: mockCustomerService})
+		customer = &domain.Customer{
+			ID:          1,
+			NewCustomer: domain.NewCustomer{Name: "Ana", Phone: "5550000", LoyaltyTier: domain.LoyaltyTierVIP},
+		}
+	})
+
+	Describe("Create Customer", func() {
+		It("should return 201 Created", func() {
+			mockCustomerService.EXPECT().CreateCustomer(gomock.Any(), customer.NewCustomer).Return(customer, nil)
+
+			body, _ := json.Marshal(customer.NewCustomer)
+			req, _ := http.NewRequest(http.MethodPost, customersAPIUri, bytes.NewBuffer(body))
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusCreated))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"loyalty_tier":"vip"`))
+		})
+
+		DescribeTable("request is incorrect", func(request domain.NewCustomer) {
+			body, _ := json.Marshal(request)
+			req, _ := http.NewRequest(http.MethodPost, customersAPIUri, bytes.NewBuffer(body))
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		},
+			Entry("when no name is provided", domain.NewCustomer{Phone: "5550000"}),
+			Entry("when no phone is provided", domain.NewCustomer{Name: "Ana"}),
+			Entry("when the email is invalid", domain.NewCustomer{Name: "Ana", Phone: "5550000", Email: "ana"}),
+			Entry("when the tier is invalid", domain.NewCustomer{Name: "Ana", Phone: "5550000", LoyaltyTier: "diamond"}),
+		)
+
+		It("should return 409 Conflict when the phone is taken", func() {
+			mockCustomerService.EXPECT().CreateCustomer(gomock.Any(), gomock.Any()).Return(nil, domain.ErrDuplicateCustomer)
+
+			body, _ := json.Marshal(customer.NewCustomer)
+			req, _ := http.NewRequest(http.MethodPost, customersAPIUri, bytes.NewBuffer(body))
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusConflict))
+		})
+	})
+
+	Describe("List Customers", func() {
+		It("should look up customers by phone", func() {
+			mockCustomerService.EXPECT().FindByPhone(gomock.Any(), "5550000").Return(customer, nil)
+
+			req, _ := http.NewRequest(http.MethodGet, customersAPIUri+"?phone=5550000", nil)
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(HavePrefix(`[{"id":1`))
+		})
+
+		It("should return an empty list for unknown phones", func() {
+			mockCustomerService.EXPECT().FindByPhone(gomock.Any(), "5550000").Return(nil, domain.ErrCustomerNotFound)
+
+			req, _ := http.NewRequest(http.MethodGet, customersAPIUri+"?phone=5550000", nil)
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(Equal("[]"))
+		})
+
+		It("should return every customer without filters", func() {
+			mockCustomerService.EXPECT().FindMany(gomock.Any()).Return([]domain.Customer{*customer}, nil)
+
+			req, _ := http.NewRequest(http.MethodGet, customersAPIUri, nil)
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"name":"Ana"`))
+		})
+	})
+
+	Describe("Find Customer", func() {
+		It("should return 404 Not Found for unknown customers", func() {
+			mockCustomerService.EXPECT().FindByID(gomock.Any(), uint(1)).Return(nil, domain.ErrCustomerNotFound)
+
+			req, _ := http.NewRequest(http.MethodGet, customersAPIUri+"/1", nil)
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Describe("Delete Customer", func() {
+		It("should return 204 No Content", func() {
+			mockCustomerService.EXPECT().DeleteCustomer(gomock.Any(), uint(1)).Return(nil)
+
+			req, _ := http.NewRequest(http.MethodDelete, customersAPIUri+"/1", nil)
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusNoContent))
+		})
+	})
+
+	Describe("List Customer Orders", func() {
+		It("should return the customer's order history", func() {
+			orders := []domain.Order{{ID: 10, NewOrder: domain.NewOrder{CustomerID: &customer.ID}}}
+			mockCustomerService.EXPECT().GetOrderHistory(gomock.Any(), uint(1)).Return(orders, nil)
+
+			req, _ := http.NewRequest(http.MethodGet, customersAPIUri+"/1/orders", nil)
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"customer_id":1`))
+		})
+
+		It("should return 500 when the service fails", func() {
+			mockCustomerService.EXPECT().GetOrderHistory(gomock.Any(), uint(1)).Return(nil, errors.New("error"))
+
+			req, _ := http.NewRequest(http.MethodGet, customersAPIUri+"/1/orders", nil)
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+})
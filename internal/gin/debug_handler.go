@@ -0,0 +1,131 @@
+package gin
+
+import (
+	"crypto/subtle"
+	"database/sql"
+	"net/http"
+	"runtime/debug"
+	"strings"
+
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/gin-gonic/gin"
+)
+
+// Diagnostics enables the /debug endpoints, which are only served to the holders of the token
+type Diagnostics struct {
+	Token string
+	// Summary of the running configuration, secrets left out
+	Config  any
+	DBStats func() sql.DBStats
+}
+
+type buildInfo struct {
+	GoVersion string `json:"go_version"`
+	Module    string `json:"module"`
+	Version   string `json:"version"`
+	Revision  string `json:"revision,omitempty"`
+	Time      string `json:"time,omitempty"`
+	Modified  bool   `json:"modified"`
+}
+
+type dbPoolStats struct {
+	MaxOpenConnections int     `json:"max_open_connections"`
+	OpenConnections    int     `json:"open_connections"`
+	InUse              int     `json:"in_use"`
+	Idle               int     `json:"idle"`
+	WaitCount          int64   `json:"wait_count"`
+	WaitDurationMs     float64 `json:"wait_duration_ms"`
+	MaxIdleClosed      int64   `json:"max_idle_closed"`
+	MaxIdleTimeClosed  int64   `json:"max_idle_time_closed"`
+	MaxLifetimeClosed  int64   `json:"max_lifetime_closed"`
+}
+
+type DebugHandler struct {
+	queueChecker services.QueueIntegrityChecker
+	diagnostics  Diagnostics
+}
+
+func NewDebugHandler(queueChecker services.QueueIntegrityChecker, diagnostics Diagnostics) *DebugHandler {
+	return &DebugHandler{
+		queueChecker: queueChecker,
+		diagnostics:  diagnostics,
+	}
+}
+
+func (d *DebugHandler) Build(c *gin.Context) {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	result := buildInfo{
+		GoVersion: info.GoVersion,
+		Module:    info.Main.Path,
+		Version:   info.Main.Version,
+	}
+
+	for _, setting := range info.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			result.Revision = setting.Value
+		case "vcs.time":
+			result.Time = setting.Value
+		case "vcs.modified":
+			result.Modified = setting.Value == "true"
+		}
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (d *DebugHandler) Config(c *gin.Context) {
+	c.JSON(http.StatusOK, d.diagnostics.Config)
+}
+
+func (d *DebugHandler) Database(c *gin.Context) {
+	if d.diagnostics.DBStats == nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	stats := d.diagnostics.DBStats()
+
+	c.JSON(http.StatusOK, dbPoolStats{
+		MaxOpenConnections: stats.MaxOpenConnections,
+		OpenConnections:    stats.OpenConnections,
+		InUse:              stats.InUse,
+		Idle:               stats.Idle,
+		WaitCount:          stats.WaitCount,
+		WaitDurationMs:     float64(stats.WaitDuration.Microseconds()) / 1000,
+		MaxIdleClosed:      stats.MaxIdleClosed,
+		MaxIdleTimeClosed:  stats.MaxIdleTimeClosed,
+		MaxLifetimeClosed:  stats.MaxLifetimeClosed,
+	})
+}
+
+func (d *DebugHandler) Queue(c *gin.Context) {
+	report, err := d.queueChecker.Verify(c.Request.Context())
+
+	if err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// debugAuthMiddleware expects the token as a bearer token
+func debugAuthMiddleware(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		sent, found := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+
+		if !found || subtle.ConstantTimeCompare([]byte(sent), []byte(token)) != 1 {
+			c.Header("WWW-Authenticate", "Bearer")
+			c.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+
+		c.Next()
+	}
+}
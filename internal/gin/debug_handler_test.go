@@ -0,0 +1,112 @@
+package gin_test
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
+	internalGin "github.com/danbrato999/yuno-gveloz/internal/gin"
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("DebugHandler", func() {
+	var (
+		mockQueueChecker *mocks.MockQueueIntegrityChecker
+		router           *gin.Engine
+	)
+
+	BeforeEach(func() {
+		mockQueueChecker = mocks.NewMockQueueIntegrityChecker(gomock.NewController(GinkgoT()))
+		router = internalGin.GetServer(internalGin.Services{
+			QueueChecker: mockQueueChecker,
+			Diagnostics: &internalGin.Diagnostics{
+				Token:  "s3cr3t",
+				Config: map[string]any{"log_level": "INFO"},
+				DBStats: func() sql.DBStats {
+					return sql.DBStats{MaxOpenConnections: 1, OpenConnections: 1, Idle: 1, WaitCount: 3, WaitDuration: 1500 * time.Microsecond}
+				},
+			},
+		})
+	})
+
+	serve := func(uri string, token string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, uri, nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		router.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	DescribeTable("should reject the requests without the token",
+		func(token string) {
+			recorder := serve("/debug/config", token)
+
+			Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+			Expect(recorder.Header().Get("WWW-Authenticate")).To(Equal("Bearer"))
+		},
+		Entry("when missing", ""),
+		Entry("when wrong", "guess"),
+	)
+
+	It("should not serve the debug endpoints without a token", func() {
+		router = internalGin.GetServer(internalGin.Services{Diagnostics: &internalGin.Diagnostics{}})
+
+		Expect(serve("/debug/config", "").Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("should return the build info", func() {
+		recorder := serve("/debug/build", "s3cr3t")
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Body.String()).To(ContainSubstring(`"go_version":"go`))
+	})
+
+	It("should return the config summary", func() {
+		recorder := serve("/debug/config", "s3cr3t")
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Body.String()).To(MatchJSON(`{"log_level":"INFO"}`))
+	})
+
+	It("should return the database pool stats", func() {
+		recorder := serve("/debug/db", "s3cr3t")
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Body.String()).To(MatchJSON(`{
+			"max_open_connections": 1,
+			"open_connections": 1,
+			"in_use": 0,
+			"idle": 1,
+			"wait_count": 3,
+			"wait_duration_ms": 1.5,
+			"max_idle_closed": 0,
+			"max_idle_time_closed": 0,
+			"max_lifetime_closed": 0
+		}`))
+	})
+
+	It("should return the queue integrity", func() {
+		mockQueueChecker.EXPECT().Verify(gomock.Any()).Return(&domain.QueueIntegrityReport{Healthy: true}, nil)
+
+		recorder := serve("/debug/queue", "s3cr3t")
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Body.String()).To(ContainSubstring(`"healthy":true`))
+	})
+
+	It("should return 500 when the queue can't be verified", func() {
+		mockQueueChecker.EXPECT().Verify(gomock.Any()).Return(nil, errors.New("database is locked"))
+
+		Expect(serve("/debug/queue", "s3cr3t").Code).To(Equal(http.StatusInternalServerError))
+	})
+})
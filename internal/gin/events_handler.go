@@ -0,0 +1,67 @@
+package gin
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/gin-gonic/gin"
+)
+
+const eventsBuffer = 100
+
+type EventsHandler struct {
+	subscriber services.EventSubscriber
+}
+
+func NewEventsHandler(subscriber services.EventSubscriber) *EventsHandler {
+	return &EventsHandler{
+		subscriber: subscriber,
+	}
+}
+
+// Stream sends the order events as server sent events until the client disconnects
+func (h *EventsHandler) Stream(c *gin.Context) {
+	var queryParams struct {
+		Types string `form:"types"`
+	}
+
+	if err := c.BindQuery(&queryParams); err != nil {
+		return
+	}
+
+	types := make(map[domain.OrderEventType]bool)
+	for _, eventType := range strings.Split(queryParams.Types, ",") {
+		if eventType != "" {
+			types[domain.OrderEventType(eventType)] = true
+		}
+	}
+
+	events, unsubscribe := h.subscriber.Subscribe(eventsBuffer)
+	defer unsubscribe()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+
+			if len(types) > 0 && !types[event.Type] {
+				continue
+			}
+
+			c.SSEvent(string(event.Type), event)
+			c.Writer.Flush()
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package gin_test
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	internalGin "github.com/danbrato999/yuno-gveloz/internal/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EventsHandler", func() {
+	var (
+		bus    *services.EventBus
+		server *httptest.Server
+	)
+
+	BeforeEach(func() {
+		bus = services.NewEventBus()
+		server = httptest.NewServer(internalGin.GetServer(internalGin.Services{Events: bus}))
+		DeferCleanup(server.Close)
+	})
+
+	readEvent := func(reader *bufio.Reader) string {
+		var lines []string
+
+		for {
+			line, err := reader.ReadString('\n')
+			Expect(err).ToNot(HaveOccurred())
+
+			line = strings.TrimRight(line, "\n")
+			if line == "" {
+				return strings.Join(lines, "\n")
+			}
+
+			lines = append(lines, line)
+		}
+	}
+
+	It("should stream the requested order events", func() {
+		resp, err := http.Get(server.URL + "/api/v1/events?types=order.late")
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(resp.Header.Get("Content-Type")).To(ContainSubstring("text/event-stream"))
+
+		bus.Publish(domain.OrderEvent{Type: "order.other", Order: domain.Order{ID: 1}})
+		bus.Publish(domain.OrderEvent{Type: domain.OrderEventLate, Order: domain.Order{ID: 2, Late: true}})
+
+		event := readEvent(bufio.NewReader(resp.Body))
+
+		Expect(event).To(ContainSubstring("event:order.late"))
+		Expect(event).To(ContainSubstring(`"id":2`))
+		Expect(event).ToNot(ContainSubstring(`"id":1`))
+	})
+})
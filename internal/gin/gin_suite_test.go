@@ -1,12 +1,16 @@
 package gin_test
 
 import (
+	"log/slog"
 	"testing"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
 
+// Log lines are only printed for the failed tests
+var testLogger = slog.New(slog.NewTextHandler(GinkgoWriter, nil))
+
 func TestGin(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Gin Suite")
@@ -0,0 +1,73 @@
+package gin
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/graph-gophers/graphql-go"
+)
+
+type GraphQLHandler struct {
+	schema *graphql.Schema
+}
+
+type graphQLRequest struct {
+	Query         string                 `json:"query" binding:"required"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+func NewGraphQLHandler(schema *graphql.Schema) *GraphQLHandler {
+	return &GraphQLHandler{
+		schema: schema,
+	}
+}
+
+// Execute runs queries and mutations, while subscriptions are streamed as server sent events
+// to the clients accepting them
+func (h *GraphQLHandler) Execute(c *gin.Context) {
+	var request graphQLRequest
+
+	if err := bindJSON(c, &request); err != nil {
+		return
+	}
+
+	if strings.Contains(c.GetHeader("Accept"), "text/event-stream") {
+		h.subscribe(c, request)
+		return
+	}
+
+	response := h.schema.Exec(c.Request.Context(), request.Query, request.OperationName, request.Variables)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *GraphQLHandler) subscribe(c *gin.Context, request graphQLRequest) {
+	responses, err := h.schema.Subscribe(c.Request.Context(), request.Query, request.OperationName, request.Variables)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": []gin.H{{"message": err.Error()}}})
+		return
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case response, ok := <-responses:
+			if !ok {
+				c.SSEvent("complete", "")
+				c.Writer.Flush()
+				return
+			}
+
+			c.SSEvent("next", response)
+			c.Writer.Flush()
+		}
+	}
+}
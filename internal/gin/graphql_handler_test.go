@@ -0,0 +1,83 @@
+package gin_test
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
+	internalGin "github.com/danbrato999/yuno-gveloz/internal/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("GraphQLHandler", func() {
+	var (
+		mockOrderService *mocks.MockOrderService
+		bus              *services.EventBus
+		server           *httptest.Server
+	)
+
+	BeforeEach(func() {
+		mockOrderService = mocks.NewMockOrderService(gomock.NewController(GinkgoT()))
+		bus = services.NewEventBus()
+		server = httptest.NewServer(internalGin.GetServer(internalGin.Services{Orders: mockOrderService, Events: bus}))
+		DeferCleanup(server.Close)
+	})
+
+	It("should execute queries", func() {
+		mockOrderService.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&domain.OrderWithStatusHistory{
+			Order: domain.Order{ID: 1, Status: domain.OrderStatusPending},
+		}, nil)
+
+		resp, err := http.Post(server.URL+"/api/v1/graphql", "application/json",
+			strings.NewReader(`{"query": "query($id: ID!) { order(id: $id) { id status } }", "variables": {"id": "1"}}`))
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+		var body strings.Builder
+		_, err = bufio.NewReader(resp.Body).WriteTo(&body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(body.String()).To(MatchJSON(`{"data": {"order": {"id": "1", "status": "PENDING"}}}`))
+	})
+
+	It("should reject requests without a query", func() {
+		resp, err := http.Post(server.URL+"/api/v1/graphql", "application/json", strings.NewReader(`{}`))
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+
+		Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+	})
+
+	It("should stream subscriptions as server sent events", func() {
+		request, err := http.NewRequest(http.MethodPost, server.URL+"/api/v1/graphql",
+			strings.NewReader(`{"query": "subscription { orderChanged { type order { id } } }"}`))
+		Expect(err).ToNot(HaveOccurred())
+		request.Header.Set("Content-Type", "application/json")
+		request.Header.Set("Accept", "text/event-stream")
+
+		resp, err := http.DefaultClient.Do(request)
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(resp.Header.Get("Content-Type")).To(ContainSubstring("text/event-stream"))
+
+		bus.Publish(domain.OrderEvent{Type: domain.OrderEventCreated, Order: domain.Order{ID: 3}})
+
+		reader := bufio.NewReader(resp.Body)
+		event, err := reader.ReadString('\n')
+		Expect(err).ToNot(HaveOccurred())
+		Expect(event).To(ContainSubstring("event:next"))
+
+		data, err := reader.ReadString('\n')
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(ContainSubstring(`"orderChanged":{"type":"order.created","order":{"id":"3"}}`))
+	})
+})
@@ -0,0 +1,34 @@
+package gin
+
+import (
+	"net/http"
+
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/gin-gonic/gin"
+)
+
+type HealthHandler struct {
+	readiness services.ReadinessChecker
+}
+
+func NewHealthHandler(readiness services.ReadinessChecker) *HealthHandler {
+	return &HealthHandler{
+		readiness: readiness,
+	}
+}
+
+// Live only tells the process is able to answer, so it never touches its dependencies
+func (h *HealthHandler) Live(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+func (h *HealthHandler) Ready(c *gin.Context) {
+	report := h.readiness.CheckReadiness(c.Request.Context())
+
+	if !report.Ready {
+		c.JSON(http.StatusServiceUnavailable, report)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
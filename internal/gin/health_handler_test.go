@@ -0,0 +1,80 @@
+package gin_test
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
+	internalGin "github.com/danbrato999/yuno-gveloz/internal/gin"
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("HealthHandler", func() {
+	var (
+		mockReadiness *mocks.MockReadinessChecker
+		output        *bytes.Buffer
+		router        *gin.Engine
+	)
+
+	BeforeEach(func() {
+		mockReadiness = mocks.NewMockReadinessChecker(gomock.NewController(GinkgoT()))
+		output = &bytes.Buffer{}
+		router = internalGin.GetServer(internalGin.Services{
+			Readiness: mockReadiness,
+			Logger:    slog.New(slog.NewTextHandler(output, nil)),
+		})
+	})
+
+	serve := func(uri string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, uri, nil)
+		router.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	It("should be alive without checking its dependencies", func() {
+		recorder := serve("/healthz")
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Body.String()).To(MatchJSON(`{"status":"ok"}`))
+	})
+
+	It("should be ready when every check passes", func() {
+		mockReadiness.EXPECT().CheckReadiness(gomock.Any()).Return(domain.ReadinessReport{
+			Ready:  true,
+			Checks: []domain.ReadinessCheckResult{{Name: "database", Ready: true}},
+		})
+
+		recorder := serve("/readyz")
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Body.String()).To(MatchJSON(`{"ready":true,"checks":[{"name":"database","ready":true}]}`))
+	})
+
+	It("should answer 503 with the failed checks", func() {
+		mockReadiness.EXPECT().CheckReadiness(gomock.Any()).Return(domain.ReadinessReport{
+			Checks: []domain.ReadinessCheckResult{{Name: "webhook_backlog", Error: "120 webhook events pending, over the limit of 100"}},
+		})
+
+		recorder := serve("/readyz")
+
+		Expect(recorder.Code).To(Equal(http.StatusServiceUnavailable))
+		Expect(recorder.Body.String()).To(ContainSubstring(`"error":"120 webhook events pending, over the limit of 100"`))
+	})
+
+	It("should only log the failed probes", func() {
+		mockReadiness.EXPECT().CheckReadiness(gomock.Any()).Return(domain.ReadinessReport{})
+
+		serve("/healthz")
+		Expect(output.String()).To(BeEmpty())
+
+		serve("/readyz")
+		Expect(output.String()).To(ContainSubstring("route=/readyz"))
+	})
+})
@@ -0,0 +1,59 @@
+package gin
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/gin-gonic/gin"
+)
+
+type IntegrationsHandler struct {
+	integrationService services.IntegrationService
+}
+
+func NewIntegrationsHandler(integrationService services.IntegrationService) *IntegrationsHandler {
+	return &IntegrationsHandler{
+		integrationService: integrationService,
+	}
+}
+
+// ReceiveOrder answers 200 instead of 201 when the provider resends an order already received
+func (h *IntegrationsHandler) ReceiveOrder(c *gin.Context) {
+	payload, err := c.GetRawData()
+
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	order, created, err := h.integrationService.ReceiveOrder(c.Request.Context(), c.Param("provider"), payload, c.Request.Header)
+
+	if err != nil {
+		abortWithIntegrationError(c, err)
+		return
+	}
+
+	if !created {
+		c.JSON(http.StatusOK, order)
+		return
+	}
+
+	c.JSON(http.StatusCreated, order)
+}
+
+func abortWithIntegrationError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domain.ErrUnknownIntegration):
+		c.AbortWithStatus(http.StatusNotFound)
+	case errors.Is(err, domain.ErrInvalidIntegrationSignature):
+		c.AbortWithStatus(http.StatusUnauthorized)
+	case errors.Is(err, domain.ErrInvalidIntegrationPayload):
+		c.AbortWithStatus(http.StatusBadRequest)
+	case errors.Is(err, domain.ErrDuplicateExternalOrder):
+		c.AbortWithStatus(http.StatusConflict)
+	default:
+		abortWithOrderError(c, err)
+	}
+}
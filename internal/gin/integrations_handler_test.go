@@ -0,0 +1,80 @@
+package gin_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
+	internalGin "github.com/danbrato999/yuno-gveloz/internal/gin"
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+const integrationOrdersAPIUri = "/api/v1/integrations/fooddash/orders"
+
+var _ = Describe("IntegrationsHandler", func() {
+	var (
+		mockIntegrationService *mocks.MockIntegrationService
+		router                 *gin.Engine
+		recorder               *httptest.ResponseRecorder
+		payload                []byte
+		order                  *domain.Order
+	)
+
+	BeforeEach(func() {
+		mockIntegrationService = mocks.NewMockIntegrationService(gomock.NewController(GinkgoT()))
+		recorder = httptest.NewRecorder()
+		router = internalGin.GetServer(internalGin.Services{Integrations: mockIntegrationService})
+		payload = []byte(`{"order_id":"FD-1"}`)
+		order = &domain.Order{
+			ID:       1,
+			Status:   domain.OrderStatusPending,
+			NewOrder: domain.NewOrder{External: &domain.ExternalReference{Provider: "fooddash", ID: "FD-1"}},
+		}
+	})
+
+	receive := func() {
+		req, _ := http.NewRequest(http.MethodPost, integrationOrdersAPIUri, bytes.NewBuffer(payload))
+		req.Header.Set("X-FoodDash-Signature", "abc")
+		router.ServeHTTP(recorder, req)
+	}
+
+	It("should return 201 Created for new orders", func() {
+		mockIntegrationService.EXPECT().ReceiveOrder(gomock.Any(), "fooddash", payload, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, _ []byte, headers http.Header) (*domain.Order, bool, error) {
+				Expect(headers.Get("X-FoodDash-Signature")).To(Equal("abc"))
+				return order, true, nil
+			})
+
+		receive()
+
+		Expect(recorder.Code).To(Equal(http.StatusCreated))
+		Expect(recorder.Body.String()).To(ContainSubstring(`"external":{"provider":"fooddash","id":"FD-1"}`))
+	})
+
+	It("should return 200 OK for orders received again", func() {
+		mockIntegrationService.EXPECT().ReceiveOrder(gomock.Any(), "fooddash", payload, gomock.Any()).Return(order, false, nil)
+
+		receive()
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+	})
+
+	DescribeTable("errors", func(err error, status int) {
+		mockIntegrationService.EXPECT().ReceiveOrder(gomock.Any(), "fooddash", payload, gomock.Any()).Return(nil, false, err)
+
+		receive()
+
+		Expect(recorder.Code).To(Equal(status))
+	},
+		Entry("unknown provider", domain.ErrUnknownIntegration, http.StatusNotFound),
+		Entry("invalid signature", domain.ErrInvalidIntegrationSignature, http.StatusUnauthorized),
+		Entry("invalid payload", domain.ErrInvalidIntegrationPayload, http.StatusBadRequest),
+		Entry("invalid order", domain.ErrInvalidOrderUpdate, http.StatusBadRequest),
+	)
+})
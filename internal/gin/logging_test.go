@@ -0,0 +1,117 @@
+package gin_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
+	internalGin "github.com/danbrato999/yuno-gveloz/internal/gin"
+	"github.com/danbrato999/yuno-gveloz/internal/logging"
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Logging", func() {
+	var (
+		mockOrderService *mocks.MockOrderService
+		output           *bytes.Buffer
+		router           *gin.Engine
+	)
+
+	BeforeEach(func() {
+		mockOrderService = mocks.NewMockOrderService(gomock.NewController(GinkgoT()))
+		output = &bytes.Buffer{}
+		router = internalGin.GetServer(internalGin.Services{
+			Orders: mockOrderService,
+			Logger: logging.New(output, slog.LevelInfo),
+		})
+	})
+
+	serve := func(requestID string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/orders/7", nil)
+		if requestID != "" {
+			req.Header.Set(logging.RequestIDHeader, requestID)
+		}
+
+		router.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	logLines := func() []map[string]any {
+		var lines []map[string]any
+
+		for _, raw := range strings.Split(strings.TrimSpace(output.String()), "\n") {
+			var line map[string]any
+			Expect(json.Unmarshal([]byte(raw), &line)).To(Succeed())
+			lines = append(lines, line)
+		}
+
+		return lines
+	}
+
+	It("should keep the request ID of the client", func() {
+		mockOrderService.EXPECT().FindByID(gomock.Any(), uint(7)).DoAndReturn(
+			func(ctx context.Context, _ uint, _ ...domain.OrderFilterFn) (*domain.OrderWithStatusHistory, error) {
+				Expect(logging.RequestID(ctx)).To(Equal("till-3:42"))
+				return nil, domain.ErrOrderNotFound
+			},
+		)
+
+		recorder := serve("till-3:42")
+
+		Expect(recorder.Header().Get(logging.RequestIDHeader)).To(Equal("till-3:42"))
+
+		lines := logLines()
+		Expect(lines).To(HaveLen(1))
+		Expect(lines[0]).To(HaveKeyWithValue("msg", "request"))
+		Expect(lines[0]).To(HaveKeyWithValue("level", "WARN"))
+		Expect(lines[0]).To(HaveKeyWithValue("request_id", "till-3:42"))
+		Expect(lines[0]).To(HaveKeyWithValue("route", "/api/v1/orders/:id"))
+		Expect(lines[0]).To(HaveKeyWithValue("path", "/api/v1/orders/7"))
+		Expect(lines[0]).To(HaveKeyWithValue("status", BeNumerically("==", http.StatusNotFound)))
+	})
+
+	DescribeTable("should generate a request ID",
+		func(requestID string) {
+			mockOrderService.EXPECT().FindByID(gomock.Any(), uint(7)).Return(nil, domain.ErrOrderNotFound)
+
+			recorder := serve(requestID)
+
+			generated := recorder.Header().Get(logging.RequestIDHeader)
+			Expect(generated).To(MatchRegexp("^[0-9a-f]{32}$"))
+			Expect(logLines()[0]).To(HaveKeyWithValue("request_id", generated))
+		},
+		Entry("when the client doesn't send one", ""),
+		Entry("when the client sends an invalid one", "id with spaces"),
+		Entry("when the client sends a long one", strings.Repeat("a", 129)),
+	)
+
+	It("should log the panics", func() {
+		mockOrderService.EXPECT().FindByID(gomock.Any(), uint(7)).DoAndReturn(
+			func(_ context.Context, _ uint, _ ...domain.OrderFilterFn) (*domain.OrderWithStatusHistory, error) {
+				panic("corrupted order")
+			},
+		)
+
+		recorder := serve("till-3")
+
+		Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+
+		lines := logLines()
+		Expect(lines).To(HaveLen(2))
+		Expect(lines[0]).To(HaveKeyWithValue("msg", "request panicked"))
+		Expect(lines[0]).To(HaveKeyWithValue("panic", "corrupted order"))
+		Expect(lines[0]).To(HaveKeyWithValue("request_id", "till-3"))
+		Expect(lines[1]).To(HaveKeyWithValue("level", "ERROR"))
+		Expect(lines[1]).To(HaveKeyWithValue("status", BeNumerically("==", http.StatusInternalServerError)))
+	})
+})
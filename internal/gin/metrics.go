@@ -0,0 +1,32 @@
+package gin
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Requests that match no route share a single label, so unknown paths can't grow the series
+const unmatchedRoute = "unmatched"
+
+// HTTPMetrics records the handled requests and serves the collected metrics
+type HTTPMetrics interface {
+	ObserveRequest(method, route string, status int, duration time.Duration)
+	Handler() http.Handler
+}
+
+func metricsMiddleware(metrics HTTPMetrics) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+
+		metrics.ObserveRequest(c.Request.Method, route, c.Writer.Status(), time.Since(start))
+	}
+}
@@ -0,0 +1,65 @@
+package gin_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
+	internalGin "github.com/danbrato999/yuno-gveloz/internal/gin"
+	"github.com/danbrato999/yuno-gveloz/internal/metrics"
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Metrics", func() {
+	var (
+		mockOrderService  *mocks.MockOrderService
+		mockPriorityQueue *mocks.MockPriorityQueue
+		router            *gin.Engine
+	)
+
+	BeforeEach(func() {
+		mockCtrl := gomock.NewController(GinkgoT())
+		mockOrderService = mocks.NewMockOrderService(mockCtrl)
+		mockPriorityQueue = mocks.NewMockPriorityQueue(mockCtrl)
+		router = internalGin.GetServer(internalGin.Services{
+			Orders:  mockOrderService,
+			Metrics: metrics.New(mockPriorityQueue, testLogger),
+		})
+	})
+
+	serve := func(uri string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, uri, nil)
+		router.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	It("should record the requests by route", func() {
+		mockOrderService.EXPECT().FindByID(gomock.Any(), uint(7)).Return(nil, domain.ErrOrderNotFound)
+		mockPriorityQueue.EXPECT().Length(gomock.Any()).Return(int64(2), nil)
+
+		serve("/api/v1/orders/7")
+		serve("/api/v1/unknown/7")
+
+		recorder := serve("/metrics")
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Body.String()).To(ContainSubstring(
+			`gveloz_http_request_duration_seconds_count{method="GET",route="/api/v1/orders/:id",status="404"} 1`,
+		))
+		Expect(recorder.Body.String()).To(ContainSubstring(
+			`gveloz_http_request_duration_seconds_count{method="GET",route="unmatched",status="404"} 1`,
+		))
+		Expect(recorder.Body.String()).To(ContainSubstring("gveloz_queue_length 2"))
+	})
+
+	It("should not serve metrics unless enabled", func() {
+		router = internalGin.GetServer(internalGin.Services{})
+
+		Expect(serve("/metrics").Code).To(Equal(http.StatusNotFound))
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/danbrato999/yuno-gveloz/domain"
 	"github.com/danbrato999/yuno-gveloz/domain/services"
@@ -23,14 +24,14 @@ func NewOrdersHandler(orderService services.OrderService) *OrdersHandler {
 func (o *OrdersHandler) Create(c *gin.Context) {
 	var body domain.NewOrder
 
-	if err := c.BindJSON(&body); err != nil {
+	if err := bindJSON(c, &body); err != nil {
 		return
 	}
 
-	order, err := o.orderService.CreateOrder(body)
+	order, err := o.orderService.CreateOrder(c.Request.Context(), body)
 
 	if err != nil {
-		c.AbortWithStatus(http.StatusInternalServerError)
+		abortWithOrderError(c, err)
 		return
 	}
 
@@ -39,7 +40,11 @@ func (o *OrdersHandler) Create(c *gin.Context) {
 
 func (o *OrdersHandler) List(c *gin.Context) {
 	var queryParams struct {
-		Active bool `form:"active"`
+		Active    bool `form:"active"`
+		Scheduled bool `form:"scheduled"`
+		Late      bool `form:"late"`
+		// Archived orders are appended after the live ones
+		IncludeArchived bool `form:"include_archived"`
 	}
 
 	if err := c.BindQuery(&queryParams); err != nil {
@@ -52,10 +57,22 @@ func (o *OrdersHandler) List(c *gin.Context) {
 		filters = append(filters, domain.FilterActive)
 	}
 
-	orders, err := o.orderService.FindMany(filters...)
+	if queryParams.Scheduled {
+		filters = append(filters, domain.FilterScheduled)
+	}
+
+	if queryParams.Late {
+		filters = append(filters, domain.FilterLate)
+	}
+
+	if queryParams.IncludeArchived {
+		filters = append(filters, domain.FilterIncludeArchived)
+	}
+
+	orders, err := o.orderService.FindMany(c.Request.Context(), filters...)
 
 	if err != nil {
-		c.AbortWithStatus(http.StatusInternalServerError)
+		c.AbortWithStatus(unexpectedErrorStatus(err))
 		return
 	}
 
@@ -72,7 +89,20 @@ func (o *OrdersHandler) Find(c *gin.Context) {
 		return
 	}
 
-	order, err := o.orderService.FindByID(uint(orderID))
+	var queryParams struct {
+		IncludeArchived bool `form:"include_archived"`
+	}
+
+	if err := c.BindQuery(&queryParams); err != nil {
+		return
+	}
+
+	var filters []domain.OrderFilterFn
+	if queryParams.IncludeArchived {
+		filters = append(filters, domain.FilterIncludeArchived)
+	}
+
+	order, err := o.orderService.FindByID(c.Request.Context(), uint(orderID), filters...)
 
 	if err != nil {
 		abortWithOrderError(c, err)
@@ -93,7 +123,7 @@ func (o *OrdersHandler) UpdateStatus(c *gin.Context) {
 		return
 	}
 
-	order, err := o.orderService.UpdateStatus(uint(orderID), status)
+	order, err := o.orderService.UpdateStatus(c.Request.Context(), uint(orderID), status)
 
 	if err != nil {
 		abortWithOrderError(c, err)
@@ -103,6 +133,63 @@ func (o *OrdersHandler) UpdateStatus(c *gin.Context) {
 	c.JSON(http.StatusOK, order)
 }
 
+// Cancel acts with the role of the staff API key sent, callers without one can't cancel any order
+func (o *OrdersHandler) Cancel(c *gin.Context) {
+	id := c.Param("id")
+	orderID, err := strconv.Atoi(id)
+
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	var body domain.CancelOrder
+
+	if err := bindJSON(c, &body); err != nil {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	result, err := o.orderService.Cancel(ctx, uint(orderID), body, services.StaffRoleFrom(ctx))
+	if err != nil {
+		abortWithOrderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// ListWaste defaults to the waste recorded in the last day
+func (o *OrdersHandler) ListWaste(c *gin.Context) {
+	var queryParams struct {
+		From *time.Time `form:"from"`
+		To   *time.Time `form:"to"`
+	}
+
+	if err := c.BindQuery(&queryParams); err != nil {
+		return
+	}
+
+	to := time.Now()
+	if queryParams.To != nil {
+		to = *queryParams.To
+	}
+
+	from := to.Add(-24 * time.Hour)
+	if queryParams.From != nil {
+		from = *queryParams.From
+	}
+
+	waste, err := o.orderService.FindWaste(c.Request.Context(), from, to)
+	if err != nil {
+		c.AbortWithStatus(unexpectedErrorStatus(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, waste)
+}
+
 func (o *OrdersHandler) UpdateContent(c *gin.Context) {
 	id := c.Param("id")
 	orderID, err := strconv.Atoi(id)
@@ -116,11 +203,84 @@ func (o *OrdersHandler) UpdateContent(c *gin.Context) {
 		Dishes []domain.Dish `json:"dishes" binding:"required,min=1,dive"`
 	}
 
-	if err := c.BindJSON(&body); err != nil {
+	if err := bindJSON(c, &body); err != nil {
+		return
+	}
+
+	result, err := o.orderService.UpdateDishes(c.Request.Context(), uint(orderID), body.Dishes)
+	if err != nil {
+		abortWithOrderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (o *OrdersHandler) AddDish(c *gin.Context) {
+	orderID, err := strconv.Atoi(c.Param("id"))
+
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	var body domain.Dish
+
+	if err := bindJSON(c, &body); err != nil {
+		return
+	}
+
+	result, err := o.orderService.AddDish(c.Request.Context(), uint(orderID), body)
+	if err != nil {
+		abortWithOrderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (o *OrdersHandler) VoidDish(c *gin.Context) {
+	orderID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	dishID, err := strconv.Atoi(c.Param("dish_id"))
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	var body domain.VoidDish
+
+	if err := bindJSON(c, &body); err != nil {
+		return
+	}
+
+	result, err := o.orderService.VoidDish(c.Request.Context(), uint(orderID), uint(dishID), body)
+	if err != nil {
+		abortWithOrderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (o *OrdersHandler) MarkDishReady(c *gin.Context) {
+	orderID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	dishID, err := strconv.Atoi(c.Param("dish_id"))
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
 		return
 	}
 
-	result, err := o.orderService.UpdateDishes(uint(orderID), body.Dishes)
+	result, err := o.orderService.MarkDishReady(c.Request.Context(), uint(orderID), uint(dishID))
 	if err != nil {
 		abortWithOrderError(c, err)
 		return
@@ -129,6 +289,23 @@ func (o *OrdersHandler) UpdateContent(c *gin.Context) {
 	c.JSON(http.StatusOK, result)
 }
 
+func (o *OrdersHandler) DishHistory(c *gin.Context) {
+	orderID, err := strconv.Atoi(c.Param("id"))
+
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	history, err := o.orderService.FindDishHistory(c.Request.Context(), uint(orderID))
+	if err != nil {
+		abortWithOrderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, history)
+}
+
 func (o *OrdersHandler) Prioritize(c *gin.Context) {
 	id := c.Param("id")
 	orderID, err := strconv.Atoi(id)
@@ -142,11 +319,11 @@ func (o *OrdersHandler) Prioritize(c *gin.Context) {
 		AfterID uint `json:"after_id" binding:"required"`
 	}
 
-	if err := c.BindJSON(&body); err != nil {
+	if err := bindJSON(c, &body); err != nil {
 		return
 	}
 
-	if err := o.orderService.Prioritize(uint(orderID), body.AfterID); err != nil {
+	if err := o.orderService.Prioritize(c.Request.Context(), uint(orderID), body.AfterID); err != nil {
 		abortWithOrderError(c, err)
 		return
 	}
@@ -154,15 +331,91 @@ func (o *OrdersHandler) Prioritize(c *gin.Context) {
 	c.Status(http.StatusNoContent)
 }
 
+func (o *OrdersHandler) AssignCourier(c *gin.Context) {
+	id := c.Param("id")
+	orderID, err := strconv.Atoi(id)
+
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	var body domain.Courier
+
+	if err := bindJSON(c, &body); err != nil {
+		return
+	}
+
+	result, err := o.orderService.AssignCourier(c.Request.Context(), uint(orderID), body)
+	if err != nil {
+		abortWithOrderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (o *OrdersHandler) Reschedule(c *gin.Context) {
+	id := c.Param("id")
+	orderID, err := strconv.Atoi(id)
+
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	var body struct {
+		ReadyAt time.Time `json:"ready_at" binding:"required"`
+	}
+
+	if err := bindJSON(c, &body); err != nil {
+		return
+	}
+
+	result, err := o.orderService.Reschedule(c.Request.Context(), uint(orderID), body.ReadyAt)
+	if err != nil {
+		abortWithOrderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
 func abortWithOrderError(c *gin.Context, err error) {
-	status := http.StatusInternalServerError
-	if errors.Is(err, domain.ErrOrderNotFound) {
+	if errors.Is(err, domain.ErrAllergenConflict) {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+		return
+	}
+
+	if errors.Is(err, domain.ErrTooManyDishes) {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	status := unexpectedErrorStatus(err)
+	if errors.Is(err, domain.ErrOrderNotFound) || errors.Is(err, domain.ErrDishNotFound) {
 		status = http.StatusNotFound
 	}
 
-	if errors.Is(err, domain.ErrInvalidOrderUpdate) || errors.Is(err, domain.ErrCompleteOrderUpdate) {
+	if errors.Is(err, domain.ErrInvalidOrderUpdate) ||
+		errors.Is(err, domain.ErrCompleteOrderUpdate) ||
+		errors.Is(err, domain.ErrUnknownOrderCustomer) ||
+		errors.Is(err, domain.ErrNotDeliveryOrder) ||
+		errors.Is(err, domain.ErrInvalidCancellation) ||
+		errors.Is(err, domain.ErrCancellationWithoutReason) ||
+		errors.Is(err, domain.ErrDishChangeNotAllowed) ||
+		errors.Is(err, domain.ErrLastDishVoided) ||
+		errors.Is(err, domain.ErrInvalidDishVoid) ||
+		errors.Is(err, domain.ErrInvalidOrderDiet) ||
+		errors.Is(err, domain.ErrUnknownOrderTable) ||
+		errors.Is(err, domain.ErrTableNotSeated) ||
+		errors.Is(err, domain.ErrNotDineInOrder) {
 		status = http.StatusBadRequest
 	}
 
+	if errors.Is(err, domain.ErrCancellationNotAllowed) {
+		status = http.StatusForbidden
+	}
+
 	c.AbortWithStatus(status)
 }
@@ -32,7 +32,10 @@ var _ = Describe("OrdersHandler", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockService = mocks.NewMockOrderService(ctrl)
 		recorder = httptest.NewRecorder()
-		router = internalGin.GetServer(mockService)
+		router = internalGin.GetServer(internalGin.Services{
+			Orders:    mockService,
+			StaffKeys: domain.StaffKeys{"c00k": domain.StaffRoleCook},
+		})
 	})
 
 	Describe("Create Order", func() {
@@ -54,7 +57,7 @@ var _ = Describe("OrdersHandler", func() {
 			It("should return 201 Created", func() {
 				order := &domain.Order{ID: 1, NewOrder: validNewOrder, Status: domain.OrderStatusPending}
 
-				mockService.EXPECT().CreateOrder(gomock.Any()).Return(order, nil)
+				mockService.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(order, nil)
 
 				body, _ := json.Marshal(validNewOrder)
 				req, _ := http.NewRequest(http.MethodPost, baseAPIUri, bytes.NewBuffer(body))
@@ -80,11 +83,68 @@ var _ = Describe("OrdersHandler", func() {
 			Entry("when no dishes are provided", domain.NewOrder{Time: time.Now(), Source: domain.OrderSourcePhone}),
 			Entry("when no source is provided", domain.NewOrder{Time: time.Now(), Dishes: []domain.Dish{{Name: "Pizza"}}}),
 			Entry("when invalid source is provided", domain.NewOrder{Source: "test", Dishes: []domain.Dish{{Name: "Pizza"}}, Time: time.Now()}),
+			Entry("when an allergy has no allergen", domain.NewOrder{
+				Source:    domain.OrderSourcePhone,
+				Dishes:    []domain.Dish{{Name: "Pizza"}},
+				Time:      time.Now(),
+				Allergies: []domain.Allergy{{Severe: true}},
+			}),
+			Entry("when delivery details are incomplete", domain.NewOrder{
+				Source:   domain.OrderSourceDelivery,
+				Dishes:   []domain.Dish{{Name: "Pizza"}},
+				Time:     time.Now(),
+				Delivery: &domain.DeliveryDetails{Address: "Main St 123"},
+			}),
 		)
 
+		When("the customer does not exist", func() {
+			It("should return 400 Bad Request", func() {
+				mockService.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil, domain.ErrUnknownOrderCustomer)
+
+				body, _ := json.Marshal(validNewOrder)
+				req, _ := http.NewRequest(http.MethodPost, baseAPIUri, bytes.NewBuffer(body))
+				req.Header.Set("Content-Type", "application/json")
+
+				router.ServeHTTP(recorder, req)
+
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		When("the table is not seated", func() {
+			It("should return 400 Bad Request", func() {
+				mockService.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil, domain.ErrTableNotSeated)
+
+				body, _ := json.Marshal(validNewOrder)
+				req, _ := http.NewRequest(http.MethodPost, baseAPIUri, bytes.NewBuffer(body))
+				req.Header.Set("Content-Type", "application/json")
+
+				router.ServeHTTP(recorder, req)
+
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		When("a dish conflicts with a severe allergy", func() {
+			It("should return 422 Unprocessable Entity with the conflict", func() {
+				conflict := fmt.Errorf("%w: Pasta contains gluten", domain.ErrAllergenConflict)
+				mockService.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil, conflict)
+
+				validNewOrder.Allergies = []domain.Allergy{{Allergen: domain.AllergenGluten, Severe: true}}
+				body, _ := json.Marshal(validNewOrder)
+				req, _ := http.NewRequest(http.MethodPost, baseAPIUri, bytes.NewBuffer(body))
+				req.Header.Set("Content-Type", "application/json")
+
+				router.ServeHTTP(recorder, req)
+
+				Expect(recorder.Code).To(Equal(http.StatusUnprocessableEntity))
+				Expect(recorder.Body.String()).To(ContainSubstring("Pasta contains gluten"))
+			})
+		})
+
 		When("service fails", func() {
 			It("should return 500 Internal Server Error", func() {
-				mockService.EXPECT().CreateOrder(gomock.Any()).Return(nil, errors.New("error"))
+				mockService.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
 
 				body, _ := json.Marshal(validNewOrder)
 				req, _ := http.NewRequest(http.MethodPost, baseAPIUri, bytes.NewBuffer(body))
@@ -104,7 +164,7 @@ var _ = Describe("OrdersHandler", func() {
 					Order: domain.Order{ID: 1, Status: domain.OrderStatusPending},
 				}
 
-				mockService.EXPECT().FindByID(uint(1)).Return(order, nil)
+				mockService.EXPECT().FindByID(gomock.Any(), uint(1)).Return(order, nil)
 
 				req, _ := http.NewRequest(http.MethodGet, baseAPIUri+"/1", nil)
 				router.ServeHTTP(recorder, req)
@@ -116,7 +176,7 @@ var _ = Describe("OrdersHandler", func() {
 
 		When("order is not found", func() {
 			It("should return 404 Not Found", func() {
-				mockService.EXPECT().FindByID(uint(1)).Return(nil, domain.ErrOrderNotFound)
+				mockService.EXPECT().FindByID(gomock.Any(), uint(1)).Return(nil, domain.ErrOrderNotFound)
 
 				req, _ := http.NewRequest(http.MethodGet, baseAPIUri+"/1", nil)
 				router.ServeHTTP(recorder, req)
@@ -124,13 +184,29 @@ var _ = Describe("OrdersHandler", func() {
 				Expect(recorder.Code).To(Equal(http.StatusNotFound))
 			})
 		})
+
+		When("archived orders are included", func() {
+			It("should return 200 OK", func() {
+				order := &domain.OrderWithStatusHistory{
+					Order: domain.Order{ID: 1, Status: domain.OrderStatusDone},
+				}
+
+				mockService.EXPECT().FindByID(gomock.Any(), uint(1), gomock.Any()).Return(order, nil)
+
+				req, _ := http.NewRequest(http.MethodGet, baseAPIUri+"/1?include_archived=true", nil)
+				router.ServeHTTP(recorder, req)
+
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+				Expect(recorder.Body.String()).To(ContainSubstring(`"status":"done"`))
+			})
+		})
 	})
 
 	Describe("List Orders", func() {
 		When("active orders are requested", func() {
 			It("should return 200 OK with filtered orders", func() {
 				orders := []domain.Order{{ID: 1, Status: domain.OrderStatusPending}}
-				mockService.EXPECT().FindMany(gomock.Len(1)).Return(orders, nil)
+				mockService.EXPECT().FindMany(gomock.Any(), gomock.Len(1)).Return(orders, nil)
 
 				req, _ := http.NewRequest(http.MethodGet, baseAPIUri+"?active=true", nil)
 				router.ServeHTTP(recorder, req)
@@ -143,7 +219,7 @@ var _ = Describe("OrdersHandler", func() {
 		When("all orders are requested", func() {
 			It("should return 200 OK with filtered orders", func() {
 				orders := []domain.Order{{ID: 1, Status: domain.OrderStatusPending}}
-				mockService.EXPECT().FindMany(gomock.Len(0)).Return(orders, nil)
+				mockService.EXPECT().FindMany(gomock.Any(), gomock.Len(0)).Return(orders, nil)
 
 				req, _ := http.NewRequest(http.MethodGet, baseAPIUri, nil)
 				router.ServeHTTP(recorder, req)
@@ -153,9 +229,48 @@ var _ = Describe("OrdersHandler", func() {
 			})
 		})
 
+		When("scheduled orders are requested", func() {
+			It("should return 200 OK with filtered orders", func() {
+				orders := []domain.Order{{ID: 1, Status: domain.OrderStatusScheduled}}
+				mockService.EXPECT().FindMany(gomock.Any(), gomock.Len(1)).Return(orders, nil)
+
+				req, _ := http.NewRequest(http.MethodGet, baseAPIUri+"?scheduled=true", nil)
+				router.ServeHTTP(recorder, req)
+
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+				Expect(recorder.Body.String()).To(ContainSubstring(`"status":"scheduled"`))
+			})
+		})
+
+		When("late orders are requested", func() {
+			It("should return 200 OK with filtered orders", func() {
+				orders := []domain.Order{{ID: 1, Status: domain.OrderStatusPending, Late: true}}
+				mockService.EXPECT().FindMany(gomock.Any(), gomock.Len(1)).Return(orders, nil)
+
+				req, _ := http.NewRequest(http.MethodGet, baseAPIUri+"?late=true", nil)
+				router.ServeHTTP(recorder, req)
+
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+				Expect(recorder.Body.String()).To(ContainSubstring(`"late":true`))
+			})
+		})
+
+		When("archived orders are included", func() {
+			It("should return 200 OK with all the orders", func() {
+				orders := []domain.Order{{ID: 2, Status: domain.OrderStatusPending}, {ID: 1, Status: domain.OrderStatusDone}}
+				mockService.EXPECT().FindMany(gomock.Any(), gomock.Len(1)).Return(orders, nil)
+
+				req, _ := http.NewRequest(http.MethodGet, baseAPIUri+"?include_archived=true", nil)
+				router.ServeHTTP(recorder, req)
+
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+				Expect(recorder.Body.String()).To(ContainSubstring(`"status":"done"`))
+			})
+		})
+
 		When("service fails", func() {
 			It("should return 500 Internal Server Error", func() {
-				mockService.EXPECT().FindMany(gomock.Any()).Return(nil, errors.New("error"))
+				mockService.EXPECT().FindMany(gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
 
 				req, _ := http.NewRequest(http.MethodGet, baseAPIUri, nil)
 				router.ServeHTTP(recorder, req)
@@ -169,7 +284,7 @@ var _ = Describe("OrdersHandler", func() {
 		When("order status is updated successfully", func() {
 			It("should return 200 OK", func() {
 				order := &domain.Order{ID: 1, Status: domain.OrderStatusDone}
-				mockService.EXPECT().UpdateStatus(uint(1), domain.OrderStatusDone).Return(order, nil)
+				mockService.EXPECT().UpdateStatus(gomock.Any(), uint(1), domain.OrderStatusDone).Return(order, nil)
 
 				req, _ := http.NewRequest(http.MethodPut, baseAPIUri+"/1/status/done", nil)
 				router.ServeHTTP(recorder, req)
@@ -181,7 +296,7 @@ var _ = Describe("OrdersHandler", func() {
 
 		When("order update fails due to invalid status", func() {
 			It("should return 400 Bad Request", func() {
-				mockService.EXPECT().UpdateStatus(uint(1), domain.OrderStatusDone).Return(nil, domain.ErrInvalidOrderUpdate)
+				mockService.EXPECT().UpdateStatus(gomock.Any(), uint(1), domain.OrderStatusDone).Return(nil, domain.ErrInvalidOrderUpdate)
 
 				req, _ := http.NewRequest(http.MethodPut, baseAPIUri+"/1/status/done", nil)
 				router.ServeHTTP(recorder, req)
@@ -191,6 +306,88 @@ var _ = Describe("OrdersHandler", func() {
 		})
 	})
 
+	Describe("Cancel Order", func() {
+		var (
+			body   map[string]any
+			apiKey string
+		)
+
+		BeforeEach(func() {
+			body = map[string]any{"reason": "out_of_stock"}
+			apiKey = "c00k"
+		})
+
+		JustBeforeEach(func() {
+			payload, _ := json.Marshal(body)
+			req, _ := http.NewRequest(http.MethodPost, baseAPIUri+"/1/cancel", bytes.NewBuffer(payload))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set(internalGin.APIKeyHeader, apiKey)
+			router.ServeHTTP(recorder, req)
+		})
+
+		When("the staff role can cancel the order", func() {
+			BeforeEach(func() {
+				request := domain.CancelOrder{Reason: domain.CancellationReasonOutOfStock}
+				order := &domain.Order{ID: 1, Status: domain.OrderStatusCancelled, Cancellation: &domain.Cancellation{
+					CancelOrder: request,
+					CancelledBy: domain.StaffRoleCook,
+					Refund:      domain.Refund{Type: domain.RefundFull},
+				}}
+				mockService.EXPECT().Cancel(gomock.Any(), uint(1), request, domain.StaffRoleCook).Return(order, nil)
+			})
+
+			It("should return the order with its cancellation", func() {
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+				Expect(recorder.Body.String()).To(ContainSubstring(`"cancelled_by":"cook"`))
+				Expect(recorder.Body.String()).To(ContainSubstring(`"refund":{"type":"full"`))
+			})
+		})
+
+		When("the caller is not staff", func() {
+			BeforeEach(func() {
+				apiKey = "unknown"
+				mockService.EXPECT().Cancel(gomock.Any(), uint(1), gomock.Any(), domain.StaffRole("")).
+					Return(nil, domain.ErrCancellationNotAllowed)
+			})
+
+			It("should return 403 Forbidden", func() {
+				Expect(recorder.Code).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		When("the reason is missing", func() {
+			BeforeEach(func() {
+				body = map[string]any{"note": "No reason"}
+			})
+
+			It("should return 400 Bad Request", func() {
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("List Waste", func() {
+		It("should return the waste recorded in the given period", func() {
+			from := time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC)
+			to := time.Date(2025, 2, 11, 0, 0, 0, 0, time.UTC)
+			waste := []domain.WasteRecord{{ID: 1, OrderID: 3, Dish: "Pizza", Reason: domain.CancellationReasonKitchenError, RecordedAt: from}}
+			mockService.EXPECT().FindWaste(gomock.Any(), from, to).Return(waste, nil)
+
+			req, _ := http.NewRequest(http.MethodGet, "/api/v1/waste?from=2025-02-10T00:00:00Z&to=2025-02-11T00:00:00Z", nil)
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"dish":"Pizza"`))
+		})
+
+		It("should reject invalid times", func() {
+			req, _ := http.NewRequest(http.MethodGet, "/api/v1/waste?from=yesterday", nil)
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
 	Describe("Update Order", func() {
 		var (
 			dishes []domain.Dish
@@ -209,7 +406,7 @@ var _ = Describe("OrdersHandler", func() {
 		When("order status is updated successfully", func() {
 			BeforeEach(func() {
 				order := &domain.Order{ID: 1}
-				mockService.EXPECT().UpdateDishes(uint(1), dishes).Return(order, nil)
+				mockService.EXPECT().UpdateDishes(gomock.Any(), uint(1), dishes).Return(order, nil)
 			})
 
 			It("should return 200 OK", func() {
@@ -220,7 +417,7 @@ var _ = Describe("OrdersHandler", func() {
 
 		When("order update fails due to invalid status", func() {
 			BeforeEach(func() {
-				mockService.EXPECT().UpdateDishes(uint(1), dishes).Return(nil, domain.ErrInvalidOrderUpdate)
+				mockService.EXPECT().UpdateDishes(gomock.Any(), uint(1), dishes).Return(nil, domain.ErrInvalidOrderUpdate)
 			})
 
 			It("should return 400 Bad Request", func() {
@@ -229,6 +426,64 @@ var _ = Describe("OrdersHandler", func() {
 		})
 	})
 
+	Describe("Dish Changes", func() {
+		serve := func(method string, uri string, body any) {
+			var payload []byte
+			if body != nil {
+				payload, _ = json.Marshal(body)
+			}
+
+			req, _ := http.NewRequest(method, baseAPIUri+uri, bytes.NewBuffer(payload))
+			router.ServeHTTP(recorder, req)
+		}
+
+		It("should add dishes", func() {
+			order := &domain.Order{ID: 1, NewOrder: domain.NewOrder{Dishes: []domain.Dish{{ID: 4, Name: "Soup", Status: domain.DishStatusPending}}}}
+			mockService.EXPECT().AddDish(gomock.Any(), uint(1), domain.Dish{Name: "Soup"}).Return(order, nil)
+
+			serve(http.MethodPost, "/1/dishes", map[string]any{"name": "Soup"})
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(ContainSubstring(`{"id":4,"name":"Soup","status":"pending"}`))
+		})
+
+		It("should void dishes with a reason", func() {
+			request := domain.VoidDish{Reason: domain.CancellationReasonOutOfStock}
+			mockService.EXPECT().VoidDish(gomock.Any(), uint(1), uint(4), request).Return(&domain.Order{ID: 1}, nil)
+
+			serve(http.MethodPost, "/1/dishes/4/void", map[string]any{"reason": "out_of_stock"})
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+		})
+
+		It("should reject voids without a reason", func() {
+			serve(http.MethodPost, "/1/dishes/4/void", map[string]any{})
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		DescribeTable("mapping the errors of marking dishes ready", func(err error, status int) {
+			mockService.EXPECT().MarkDishReady(gomock.Any(), uint(1), uint(4)).Return(nil, err)
+
+			serve(http.MethodPut, "/1/dishes/4/ready", nil)
+
+			Expect(recorder.Code).To(Equal(status))
+		},
+			Entry("unknown dishes", domain.ErrDishNotFound, http.StatusNotFound),
+			Entry("changes the statuses don't allow", domain.ErrDishChangeNotAllowed, http.StatusBadRequest),
+		)
+
+		It("should return the dish history", func() {
+			history := []domain.DishChange{{ID: 1, DishID: 4, Dish: "Soup", Action: domain.DishActionVoided, Reason: domain.CancellationReasonOutOfStock}}
+			mockService.EXPECT().FindDishHistory(gomock.Any(), uint(1)).Return(history, nil)
+
+			serve(http.MethodGet, "/1/dishes/history", nil)
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"action":"voided"`))
+		})
+	})
+
 	Describe("Prioritize Order", func() {
 		var (
 			afterID uint = 2
@@ -244,7 +499,7 @@ var _ = Describe("OrdersHandler", func() {
 
 		When("the request is valid", func() {
 			BeforeEach(func() {
-				mockService.EXPECT().Prioritize(orderID, afterID).Return(nil)
+				mockService.EXPECT().Prioritize(gomock.Any(), orderID, afterID).Return(nil)
 			})
 
 			It("should return 204 No Content", func() {
@@ -254,7 +509,7 @@ var _ = Describe("OrdersHandler", func() {
 
 		When("service returns an error", func() {
 			BeforeEach(func() {
-				mockService.EXPECT().Prioritize(orderID, afterID).Return(errors.New("error"))
+				mockService.EXPECT().Prioritize(gomock.Any(), orderID, afterID).Return(errors.New("error"))
 			})
 
 			It("should return 500 Internal Server Error", func() {
@@ -262,4 +517,97 @@ var _ = Describe("OrdersHandler", func() {
 			})
 		})
 	})
+
+	Describe("Assign Courier", func() {
+		var courier map[string]any
+
+		BeforeEach(func() {
+			courier = map[string]any{"name": "Carla", "phone": "5550000"}
+		})
+
+		JustBeforeEach(func() {
+			body, _ := json.Marshal(courier)
+			req, _ := http.NewRequest(http.MethodPut, baseAPIUri+"/1/courier", bytes.NewBuffer(body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(recorder, req)
+		})
+
+		When("the courier is assigned", func() {
+			BeforeEach(func() {
+				order := &domain.Order{ID: 1, Courier: &domain.Courier{Name: "Carla"}}
+				mockService.EXPECT().AssignCourier(gomock.Any(), uint(1), gomock.Any()).Return(order, nil)
+			})
+
+			It("should return 200 OK", func() {
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+				Expect(recorder.Body.String()).To(ContainSubstring(`"courier":{"name":"Carla"`))
+			})
+		})
+
+		When("the order is not a delivery", func() {
+			BeforeEach(func() {
+				mockService.EXPECT().AssignCourier(gomock.Any(), uint(1), gomock.Any()).Return(nil, domain.ErrNotDeliveryOrder)
+			})
+
+			It("should return 400 Bad Request", func() {
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		When("the courier has no name", func() {
+			BeforeEach(func() {
+				courier = map[string]any{"phone": "5550000"}
+			})
+
+			It("should return 400 Bad Request", func() {
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("Reschedule Order", func() {
+		var body map[string]any
+
+		JustBeforeEach(func() {
+			payload, _ := json.Marshal(body)
+			req, _ := http.NewRequest(http.MethodPut, baseAPIUri+"/1/schedule", bytes.NewBuffer(payload))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(recorder, req)
+		})
+
+		When("the order is scheduled", func() {
+			BeforeEach(func() {
+				readyAt := time.Date(2025, 2, 10, 20, 0, 0, 0, time.UTC)
+				body = map[string]any{"ready_at": readyAt}
+
+				order := &domain.Order{ID: 1, Status: domain.OrderStatusScheduled}
+				mockService.EXPECT().Reschedule(gomock.Any(), uint(1), readyAt).Return(order, nil)
+			})
+
+			It("should return 200 OK", func() {
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+			})
+		})
+
+		When("the order is not scheduled anymore", func() {
+			BeforeEach(func() {
+				body = map[string]any{"ready_at": time.Now()}
+				mockService.EXPECT().Reschedule(gomock.Any(), uint(1), gomock.Any()).Return(nil, domain.ErrInvalidOrderUpdate)
+			})
+
+			It("should return 400 Bad Request", func() {
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		When("no ready time is provided", func() {
+			BeforeEach(func() {
+				body = map[string]any{}
+			})
+
+			It("should return 400 Bad Request", func() {
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+	})
 })
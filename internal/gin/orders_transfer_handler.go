@@ -0,0 +1,128 @@
+package gin
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/danbrato999/yuno-gveloz/internal/orderio"
+	"github.com/gin-gonic/gin"
+)
+
+type OrdersTransferHandler struct {
+	transferService services.OrderTransferService
+	logger          *slog.Logger
+}
+
+func NewOrdersTransferHandler(transferService services.OrderTransferService, logger *slog.Logger) *OrdersTransferHandler {
+	return &OrdersTransferHandler{
+		transferService: transferService,
+		logger:          logger,
+	}
+}
+
+// Import reads the body as CSV or NDJSON, picked by the format param or the content type. Valid
+// rows are imported even if others fail, and the report lists the failed ones
+func (h *OrdersTransferHandler) Import(c *gin.Context) {
+	var queryParams struct {
+		Format string `form:"format" binding:"omitempty,oneof=csv ndjson"`
+		DryRun bool   `form:"dry_run"`
+	}
+
+	if err := c.BindQuery(&queryParams); err != nil {
+		return
+	}
+
+	format := queryParams.Format
+	if format == "" {
+		format = orderio.FormatFromContentType(c.ContentType())
+	}
+
+	if format == "" {
+		c.AbortWithStatus(http.StatusUnsupportedMediaType)
+		return
+	}
+
+	reader, err := orderio.NewImportReader(format, c.Request.Body)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	report, err := h.transferService.Import(c.Request.Context(), reader, queryParams.DryRun)
+	if err != nil {
+		h.logger.ErrorContext(c.Request.Context(), "order import stopped", slog.Int("rows", report.Total), slog.Any("error", err))
+		c.AbortWithStatus(unexpectedErrorStatus(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// Export streams the orders with their status history, flushing every batch
+func (h *OrdersTransferHandler) Export(c *gin.Context) {
+	var queryParams struct {
+		Format string `form:"format" binding:"omitempty,oneof=csv ndjson"`
+		// Comma separated list of statuses
+		Status string             `form:"status"`
+		Source domain.OrderSource `form:"source" binding:"omitempty,oneof=in_person delivery phone"`
+	}
+
+	if err := c.BindQuery(&queryParams); err != nil {
+		return
+	}
+
+	format := queryParams.Format
+	if format == "" {
+		format = orderio.FormatNDJSON
+	}
+
+	var filters []domain.OrderFilterFn
+
+	if queryParams.Status != "" {
+		var statuses []domain.OrderStatus
+		for _, status := range strings.Split(queryParams.Status, ",") {
+			statuses = append(statuses, domain.OrderStatus(strings.TrimSpace(status)))
+		}
+
+		filters = append(filters, domain.FilterByStatus(statuses...))
+	}
+
+	if queryParams.Source != "" {
+		filters = append(filters, domain.FilterBySource(queryParams.Source))
+	}
+
+	writer, err := orderio.NewExportWriter(format, c.Writer)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	c.Header("Content-Type", orderio.ContentType(format))
+	c.Header("Content-Disposition", "attachment; filename=orders."+format)
+	c.Status(http.StatusOK)
+
+	err = h.transferService.Export(c.Request.Context(), func(batch []domain.OrderWithStatusHistory) error {
+		if err := writer.Write(batch); err != nil {
+			return err
+		}
+
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+
+		c.Writer.Flush()
+		return nil
+	}, filters...)
+
+	if err == nil {
+		err = writer.Flush()
+	}
+
+	// The status was already sent, so a failed export just ends early
+	if err != nil {
+		h.logger.ErrorContext(c.Request.Context(), "order export failed", slog.Any("error", err))
+	}
+}
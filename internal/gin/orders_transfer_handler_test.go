@@ -0,0 +1,150 @@
+package gin_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
+	internalGin "github.com/danbrato999/yuno-gveloz/internal/gin"
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("OrdersTransferHandler", func() {
+	var (
+		mockTransferService *mocks.MockOrderTransferService
+		router              *gin.Engine
+		recorder            *httptest.ResponseRecorder
+	)
+
+	BeforeEach(func() {
+		mockTransferService = mocks.NewMockOrderTransferService(gomock.NewController(GinkgoT()))
+		recorder = httptest.NewRecorder()
+		router = internalGin.GetServer(internalGin.Services{Transfers: mockTransferService})
+	})
+
+	Context("Import", func() {
+		importOrders := func(uri, contentType, body string) {
+			req, _ := http.NewRequest(http.MethodPost, uri, bytes.NewBufferString(body))
+			req.Header.Set("Content-Type", contentType)
+			router.ServeHTTP(recorder, req)
+		}
+
+		It("should return the import report", func() {
+			mockTransferService.EXPECT().Import(gomock.Any(), gomock.Any(), true).
+				DoAndReturn(func(_ context.Context, reader services.OrderImportReader, _ bool) (*domain.ImportReport, error) {
+					row, err := reader.Next()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(row.Order.Dishes).To(Equal([]domain.Dish{{Name: "Pizza"}}))
+
+					report := &domain.ImportReport{DryRun: true, Total: 2, Imported: 1}
+					report.AddError(3, domain.ErrInvalidImportedOrder)
+					return report, nil
+				})
+
+			importOrders("/api/v1/orders/import?dry_run=true", "text/csv",
+				"time,source,dishes\n2025-02-10T12:00:00Z,phone,Pizza\n2025-02-10T12:00:00Z,kiosk,Pizza\n")
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(MatchJSON(`{
+				"dry_run": true,
+				"total": 2,
+				"imported": 1,
+				"failed": 1,
+				"errors": [{"row": 3, "error": "Imported order is not valid"}]
+			}`))
+		})
+
+		It("should prefer the format param over the content type", func() {
+			mockTransferService.EXPECT().Import(gomock.Any(), gomock.Any(), false).Return(&domain.ImportReport{}, nil)
+
+			importOrders("/api/v1/orders/import?format=ndjson", "text/plain", "")
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+		})
+
+		It("should return 415 Unsupported Media Type for other content types", func() {
+			importOrders("/api/v1/orders/import", "application/xml", "<orders/>")
+
+			Expect(recorder.Code).To(Equal(http.StatusUnsupportedMediaType))
+		})
+
+		It("should return 400 Bad Request for CSV files without the required columns", func() {
+			importOrders("/api/v1/orders/import", "text/csv", "time,source\n")
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			Expect(recorder.Body.String()).To(MatchJSON(`{"error": "missing CSV column dishes"}`))
+		})
+
+		It("should return 500 Internal Server Error when the import stops", func() {
+			mockTransferService.EXPECT().Import(gomock.Any(), gomock.Any(), false).Return(&domain.ImportReport{}, errors.New("database is locked"))
+
+			importOrders("/api/v1/orders/import", "application/x-ndjson", "")
+
+			Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+
+	Context("Export", func() {
+		orderTime := time.Date(2025, 2, 10, 12, 0, 0, 0, time.UTC)
+
+		It("should stream the orders as CSV", func() {
+			mockTransferService.EXPECT().Export(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, fn func([]domain.OrderWithStatusHistory) error, filters ...domain.OrderFilterFn) error {
+					applied := &domain.OrderFilters{}
+					for _, filter := range filters {
+						filter(applied)
+					}
+
+					Expect(applied).To(Equal(&domain.OrderFilters{
+						AnyStatus: []domain.OrderStatus{domain.OrderStatusDone, domain.OrderStatusCancelled},
+					}))
+
+					return fn([]domain.OrderWithStatusHistory{{Order: domain.Order{
+						ID:       4,
+						NewOrder: domain.NewOrder{Time: orderTime, Source: domain.OrderSourcePhone, Dishes: []domain.Dish{{Name: "Pizza"}}},
+						Status:   domain.OrderStatusDone,
+					}}})
+				})
+
+			req, _ := http.NewRequest(http.MethodGet, "/api/v1/orders/export?format=csv&status=done,cancelled", nil)
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Header().Get("Content-Type")).To(Equal("text/csv"))
+			Expect(recorder.Header().Get("Content-Disposition")).To(Equal("attachment; filename=orders.csv"))
+
+			lines := strings.Split(strings.TrimSpace(recorder.Body.String()), "\n")
+			Expect(lines).To(HaveLen(2))
+			Expect(lines[0]).To(HavePrefix("id,time,source,status,dishes"))
+			Expect(lines[1]).To(HavePrefix("4,2025-02-10T12:00:00Z,phone,done,Pizza"))
+		})
+
+		It("should default to NDJSON", func() {
+			mockTransferService.EXPECT().Export(gomock.Any(), gomock.Any()).Return(nil)
+
+			req, _ := http.NewRequest(http.MethodGet, "/api/v1/orders/export", nil)
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Header().Get("Content-Type")).To(Equal("application/x-ndjson"))
+			Expect(recorder.Body.String()).To(BeEmpty())
+		})
+
+		It("should return 400 Bad Request for unknown formats", func() {
+			req, _ := http.NewRequest(http.MethodGet, "/api/v1/orders/export?format=xml", nil)
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+})
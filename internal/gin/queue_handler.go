@@ -0,0 +1,57 @@
+package gin
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/gin-gonic/gin"
+)
+
+type QueueHandler struct {
+	queueService services.QueueService
+}
+
+func NewQueueHandler(queueService services.QueueService) *QueueHandler {
+	return &QueueHandler{
+		queueService: queueService,
+	}
+}
+
+func (q *QueueHandler) List(c *gin.Context) {
+	queue, err := q.queueService.GetQueue(c.Request.Context())
+
+	if err != nil {
+		c.AbortWithStatus(unexpectedErrorStatus(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, queue)
+}
+
+func (q *QueueHandler) FindPosition(c *gin.Context) {
+	id := c.Param("id")
+
+	orderID, err := strconv.Atoi(id)
+
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	position, err := q.queueService.GetOrderPosition(c.Request.Context(), uint(orderID))
+
+	if err != nil {
+		if errors.Is(err, domain.ErrOrderNotQueued) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+
+		abortWithOrderError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, position)
+}
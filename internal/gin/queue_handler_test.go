@@ -0,0 +1,98 @@
+package gin_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
+	internalGin "github.com/danbrato999/yuno-gveloz/internal/gin"
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("QueueHandler", func() {
+	var (
+		ctrl             *gomock.Controller
+		mockQueueService *mocks.MockQueueService
+		router           *gin.Engine
+		recorder         *httptest.ResponseRecorder
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockQueueService = mocks.NewMockQueueService(ctrl)
+		recorder = httptest.NewRecorder()
+		router = internalGin.GetServer(internalGin.Services{Queue: mockQueueService})
+	})
+
+	Describe("List Queue", func() {
+		When("the queue has orders", func() {
+			It("should return 200 OK with positions", func() {
+				queue := []domain.QueuedOrder{
+					{Order: domain.Order{ID: 3}, Position: 1, WaitingSeconds: 30},
+					{Order: domain.Order{ID: 1}, Position: 2, WaitingSeconds: 60},
+				}
+				mockQueueService.EXPECT().GetQueue(gomock.Any()).Return(queue, nil)
+
+				req, _ := http.NewRequest(http.MethodGet, "/api/v1/queue", nil)
+				router.ServeHTTP(recorder, req)
+
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+				Expect(recorder.Body.String()).To(ContainSubstring(`"id":3,`))
+				Expect(recorder.Body.String()).To(ContainSubstring(`"position":1,`))
+				Expect(recorder.Body.String()).To(ContainSubstring(`"waiting_seconds":60`))
+			})
+		})
+
+		When("service fails", func() {
+			It("should return 500 Internal Server Error", func() {
+				mockQueueService.EXPECT().GetQueue(gomock.Any()).Return(nil, errors.New("error"))
+
+				req, _ := http.NewRequest(http.MethodGet, "/api/v1/queue", nil)
+				router.ServeHTTP(recorder, req)
+
+				Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
+
+	Describe("Find Order Position", func() {
+		When("the order is queued", func() {
+			It("should return 200 OK", func() {
+				position := &domain.QueuedOrder{Order: domain.Order{ID: 1}, Position: 4}
+				mockQueueService.EXPECT().GetOrderPosition(gomock.Any(), uint(1)).Return(position, nil)
+
+				req, _ := http.NewRequest(http.MethodGet, baseAPIUri+"/1/position", nil)
+				router.ServeHTTP(recorder, req)
+
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+				Expect(recorder.Body.String()).To(ContainSubstring(`"position":4`))
+			})
+		})
+
+		DescribeTable("the order has no position", func(err error) {
+			mockQueueService.EXPECT().GetOrderPosition(gomock.Any(), uint(1)).Return(nil, err)
+
+			req, _ := http.NewRequest(http.MethodGet, baseAPIUri+"/1/position", nil)
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		},
+			Entry("when the order does not exist", domain.ErrOrderNotFound),
+			Entry("when the order is not queued", domain.ErrOrderNotQueued),
+		)
+
+		When("the id is invalid", func() {
+			It("should return 400 Bad Request", func() {
+				req, _ := http.NewRequest(http.MethodGet, baseAPIUri+"/abc/position", nil)
+				router.ServeHTTP(recorder, req)
+
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+	})
+})
@@ -0,0 +1,229 @@
+package gin
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	APIKeyHeader        = "X-API-Key"
+	defaultRateLimitKey = "default"
+	apiPrefix           = "/api/v1/"
+	// Buckets that filled up again are dropped this often, so idle clients don't pile up
+	rateLimitSweepInterval = time.Minute
+)
+
+// RateLimit lets a client send Requests per Period, refilling its bucket evenly along the
+// period. Zero requests leave the clients unlimited
+type RateLimit struct {
+	Requests int
+	Period   time.Duration
+}
+
+// RateLimits applies to every client apart, overriding the default for the route groups in
+// Groups, keyed by the first segment of the API routes, e.g. orders or queue
+type RateLimits struct {
+	Default RateLimit
+	Groups  map[string]RateLimit
+}
+
+func DefaultRateLimits() RateLimits {
+	return RateLimits{
+		Default: RateLimit{Requests: 20, Period: time.Second},
+		Groups: map[string]RateLimit{
+			"orders":       {Requests: 10, Period: time.Second},
+			"integrations": {Requests: 50, Period: time.Second},
+		},
+	}
+}
+
+func (l RateLimits) For(group string) RateLimit {
+	if limit, ok := l.Groups[group]; ok {
+		return limit
+	}
+
+	return l.Default
+}
+
+// Override returns a copy of the limits with the ones of a comma separated list of
+// group=requests/period or default=requests/period entries, e.g. "default=50/s,orders=300/m".
+// A zero limit leaves the group unlimited
+func (l RateLimits) Override(limits string) (RateLimits, error) {
+	result := RateLimits{
+		Default: l.Default,
+		Groups:  make(map[string]RateLimit, len(l.Groups)),
+	}
+
+	maps.Copy(result.Groups, l.Groups)
+
+	for _, entry := range strings.Split(limits, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		group, value, ok := strings.Cut(entry, "=")
+		if !ok || group == "" {
+			return result, fmt.Errorf("invalid rate limit %q", entry)
+		}
+
+		limit, err := parseRateLimit(value)
+		if err != nil {
+			return result, fmt.Errorf("invalid rate limit %q: %w", entry, err)
+		}
+
+		if group == defaultRateLimitKey {
+			result.Default = limit
+		} else {
+			result.Groups[group] = limit
+		}
+	}
+
+	return result, nil
+}
+
+func parseRateLimit(value string) (RateLimit, error) {
+	if value == "0" {
+		return RateLimit{}, nil
+	}
+
+	requests, period, ok := strings.Cut(value, "/")
+	if !ok {
+		return RateLimit{}, fmt.Errorf("expected requests/period")
+	}
+
+	count, err := strconv.Atoi(requests)
+	if err != nil || count < 0 {
+		return RateLimit{}, fmt.Errorf("invalid number of requests %q", requests)
+	}
+
+	// Periods can skip their amount, e.g. 10/s
+	if period != "" && (period[0] < '0' || period[0] > '9') {
+		period = "1" + period
+	}
+
+	duration, err := time.ParseDuration(period)
+	if err != nil || duration <= 0 {
+		return RateLimit{}, fmt.Errorf("invalid period %q", period)
+	}
+
+	return RateLimit{Requests: count, Period: duration}, nil
+}
+
+type tokenBucket struct {
+	tokens  float64
+	updated time.Time
+	// When the bucket is full again, after which it can be dropped
+	full time.Time
+}
+
+type rateLimiter struct {
+	limits    RateLimits
+	mu        sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+func newRateLimiter(limits RateLimits) *rateLimiter {
+	return &rateLimiter{
+		limits:  limits,
+		buckets: make(map[string]*tokenBucket),
+	}
+}
+
+// take spends a token of the client bucket for the group, returning how long the client has to
+// wait instead when the bucket is empty
+func (l *rateLimiter) take(group, client string, now time.Time) time.Duration {
+	limit := l.limits.For(group)
+	if limit.Requests <= 0 {
+		return 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastSweep) >= rateLimitSweepInterval {
+		l.sweep(now)
+	}
+
+	capacity := float64(limit.Requests)
+	perSecond := capacity / limit.Period.Seconds()
+
+	key := group + "|" + client
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{tokens: capacity, updated: now}
+		l.buckets[key] = bucket
+	}
+
+	bucket.tokens = math.Min(capacity, bucket.tokens+now.Sub(bucket.updated).Seconds()*perSecond)
+	bucket.updated = now
+
+	if bucket.tokens < 1 {
+		return time.Duration((1 - bucket.tokens) / perSecond * float64(time.Second))
+	}
+
+	bucket.tokens--
+	bucket.full = now.Add(time.Duration((capacity - bucket.tokens) / perSecond * float64(time.Second)))
+
+	return 0
+}
+
+func (l *rateLimiter) sweep(now time.Time) {
+	for key, bucket := range l.buckets {
+		if !now.Before(bucket.full) {
+			delete(l.buckets, key)
+		}
+	}
+
+	l.lastSweep = now
+}
+
+// rateLimitMiddleware limits the API requests of every client, identified by its staff API key or,
+// without a known one, by its IP. Any other key would let clients get a fresh budget on every request
+func rateLimitMiddleware(limits RateLimits, keys domain.StaffKeys) gin.HandlerFunc {
+	limiter := newRateLimiter(limits)
+
+	return func(c *gin.Context) {
+		group := routeGroup(c.FullPath())
+		if group == "" {
+			c.Next()
+			return
+		}
+
+		client := "ip:" + c.ClientIP()
+		if key := c.GetHeader(APIKeyHeader); key != "" {
+			if _, ok := keys[key]; ok {
+				client = "key:" + key
+			}
+		}
+
+		if wait := limiter.take(group, client, time.Now()); wait > 0 {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+			c.AbortWithStatus(http.StatusTooManyRequests)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// routeGroup returns the first segment of the API routes, or nothing for the routes outside the API
+func routeGroup(route string) string {
+	path, ok := strings.CutPrefix(route, apiPrefix)
+	if !ok {
+		return ""
+	}
+
+	group, _, _ := strings.Cut(path, "/")
+	return group
+}
@@ -0,0 +1,138 @@
+package gin_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
+	internalGin "github.com/danbrato999/yuno-gveloz/internal/gin"
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("RateLimits", func() {
+	Describe("Override", func() {
+		It("should override the default and group limits", func() {
+			limits, err := internalGin.DefaultRateLimits().Override("default=50/s, queue=300/m, orders=5/10s, integrations=0")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(limits.For("customers")).To(Equal(internalGin.RateLimit{Requests: 50, Period: time.Second}))
+			Expect(limits.For("queue")).To(Equal(internalGin.RateLimit{Requests: 300, Period: time.Minute}))
+			Expect(limits.For("orders")).To(Equal(internalGin.RateLimit{Requests: 5, Period: 10 * time.Second}))
+			Expect(limits.For("integrations")).To(BeZero())
+		})
+
+		It("should not change the original limits", func() {
+			original := internalGin.DefaultRateLimits()
+
+			_, err := original.Override("orders=1/m")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(original.For("orders")).To(Equal(internalGin.RateLimit{Requests: 10, Period: time.Second}))
+		})
+
+		DescribeTable("should reject invalid entries",
+			func(limits string) {
+				_, err := internalGin.DefaultRateLimits().Override(limits)
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("without a limit", "orders"),
+			Entry("without a period", "orders=10"),
+			Entry("with an invalid period", "orders=10/fortnight"),
+			Entry("with negative requests", "orders=-1/s"),
+		)
+	})
+
+	Describe("middleware", func() {
+		var (
+			mockOrderService *mocks.MockOrderService
+			mockQueueService *mocks.MockQueueService
+			router           *gin.Engine
+		)
+
+		BeforeEach(func() {
+			mockCtrl := gomock.NewController(GinkgoT())
+			mockOrderService = mocks.NewMockOrderService(mockCtrl)
+			mockQueueService = mocks.NewMockQueueService(mockCtrl)
+			router = internalGin.GetServer(internalGin.Services{
+				Orders: mockOrderService,
+				Queue:  mockQueueService,
+				RateLimits: internalGin.RateLimits{
+					Default: internalGin.RateLimit{Requests: 2, Period: time.Minute},
+					Groups:  map[string]internalGin.RateLimit{"queue": {}},
+				},
+				StaffKeys: domain.StaffKeys{"k1tch3n": domain.StaffRoleCook, "fl00r": domain.StaffRoleManager},
+			})
+		})
+
+		serve := func(uri string, headers map[string]string) *httptest.ResponseRecorder {
+			recorder := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, uri, nil)
+			req.RemoteAddr = "10.0.0.1:5000"
+			for name, value := range headers {
+				req.Header.Set(name, value)
+			}
+
+			router.ServeHTTP(recorder, req)
+			return recorder
+		}
+
+		It("should answer 429 once the client runs out of requests", func() {
+			mockOrderService.EXPECT().FindByID(gomock.Any(), uint(7)).Return(nil, domain.ErrOrderNotFound).Times(2)
+
+			Expect(serve("/api/v1/orders/7", nil).Code).To(Equal(http.StatusNotFound))
+			Expect(serve("/api/v1/orders/7", nil).Code).To(Equal(http.StatusNotFound))
+
+			recorder := serve("/api/v1/orders/7", nil)
+
+			Expect(recorder.Code).To(Equal(http.StatusTooManyRequests))
+
+			retryAfter, err := strconv.Atoi(recorder.Header().Get("Retry-After"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retryAfter).To(BeNumerically("~", 30, 1))
+		})
+
+		It("should limit every staff key apart", func() {
+			mockOrderService.EXPECT().FindByID(gomock.Any(), uint(7)).Return(nil, domain.ErrOrderNotFound).Times(4)
+
+			for range 2 {
+				serve("/api/v1/orders/7", nil)
+			}
+
+			Expect(serve("/api/v1/orders/7", map[string]string{internalGin.APIKeyHeader: "k1tch3n"}).Code).To(Equal(http.StatusNotFound))
+			Expect(serve("/api/v1/orders/7", map[string]string{internalGin.APIKeyHeader: "fl00r"}).Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("should limit the unknown keys by IP", func() {
+			mockOrderService.EXPECT().FindByID(gomock.Any(), uint(7)).Return(nil, domain.ErrOrderNotFound).Times(2)
+
+			serve("/api/v1/orders/7", map[string]string{internalGin.APIKeyHeader: "kiosk-1"})
+			serve("/api/v1/orders/7", map[string]string{internalGin.APIKeyHeader: "kiosk-2"})
+
+			Expect(serve("/api/v1/orders/7", map[string]string{internalGin.APIKeyHeader: "kiosk-3"}).Code).To(Equal(http.StatusTooManyRequests))
+		})
+
+		It("should not trust the forwarded IPs of unknown proxies", func() {
+			mockOrderService.EXPECT().FindByID(gomock.Any(), uint(7)).Return(nil, domain.ErrOrderNotFound).Times(2)
+
+			serve("/api/v1/orders/7", map[string]string{"X-Forwarded-For": "192.168.0.1"})
+			serve("/api/v1/orders/7", map[string]string{"X-Forwarded-For": "192.168.0.2"})
+
+			Expect(serve("/api/v1/orders/7", map[string]string{"X-Forwarded-For": "192.168.0.3"}).Code).To(Equal(http.StatusTooManyRequests))
+		})
+
+		It("should leave the unlimited groups and the routes outside the API alone", func() {
+			mockQueueService.EXPECT().GetQueue(gomock.Any()).Return(nil, nil).Times(3)
+
+			for range 3 {
+				Expect(serve("/api/v1/queue", nil).Code).To(Equal(http.StatusOK))
+				Expect(serve("/healthz", nil).Code).To(Equal(http.StatusOK))
+			}
+		})
+	})
+})
@@ -0,0 +1,126 @@
+package gin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const importRoute = "/api/v1/orders/import"
+
+// RequestLimits bounds the size of the request bodies, in bytes, and the dishes of an order.
+// Zero limits leave them unbounded
+type RequestLimits struct {
+	MaxBody int64 `json:"max_body"`
+	// Imports carry whole files, so they get their own limit
+	MaxImportBody int64 `json:"max_import_body"`
+	// Checked by the order services instead, so every API and the imports share it
+	MaxDishes int `json:"max_dishes"`
+}
+
+func DefaultRequestLimits() RequestLimits {
+	return RequestLimits{
+		MaxBody:       1 << 20,
+		MaxImportBody: 32 << 20,
+		MaxDishes:     50,
+	}
+}
+
+// Override returns a copy of the limits with the ones of a comma separated list of body=size,
+// import=size or dishes=count entries, where sizes are bytes or KiB, MiB or GiB,
+// e.g. "body=256KiB,dishes=20"
+func (l RequestLimits) Override(limits string) (RequestLimits, error) {
+	result := l
+
+	for _, entry := range strings.Split(limits, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		key, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return result, fmt.Errorf("invalid request limit %q", entry)
+		}
+
+		var err error
+
+		switch key {
+		case "body":
+			result.MaxBody, err = parseByteSize(value)
+		case "import":
+			result.MaxImportBody, err = parseByteSize(value)
+		case "dishes":
+			result.MaxDishes, err = strconv.Atoi(value)
+			if err == nil && result.MaxDishes < 0 {
+				err = errors.New("negative count")
+			}
+		default:
+			return result, fmt.Errorf("invalid request limit %q: unknown limit %s", entry, key)
+		}
+
+		if err != nil {
+			return result, fmt.Errorf("invalid request limit %q: %w", entry, err)
+		}
+	}
+
+	return result, nil
+}
+
+func parseByteSize(value string) (int64, error) {
+	multiplier := int64(1)
+
+	for suffix, unit := range map[string]int64{"KiB": 1 << 10, "MiB": 1 << 20, "GiB": 1 << 30} {
+		if number, ok := strings.CutSuffix(value, suffix); ok {
+			value, multiplier = number, unit
+			break
+		}
+	}
+
+	size, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || size < 0 {
+		return 0, fmt.Errorf("invalid size %q", value)
+	}
+
+	return size * multiplier, nil
+}
+
+// requestLimitsMiddleware rejects the oversized bodies before they are decoded
+func requestLimitsMiddleware(limits RequestLimits) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		maxBody := limits.MaxBody
+		if c.FullPath() == importRoute {
+			maxBody = limits.MaxImportBody
+		}
+
+		if maxBody > 0 {
+			if c.Request.ContentLength > maxBody {
+				c.AbortWithStatus(http.StatusRequestEntityTooLarge)
+				return
+			}
+
+			// Catches the bodies without a content length, failing the reads past the limit
+			c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxBody)
+		}
+
+		c.Next()
+	}
+}
+
+// Bodies cut by their size limit answer with 413, any other invalid body with 400
+func bodyErrorStatus(err error) int {
+	if isBodyTooLarge(err) {
+		return http.StatusRequestEntityTooLarge
+	}
+
+	return http.StatusBadRequest
+}
+
+func isBodyTooLarge(err error) bool {
+	var maxBytesErr *http.MaxBytesError
+	return errors.As(err, &maxBytesErr)
+}
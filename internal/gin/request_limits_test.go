@@ -0,0 +1,124 @@
+package gin_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
+	internalGin "github.com/danbrato999/yuno-gveloz/internal/gin"
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("RequestLimits", func() {
+	Describe("Override", func() {
+		It("should override the limits", func() {
+			limits, err := internalGin.DefaultRequestLimits().Override("body=256KiB, import=1GiB, dishes=0")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(limits).To(Equal(internalGin.RequestLimits{MaxBody: 256 << 10, MaxImportBody: 1 << 30}))
+		})
+
+		DescribeTable("should reject invalid entries",
+			func(limits string) {
+				_, err := internalGin.DefaultRequestLimits().Override(limits)
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("without a value", "body"),
+			Entry("with an invalid size", "body=1MB"),
+			Entry("with a negative count", "dishes=-1"),
+			Entry("with an unknown limit", "headers=10"),
+		)
+	})
+
+	Describe("middleware", func() {
+		var (
+			mockOrderService    *mocks.MockOrderService
+			mockTransferService *mocks.MockOrderTransferService
+			router              *gin.Engine
+		)
+
+		BeforeEach(func() {
+			mockCtrl := gomock.NewController(GinkgoT())
+			mockOrderService = mocks.NewMockOrderService(mockCtrl)
+			mockTransferService = mocks.NewMockOrderTransferService(mockCtrl)
+			router = internalGin.GetServer(internalGin.Services{
+				Orders:    mockOrderService,
+				Transfers: mockTransferService,
+				Limits:    internalGin.RequestLimits{MaxBody: 256, MaxImportBody: 1024, MaxDishes: 2},
+			})
+		})
+
+		serve := func(method, uri string, body io.Reader) *httptest.ResponseRecorder {
+			recorder := httptest.NewRecorder()
+			req, _ := http.NewRequest(method, uri, body)
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(recorder, req)
+			return recorder
+		}
+
+		orderWithDishes := func(count int) string {
+			dishes := strings.TrimSuffix(strings.Repeat(`{"name":"Taco"},`, count), ",")
+			return `{"time":"2025-02-10T12:00:00Z","source":"phone","dishes":[` + dishes + `]}`
+		}
+
+		It("should answer 413 to the bodies over the limit", func() {
+			Expect(serve(http.MethodPost, "/api/v1/orders", strings.NewReader(strings.Repeat(" ", 257))).Code).
+				To(Equal(http.StatusRequestEntityTooLarge))
+		})
+
+		It("should answer 413 to the bodies over the limit without a content length", func() {
+			body := io.MultiReader(strings.NewReader(`{"time":"2025-02-10T12:00:00Z","dishes":[{"name":"`), strings.NewReader(strings.Repeat("a", 300)+`"}]}`))
+
+			Expect(serve(http.MethodPut, "/api/v1/orders/7/courier", body).Code).To(Equal(http.StatusRequestEntityTooLarge))
+		})
+
+		It("should answer 400 to the orders the service finds with too many dishes", func() {
+			mockOrderService.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil, domain.CheckDishLimit(3, 2))
+
+			recorder := serve(http.MethodPost, "/api/v1/orders", strings.NewReader(orderWithDishes(3)))
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			Expect(recorder.Body.String()).To(MatchJSON(`{"error":"Order has more dishes than allowed: orders can't have more than 2 dishes"}`))
+		})
+
+		It("should let the orders within the limits through", func() {
+			mockOrderService.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, order domain.NewOrder) (*domain.Order, error) {
+				Expect(order.Dishes).To(HaveLen(2))
+				return &domain.Order{ID: 1, NewOrder: order}, nil
+			})
+
+			Expect(serve(http.MethodPost, "/api/v1/orders", strings.NewReader(orderWithDishes(2))).Code).To(Equal(http.StatusCreated))
+		})
+
+		It("should give the imports their own limit", func() {
+			mockTransferService.EXPECT().Import(gomock.Any(), gomock.Any(), false).
+				DoAndReturn(func(_ context.Context, reader services.OrderImportReader, _ bool) (*domain.ImportReport, error) {
+					for {
+						if _, err := reader.Next(); err != nil {
+							if errors.Is(err, io.EOF) {
+								return &domain.ImportReport{}, nil
+							}
+
+							return &domain.ImportReport{}, err
+						}
+					}
+				}).Times(2)
+
+			row := `{"dishes":[{"name":"Taco"}]}` + "\n"
+
+			Expect(serve(http.MethodPost, "/api/v1/orders/import?format=ndjson", strings.NewReader(strings.Repeat(row, 20))).Code).
+				To(Equal(http.StatusOK))
+			Expect(serve(http.MethodPost, "/api/v1/orders/import?format=ndjson", io.MultiReader(strings.NewReader(strings.Repeat(row, 40)))).Code).
+				To(Equal(http.StatusRequestEntityTooLarge))
+		})
+	})
+})
@@ -1,30 +1,210 @@
 package gin
 
 import (
+	"log/slog"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
 	"github.com/danbrato999/yuno-gveloz/domain/services"
+	internalGraphql "github.com/danbrato999/yuno-gveloz/internal/graphql"
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+)
+
+const (
+	serviceName    = "gveloz"
+	livenessRoute  = "/healthz"
+	readinessRoute = "/readyz"
 )
 
-func addOrderRoutes(ordersHandler *OrdersHandler, api *gin.RouterGroup) {
+type Services struct {
+	Orders       services.OrderService
+	Queue        services.QueueService
+	QueueChecker services.QueueIntegrityChecker
+	Customers    services.CustomerService
+	Tables       services.TableService
+	Events       services.EventSubscriber
+	Webhooks     services.WebhookService
+	Integrations services.IntegrationService
+	Transfers    services.OrderTransferService
+	Readiness    services.ReadinessChecker
+	// Optional, enables the /metrics endpoint
+	Metrics HTTPMetrics
+	// The zero value leaves every request unbounded
+	Timeouts RequestTimeouts
+	// The zero value leaves every client unlimited
+	RateLimits RateLimits
+	// The zero value leaves the bodies and orders unbounded
+	Limits RequestLimits
+	// Requests sent with one of these API keys act with its staff role
+	StaffKeys domain.StaffKeys
+	// Proxies whose X-Forwarded-For header is trusted, as IPs or CIDRs. None by default, so clients
+	// can't pick the IP they are rate limited by
+	TrustedProxies []string
+	// Defaults to slog's default logger
+	Logger *slog.Logger
+	// Optional, enables the /debug endpoints when it has a token
+	Diagnostics *Diagnostics
+}
+
+func addOrderRoutes(
+	ordersHandler *OrdersHandler,
+	queueHandler *QueueHandler,
+	transferHandler *OrdersTransferHandler,
+	api *gin.RouterGroup,
+) {
 	orders := api.Group("/orders")
 	orders.GET("", ordersHandler.List)
 	orders.POST("", ordersHandler.Create)
+	orders.POST("/import", transferHandler.Import)
+	orders.GET("/export", transferHandler.Export)
 
 	order := orders.Group("/:id")
 	order.GET("", ordersHandler.Find)
 	order.PUT("", ordersHandler.UpdateContent)
 	order.PUT("/status/:status", ordersHandler.UpdateStatus)
+	order.POST("/cancel", ordersHandler.Cancel)
 	order.PUT("/prioritize", ordersHandler.Prioritize)
+	order.PUT("/courier", ordersHandler.AssignCourier)
+	order.PUT("/schedule", ordersHandler.Reschedule)
+	order.GET("/position", queueHandler.FindPosition)
+
+	dishes := order.Group("/dishes")
+	dishes.POST("", ordersHandler.AddDish)
+	dishes.GET("/history", ordersHandler.DishHistory)
+	dishes.POST("/:dish_id/void", ordersHandler.VoidDish)
+	dishes.PUT("/:dish_id/ready", ordersHandler.MarkDishReady)
+}
+
+func addWasteRoutes(ordersHandler *OrdersHandler, api *gin.RouterGroup) {
+	api.GET("/waste", ordersHandler.ListWaste)
+}
+
+func addQueueRoutes(queueHandler *QueueHandler, api *gin.RouterGroup) {
+	api.GET("/queue", queueHandler.List)
+}
+
+func addCustomerRoutes(customersHandler *CustomersHandler, api *gin.RouterGroup) {
+	customers := api.Group("/customers")
+	customers.GET("", customersHandler.List)
+	customers.POST("", customersHandler.Create)
+
+	customer := customers.Group("/:id")
+	customer.GET("", customersHandler.Find)
+	customer.PUT("", customersHandler.Update)
+	customer.DELETE("", customersHandler.Delete)
+	customer.GET("/orders", customersHandler.ListOrders)
+}
+
+func addTableRoutes(tablesHandler *TablesHandler, api *gin.RouterGroup) {
+	tables := api.Group("/tables")
+	tables.GET("", tablesHandler.List)
+	tables.POST("", tablesHandler.Create)
+	tables.GET("/floor-plan", tablesHandler.FloorPlan)
+	tables.GET("/sessions/:session_id", tablesHandler.FindSession)
+
+	session := tables.Group("/:id/session")
+	session.GET("", tablesHandler.CurrentSession)
+	session.POST("", tablesHandler.Seat)
+	session.POST("/close", tablesHandler.CloseSession)
+}
+
+func addEventRoutes(eventsHandler *EventsHandler, api *gin.RouterGroup) {
+	api.GET("/events", eventsHandler.Stream)
+}
+
+func addWebhookRoutes(webhooksHandler *WebhooksHandler, api *gin.RouterGroup) {
+	webhooks := api.Group("/webhooks", requireStaffRole(domain.StaffRoleManager))
+	webhooks.GET("", webhooksHandler.List)
+	webhooks.POST("", webhooksHandler.Create)
+	webhooks.GET("/dead-letters", webhooksHandler.ListDeadLetters)
+
+	webhook := webhooks.Group("/:id")
+	webhook.GET("", webhooksHandler.Find)
+	webhook.DELETE("", webhooksHandler.Delete)
+}
+
+func addIntegrationRoutes(integrationsHandler *IntegrationsHandler, api *gin.RouterGroup) {
+	api.POST("/integrations/:provider/orders", integrationsHandler.ReceiveOrder)
+}
+
+func addGraphQLRoutes(graphQLHandler *GraphQLHandler, api *gin.RouterGroup) {
+	api.POST("/graphql", graphQLHandler.Execute)
+}
+
+func addAdminRoutes(adminHandler *AdminHandler, api *gin.RouterGroup) {
+	queue := api.Group("/admin/queue", requireStaffRole(domain.StaffRoleManager))
+	queue.GET("/integrity", adminHandler.VerifyQueue)
+	queue.POST("/repair", adminHandler.RepairQueue)
+}
+
+func addHealthRoutes(healthHandler *HealthHandler, router *gin.Engine) {
+	router.GET(livenessRoute, healthHandler.Live)
+	router.GET(readinessRoute, healthHandler.Ready)
+}
+
+func addDebugRoutes(debugHandler *DebugHandler, token string, router *gin.Engine) {
+	debug := router.Group("/debug", debugAuthMiddleware(token))
+	debug.GET("/build", debugHandler.Build)
+	debug.GET("/config", debugHandler.Config)
+	debug.GET("/db", debugHandler.Database)
+	debug.GET("/queue", debugHandler.Queue)
 }
 
-func GetServer(orderService services.OrderService) *gin.Engine {
-	ordersHandler := &OrdersHandler{
-		orderService: orderService,
+func GetServer(s Services) *gin.Engine {
+	logger := s.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	ordersHandler := NewOrdersHandler(s.Orders)
+	queueHandler := NewQueueHandler(s.Queue)
+	transferHandler := NewOrdersTransferHandler(s.Transfers, logger)
+	adminHandler := NewAdminHandler(s.QueueChecker)
+	customersHandler := NewCustomersHandler(s.Customers)
+	tablesHandler := NewTablesHandler(s.Tables)
+	eventsHandler := NewEventsHandler(s.Events)
+	webhooksHandler := NewWebhooksHandler(s.Webhooks)
+	integrationsHandler := NewIntegrationsHandler(s.Integrations)
+	healthHandler := NewHealthHandler(s.Readiness)
+	graphQLHandler := NewGraphQLHandler(internalGraphql.NewSchema(s.Orders, s.Queue, s.Events, logger))
+
+	router := gin.New()
+	if err := router.SetTrustedProxies(s.TrustedProxies); err != nil {
+		panic(err.Error())
+	}
+
+	router.Use(otelgin.Middleware(serviceName))
+	router.Use(requestIDMiddleware())
+	router.Use(accessLogMiddleware(logger))
+
+	if s.Metrics != nil {
+		router.Use(metricsMiddleware(s.Metrics))
+		router.GET("/metrics", gin.WrapH(s.Metrics.Handler()))
 	}
 
-	router := gin.Default()
+	// Recovering inside the other middlewares lets panicked requests be logged, measured and traced as 500s
+	router.Use(recoveryMiddleware(logger))
+	router.Use(staffMiddleware(s.StaffKeys))
+	router.Use(rateLimitMiddleware(s.RateLimits, s.StaffKeys))
+	router.Use(requestLimitsMiddleware(s.Limits))
+	router.Use(timeoutMiddleware(s.Timeouts))
+
+	addHealthRoutes(healthHandler, router)
+
+	if s.Diagnostics != nil && s.Diagnostics.Token != "" {
+		addDebugRoutes(NewDebugHandler(s.QueueChecker, *s.Diagnostics), s.Diagnostics.Token, router)
+	}
 
 	api := router.Group("/api/v1")
-	addOrderRoutes(ordersHandler, api)
+	addOrderRoutes(ordersHandler, queueHandler, transferHandler, api)
+	addWasteRoutes(ordersHandler, api)
+	addQueueRoutes(queueHandler, api)
+	addCustomerRoutes(customersHandler, api)
+	addTableRoutes(tablesHandler, api)
+	addEventRoutes(eventsHandler, api)
+	addWebhookRoutes(webhooksHandler, api)
+	addIntegrationRoutes(integrationsHandler, api)
+	addGraphQLRoutes(graphQLHandler, api)
+	addAdminRoutes(adminHandler, api)
 	return router
 }
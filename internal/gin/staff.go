@@ -0,0 +1,35 @@
+package gin
+
+import (
+	"net/http"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/gin-gonic/gin"
+)
+
+// staffMiddleware gives the requests sent with a staff API key the role of its owner
+func staffMiddleware(keys domain.StaffKeys) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if role, ok := keys[c.GetHeader(APIKeyHeader)]; ok {
+			c.Request = c.Request.WithContext(services.WithStaffRole(c.Request.Context(), role))
+		}
+
+		c.Next()
+	}
+}
+
+// requireStaffRole answers 401 to the requests without a staff API key, and 403 to the ones
+// sent with the key of another role
+func requireStaffRole(role domain.StaffRole) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch services.StaffRoleFrom(c.Request.Context()) {
+		case role:
+			c.Next()
+		case "":
+			c.AbortWithStatus(http.StatusUnauthorized)
+		default:
+			c.AbortWithStatus(http.StatusForbidden)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package gin
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/gin-gonic/gin"
+)
+
+type TablesHandler struct {
+	tableService services.TableService
+}
+
+func NewTablesHandler(tableService services.TableService) *TablesHandler {
+	return &TablesHandler{
+		tableService: tableService,
+	}
+}
+
+func (h *TablesHandler) Create(c *gin.Context) {
+	var body domain.NewTable
+
+	if err := bindJSON(c, &body); err != nil {
+		return
+	}
+
+	table, err := h.tableService.CreateTable(c.Request.Context(), body)
+
+	if err != nil {
+		abortWithTableError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, table)
+}
+
+func (h *TablesHandler) List(c *gin.Context) {
+	tables, err := h.tableService.FindMany(c.Request.Context())
+
+	if err != nil {
+		abortWithTableError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, tables)
+}
+
+func (h *TablesHandler) FloorPlan(c *gin.Context) {
+	areas, err := h.tableService.GetFloorPlan(c.Request.Context())
+
+	if err != nil {
+		abortWithTableError(c, err)
+		return
+	}
+
+	if areas == nil {
+		areas = []domain.FloorArea{}
+	}
+
+	c.JSON(http.StatusOK, areas)
+}
+
+func (h *TablesHandler) Seat(c *gin.Context) {
+	tableID, err := strconv.Atoi(c.Param("id"))
+
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	var body domain.SeatTable
+
+	if err := bindJSON(c, &body); err != nil {
+		return
+	}
+
+	session, err := h.tableService.SeatTable(c.Request.Context(), uint(tableID), body)
+
+	if err != nil {
+		abortWithTableError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, session)
+}
+
+func (h *TablesHandler) CurrentSession(c *gin.Context) {
+	tableID, err := strconv.Atoi(c.Param("id"))
+
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	session, err := h.tableService.FindCurrentSession(c.Request.Context(), uint(tableID))
+
+	if err != nil {
+		abortWithTableError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, session)
+}
+
+func (h *TablesHandler) CloseSession(c *gin.Context) {
+	tableID, err := strconv.Atoi(c.Param("id"))
+
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	session, err := h.tableService.CloseSession(c.Request.Context(), uint(tableID))
+
+	if err != nil {
+		abortWithTableError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, session)
+}
+
+func (h *TablesHandler) FindSession(c *gin.Context) {
+	sessionID, err := strconv.Atoi(c.Param("session_id"))
+
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	session, err := h.tableService.FindSession(c.Request.Context(), uint(sessionID))
+
+	if err != nil {
+		abortWithTableError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, session)
+}
+
+func abortWithTableError(c *gin.Context, err error) {
+	status := unexpectedErrorStatus(err)
+
+	// Tables without an open session have no current session to show or close
+	if errors.Is(err, domain.ErrTableNotFound) ||
+		errors.Is(err, domain.ErrTableSessionNotFound) ||
+		errors.Is(err, domain.ErrTableNotSeated) {
+		status = http.StatusNotFound
+	}
+
+	if errors.Is(err, domain.ErrDuplicateTable) ||
+		errors.Is(err, domain.ErrTableOccupied) ||
+		errors.Is(err, domain.ErrTableSessionInProgress) {
+		status = http.StatusConflict
+	}
+
+	c.AbortWithStatus(status)
+}
@@ -0,0 +1,172 @@
+package gin_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
+	internalGin "github.com/danbrato999/yuno-gveloz/internal/gin"
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+const tablesAPIUri = "/api/v1/tables"
+
+var _ = Describe("TablesHandler", func() {
+	var (
+		mockTableService *mocks.MockTableService
+		router           *gin.Engine
+		recorder         *httptest.ResponseRecorder
+		table            *domain.Table
+		session          *domain.TableSessionView
+	)
+
+	BeforeEach(func() {
+		mockTableService = mocks.NewMockTableService(gomock.NewController(GinkgoT()))
+		recorder = httptest.NewRecorder()
+		router = internalGin.GetServer(internalGin.Services{Tables: mockTableService})
+		table = &domain.Table{ID: 1, NewTable: domain.NewTable{Name: "T1", Area: "terrace", Seats: 4}}
+
+		view := domain.NewTableSessionView(*table, domain.TableSession{
+			ID:      7,
+			TableID: 1,
+			Covers:  3,
+			Status:  domain.TableSessionStatusOpen,
+		}, []domain.Order{{ID: 1, Status: domain.OrderStatusReady}})
+		session = &view
+	})
+
+	Describe("Create Table", func() {
+		It("should return 201 Created", func() {
+			mockTableService.EXPECT().CreateTable(gomock.Any(), table.NewTable).Return(table, nil)
+
+			body, _ := json.Marshal(table.NewTable)
+			req, _ := http.NewRequest(http.MethodPost, tablesAPIUri, bytes.NewBuffer(body))
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusCreated))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"area":"terrace"`))
+		})
+
+		DescribeTable("request is incorrect", func(request domain.NewTable) {
+			body, _ := json.Marshal(request)
+			req, _ := http.NewRequest(http.MethodPost, tablesAPIUri, bytes.NewBuffer(body))
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		},
+			Entry("when no name is provided", domain.NewTable{Seats: 4}),
+			Entry("when no seats are provided", domain.NewTable{Name: "T1"}),
+		)
+
+		It("should return 409 Conflict when the name is taken", func() {
+			mockTableService.EXPECT().CreateTable(gomock.Any(), gomock.Any()).Return(nil, domain.ErrDuplicateTable)
+
+			body, _ := json.Marshal(table.NewTable)
+			req, _ := http.NewRequest(http.MethodPost, tablesAPIUri, bytes.NewBuffer(body))
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusConflict))
+		})
+	})
+
+	Describe("Floor Plan", func() {
+		It("should return the areas with the seated tables", func() {
+			mockTableService.EXPECT().GetFloorPlan(gomock.Any()).Return([]domain.FloorArea{
+				{Name: "terrace", Tables: []domain.FloorPlanTable{{Table: *table, Session: session}}},
+			}, nil)
+
+			req, _ := http.NewRequest(http.MethodGet, tablesAPIUri+"/floor-plan", nil)
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"food_status":"ready"`))
+		})
+	})
+
+	Describe("Seat Table", func() {
+		It("should return 201 Created with the new session", func() {
+			mockTableService.EXPECT().SeatTable(gomock.Any(), uint(1), domain.SeatTable{Covers: 3}).Return(session, nil)
+
+			req, _ := http.NewRequest(http.MethodPost, tablesAPIUri+"/1/session", bytes.NewBufferString(`{"covers": 3}`))
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusCreated))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"covers":3`))
+		})
+
+		It("should return 400 Bad Request without covers", func() {
+			req, _ := http.NewRequest(http.MethodPost, tablesAPIUri+"/1/session", bytes.NewBufferString(`{}`))
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return 409 Conflict when the table is occupied", func() {
+			mockTableService.EXPECT().SeatTable(gomock.Any(), uint(1), gomock.Any()).Return(nil, domain.ErrTableOccupied)
+
+			req, _ := http.NewRequest(http.MethodPost, tablesAPIUri+"/1/session", bytes.NewBufferString(`{"covers": 2}`))
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusConflict))
+		})
+	})
+
+	Describe("Current Session", func() {
+		It("should return the aggregated session", func() {
+			mockTableService.EXPECT().FindCurrentSession(gomock.Any(), uint(1)).Return(session, nil)
+
+			req, _ := http.NewRequest(http.MethodGet, tablesAPIUri+"/1/session", nil)
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"order_statuses":{"ready":1}`))
+		})
+
+		It("should return 404 Not Found when nobody is seated", func() {
+			mockTableService.EXPECT().FindCurrentSession(gomock.Any(), uint(1)).Return(nil, domain.ErrTableNotSeated)
+
+			req, _ := http.NewRequest(http.MethodGet, tablesAPIUri+"/1/session", nil)
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Describe("Close Session", func() {
+		It("should return 409 Conflict while orders are in progress", func() {
+			mockTableService.EXPECT().CloseSession(gomock.Any(), uint(1)).Return(nil, domain.ErrTableSessionInProgress)
+
+			req, _ := http.NewRequest(http.MethodPost, tablesAPIUri+"/1/session/close", nil)
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusConflict))
+		})
+	})
+
+	Describe("Find Session", func() {
+		It("should return 404 Not Found for unknown sessions", func() {
+			mockTableService.EXPECT().FindSession(gomock.Any(), uint(99)).Return(nil, domain.ErrTableSessionNotFound)
+
+			req, _ := http.NewRequest(http.MethodGet, tablesAPIUri+"/sessions/99", nil)
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("should return 500 Internal Server Error when the service fails", func() {
+			mockTableService.EXPECT().FindSession(gomock.Any(), uint(7)).Return(nil, errors.New("db error"))
+
+			req, _ := http.NewRequest(http.MethodGet, tablesAPIUri+"/sessions/7", nil)
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+})
@@ -0,0 +1,116 @@
+package gin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const defaultTimeoutKey = "default"
+
+// RequestTimeouts bounds how long a request can take, overriding the default for the routes in
+// Routes, keyed by their gin path. A zero timeout leaves the requests unbounded
+type RequestTimeouts struct {
+	Default time.Duration
+	Routes  map[string]time.Duration
+}
+
+func DefaultRequestTimeouts() RequestTimeouts {
+	return RequestTimeouts{
+		Default: 30 * time.Second,
+		Routes: map[string]time.Duration{
+			"/api/v1/events":        0,
+			"/api/v1/orders/import": 5 * time.Minute,
+			"/api/v1/orders/export": 5 * time.Minute,
+		},
+	}
+}
+
+func (t RequestTimeouts) For(route string) time.Duration {
+	if timeout, ok := t.Routes[route]; ok {
+		return timeout
+	}
+
+	return t.Default
+}
+
+// Override returns a copy of the timeouts with the ones of a comma separated list of
+// route=duration or default=duration entries, e.g. "default=10s,/api/v1/queue=2s"
+func (t RequestTimeouts) Override(timeouts string) (RequestTimeouts, error) {
+	result := RequestTimeouts{
+		Default: t.Default,
+		Routes:  make(map[string]time.Duration, len(t.Routes)),
+	}
+
+	maps.Copy(result.Routes, t.Routes)
+
+	for _, entry := range strings.Split(timeouts, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		route, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return result, fmt.Errorf("invalid request timeout %q", entry)
+		}
+
+		timeout, err := time.ParseDuration(value)
+		if err != nil {
+			return result, fmt.Errorf("invalid request timeout %q: %w", entry, err)
+		}
+
+		if timeout < 0 {
+			return result, fmt.Errorf("invalid request timeout %q: negative duration", entry)
+		}
+
+		switch {
+		case route == defaultTimeoutKey:
+			result.Default = timeout
+		case strings.HasPrefix(route, "/"):
+			result.Routes[route] = timeout
+		default:
+			return result, fmt.Errorf("invalid request timeout %q: routes must start with /", entry)
+		}
+	}
+
+	return result, nil
+}
+
+// timeoutMiddleware sets the deadline of the request context, which the handlers hand to the
+// services. Streams are meant to stay open, so they are never bounded
+func timeoutMiddleware(timeouts RequestTimeouts) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		timeout := timeouts.For(c.FullPath())
+		if timeout <= 0 || strings.Contains(c.GetHeader("Accept"), "text/event-stream") {
+			c.Next()
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
+// Requests that ran out of time answer with 504, the ones whose body was cut by its size limit
+// with 413 and any other unexpected error with 500
+func unexpectedErrorStatus(err error) int {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return http.StatusGatewayTimeout
+	}
+
+	if isBodyTooLarge(err) {
+		return http.StatusRequestEntityTooLarge
+	}
+
+	return http.StatusInternalServerError
+}
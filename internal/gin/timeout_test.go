@@ -0,0 +1,115 @@
+package gin_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
+	internalGin "github.com/danbrato999/yuno-gveloz/internal/gin"
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("RequestTimeouts", func() {
+	Describe("Override", func() {
+		It("should override the default and route timeouts", func() {
+			timeouts, err := internalGin.DefaultRequestTimeouts().Override("default=5s, /api/v1/queue=1s")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(timeouts.For("/api/v1/orders")).To(Equal(5 * time.Second))
+			Expect(timeouts.For("/api/v1/queue")).To(Equal(time.Second))
+			Expect(timeouts.For("/api/v1/orders/export")).To(Equal(5 * time.Minute))
+			Expect(timeouts.For("/api/v1/events")).To(BeZero())
+		})
+
+		It("should not change the original timeouts", func() {
+			original := internalGin.DefaultRequestTimeouts()
+
+			_, err := original.Override("/api/v1/events=1s")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(original.For("/api/v1/events")).To(BeZero())
+		})
+
+		DescribeTable("should reject invalid entries",
+			func(timeouts string) {
+				_, err := internalGin.DefaultRequestTimeouts().Override(timeouts)
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("without a duration", "default"),
+			Entry("with an invalid duration", "default=soon"),
+			Entry("with a negative duration", "/api/v1/queue=-1s"),
+			Entry("with a relative route", "api/v1/queue=1s"),
+		)
+	})
+
+	Describe("middleware", func() {
+		var (
+			mockOrderService *mocks.MockOrderService
+			router           *gin.Engine
+		)
+
+		BeforeEach(func() {
+			mockOrderService = mocks.NewMockOrderService(gomock.NewController(GinkgoT()))
+			router = internalGin.GetServer(internalGin.Services{
+				Orders: mockOrderService,
+				Timeouts: internalGin.RequestTimeouts{
+					Default: 20 * time.Millisecond,
+					Routes:  map[string]time.Duration{"/api/v1/orders/:id": 0},
+				},
+			})
+		})
+
+		It("should answer 504 when the services run out of time", func() {
+			mockOrderService.EXPECT().FindMany(gomock.Any()).DoAndReturn(func(ctx context.Context, _ ...domain.OrderFilterFn) ([]domain.Order, error) {
+				_, hasDeadline := ctx.Deadline()
+				Expect(hasDeadline).To(BeTrue())
+
+				<-ctx.Done()
+				return nil, ctx.Err()
+			})
+
+			recorder := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/api/v1/orders", nil)
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusGatewayTimeout))
+		})
+
+		It("should not bound the routes without a timeout", func() {
+			mockOrderService.EXPECT().FindByID(gomock.Any(), uint(1)).DoAndReturn(func(ctx context.Context, id uint, _ ...domain.OrderFilterFn) (*domain.OrderWithStatusHistory, error) {
+				_, hasDeadline := ctx.Deadline()
+				Expect(hasDeadline).To(BeFalse())
+
+				return &domain.OrderWithStatusHistory{Order: domain.Order{ID: id}}, nil
+			})
+
+			recorder := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/api/v1/orders/1", nil)
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+		})
+
+		It("should not bound streams", func() {
+			mockOrderService.EXPECT().FindMany(gomock.Any()).DoAndReturn(func(ctx context.Context, _ ...domain.OrderFilterFn) ([]domain.Order, error) {
+				_, hasDeadline := ctx.Deadline()
+				Expect(hasDeadline).To(BeFalse())
+
+				return []domain.Order{}, nil
+			})
+
+			recorder := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/api/v1/orders", nil)
+			req.Header.Set("Accept", "text/event-stream")
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+		})
+	})
+})
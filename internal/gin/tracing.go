@@ -0,0 +1,22 @@
+package gin
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("github.com/danbrato999/yuno-gveloz/internal/gin")
+
+// bindJSON traces the decoding and validation of the body apart from the rest of the request
+func bindJSON(c *gin.Context, obj any) error {
+	_, span := tracer.Start(c.Request.Context(), "gin.BindJSON")
+	defer span.End()
+
+	err := c.ShouldBindJSON(obj)
+	if err != nil {
+		span.RecordError(err)
+		_ = c.AbortWithError(bodyErrorStatus(err), err).SetType(gin.ErrorTypeBind)
+	}
+
+	return err
+}
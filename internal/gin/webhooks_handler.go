@@ -0,0 +1,108 @@
+package gin
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/gin-gonic/gin"
+)
+
+type WebhooksHandler struct {
+	webhookService services.WebhookService
+}
+
+func NewWebhooksHandler(webhookService services.WebhookService) *WebhooksHandler {
+	return &WebhooksHandler{
+		webhookService: webhookService,
+	}
+}
+
+func (h *WebhooksHandler) Create(c *gin.Context) {
+	var body domain.NewWebhook
+
+	if err := bindJSON(c, &body); err != nil {
+		return
+	}
+
+	webhook, err := h.webhookService.CreateWebhook(c.Request.Context(), body)
+
+	if err != nil {
+		abortWithWebhookError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, webhook)
+}
+
+func (h *WebhooksHandler) List(c *gin.Context) {
+	webhooks, err := h.webhookService.FindMany(c.Request.Context())
+
+	if err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.JSON(http.StatusOK, webhooks)
+}
+
+func (h *WebhooksHandler) Find(c *gin.Context) {
+	webhookID, err := strconv.Atoi(c.Param("id"))
+
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	webhook, err := h.webhookService.FindByID(c.Request.Context(), uint(webhookID))
+
+	if err != nil {
+		abortWithWebhookError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, webhook)
+}
+
+func (h *WebhooksHandler) Delete(c *gin.Context) {
+	webhookID, err := strconv.Atoi(c.Param("id"))
+
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	if err := h.webhookService.DeleteWebhook(c.Request.Context(), uint(webhookID)); err != nil {
+		abortWithWebhookError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *WebhooksHandler) ListDeadLetters(c *gin.Context) {
+	deadLetters, err := h.webhookService.GetDeadLetters(c.Request.Context())
+
+	if err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.JSON(http.StatusOK, deadLetters)
+}
+
+func abortWithWebhookError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+
+	if errors.Is(err, domain.ErrWebhookNotFound) {
+		status = http.StatusNotFound
+	}
+
+	if errors.Is(err, domain.ErrInvalidWebhook) {
+		status = http.StatusBadRequest
+	}
+
+	c.AbortWithStatus(status)
+}
@@ -0,0 +1,147 @@
+package gin_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
+	internalGin "github.com/danbrato999/yuno-gveloz/internal/gin"
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+const webhooksAPIUri = "/api/v1/webhooks"
+
+var _ = Describe("WebhooksHandler", func() {
+	var (
+		mockWebhookService *mocks.MockWebhookService
+		router             *gin.Engine
+		recorder           *httptest.ResponseRecorder
+		newWebhook         domain.NewWebhook
+	)
+
+	BeforeEach(func() {
+		mockWebhookService = mocks.NewMockWebhookService(gomock.NewController(GinkgoT()))
+		recorder = httptest.NewRecorder()
+		router = internalGin.GetServer(internalGin.Services{
+			Webhooks:  mockWebhookService,
+			StaffKeys: domain.StaffKeys{"k1tch3n": domain.StaffRoleCook, "fl00r": domain.StaffRoleManager},
+		})
+		newWebhook = domain.NewWebhook{
+			URL:        "https://partner.example.com/hooks",
+			Secret:     "s3cr3t",
+			EventTypes: []domain.OrderEventType{domain.OrderEventCreated},
+		}
+	})
+
+	webhooksRequest := func(method string, url string, body io.Reader) *http.Request {
+		req, _ := http.NewRequest(method, url, body)
+		req.Header.Set(internalGin.APIKeyHeader, "fl00r")
+		return req
+	}
+
+	DescribeTable("webhooks are managed by the managers only", func(key string, status int) {
+		req, _ := http.NewRequest(http.MethodGet, webhooksAPIUri, nil)
+		if key != "" {
+			req.Header.Set(internalGin.APIKeyHeader, key)
+		}
+		router.ServeHTTP(recorder, req)
+
+		Expect(recorder.Code).To(Equal(status))
+	},
+		Entry("without a staff key", "", http.StatusUnauthorized),
+		Entry("with an unknown key", "wh4t3v3r", http.StatusUnauthorized),
+		Entry("with a cook's key", "k1tch3n", http.StatusForbidden),
+	)
+
+	Describe("Create Webhook", func() {
+		It("should return 201 Created", func() {
+			mockWebhookService.EXPECT().CreateWebhook(gomock.Any(), newWebhook).Return(&domain.Webhook{
+				ID:         1,
+				NewWebhook: domain.NewWebhook{URL: newWebhook.URL, EventTypes: newWebhook.EventTypes},
+			}, nil)
+
+			body, _ := json.Marshal(newWebhook)
+			req := webhooksRequest(http.MethodPost, webhooksAPIUri, bytes.NewBuffer(body))
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusCreated))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"event_types":["order.created"]`))
+			Expect(recorder.Body.String()).ToNot(ContainSubstring("secret"))
+		})
+
+		DescribeTable("request is incorrect", func(request domain.NewWebhook) {
+			body, _ := json.Marshal(request)
+			req := webhooksRequest(http.MethodPost, webhooksAPIUri, bytes.NewBuffer(body))
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		},
+			Entry("when no url is provided", domain.NewWebhook{Secret: "s", EventTypes: []domain.OrderEventType{domain.OrderEventCreated}}),
+			Entry("when no secret is provided", domain.NewWebhook{URL: "https://a.com", EventTypes: []domain.OrderEventType{domain.OrderEventCreated}}),
+			Entry("when no event types are provided", domain.NewWebhook{URL: "https://a.com", Secret: "s"}),
+		)
+
+		It("should return 400 Bad Request when the service rejects it", func() {
+			mockWebhookService.EXPECT().CreateWebhook(gomock.Any(), gomock.Any()).Return(nil, domain.ErrInvalidWebhook)
+
+			body, _ := json.Marshal(newWebhook)
+			req := webhooksRequest(http.MethodPost, webhooksAPIUri, bytes.NewBuffer(body))
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("Find Webhook", func() {
+		It("should return 404 Not Found", func() {
+			mockWebhookService.EXPECT().FindByID(gomock.Any(), uint(1)).Return(nil, domain.ErrWebhookNotFound)
+
+			req := webhooksRequest(http.MethodGet, webhooksAPIUri+"/1", nil)
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Describe("Delete Webhook", func() {
+		It("should return 204 No Content", func() {
+			mockWebhookService.EXPECT().DeleteWebhook(gomock.Any(), uint(1)).Return(nil)
+
+			req := webhooksRequest(http.MethodDelete, webhooksAPIUri+"/1", nil)
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusNoContent))
+		})
+	})
+
+	Describe("List Dead Letters", func() {
+		It("should return 200 OK", func() {
+			mockWebhookService.EXPECT().GetDeadLetters(gomock.Any()).Return([]domain.WebhookDeadLetter{
+				{ID: 1, WebhookID: 1, EventType: domain.OrderEventCreated, Attempts: 5},
+			}, nil)
+
+			req := webhooksRequest(http.MethodGet, webhooksAPIUri+"/dead-letters", nil)
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"attempts":5`))
+		})
+
+		It("should return 500 Internal Server Error", func() {
+			mockWebhookService.EXPECT().GetDeadLetters(gomock.Any()).Return(nil, errors.New("error"))
+
+			req := webhooksRequest(http.MethodGet, webhooksAPIUri+"/dead-letters", nil)
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+})
@@ -0,0 +1,57 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"gorm.io/gorm"
+)
+
+func NewDatabaseCheck(db *gorm.DB) services.ReadinessCheck {
+	return services.ReadinessCheck{
+		Name: "database",
+		Check: func(ctx context.Context) error {
+			sqlDB, err := db.DB()
+			if err != nil {
+				return err
+			}
+
+			return sqlDB.PingContext(ctx)
+		},
+	}
+}
+
+// NewMigrationsCheck verifies every table and column of the models exists, which stops being
+// true when the database file is replaced by an older one, e.g. while restoring a backup
+func NewMigrationsCheck(db *gorm.DB) services.ReadinessCheck {
+	return services.ReadinessCheck{
+		Name: "migrations",
+		Check: func(ctx context.Context) error {
+			return verifyMigrations(db.WithContext(ctx))
+		},
+	}
+}
+
+func verifyMigrations(db *gorm.DB) error {
+	migrator := db.Migrator()
+
+	for _, model := range migratedModels {
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(model); err != nil {
+			return err
+		}
+
+		if !migrator.HasTable(model) {
+			return fmt.Errorf("missing table %s", stmt.Schema.Table)
+		}
+
+		for _, field := range stmt.Schema.Fields {
+			if field.DBName != "" && !migrator.HasColumn(model, field.DBName) {
+				return fmt.Errorf("missing column %s.%s", stmt.Schema.Table, field.DBName)
+			}
+		}
+	}
+
+	return nil
+}
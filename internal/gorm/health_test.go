@@ -0,0 +1,55 @@
+package gorm_test
+
+import (
+	"context"
+	"path/filepath"
+
+	dbAdapter "github.com/danbrato999/yuno-gveloz/internal/gorm"
+	"github.com/danbrato999/yuno-gveloz/internal/gorm/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+var _ = Describe("Readiness checks", func() {
+	var testDB *gorm.DB
+
+	BeforeEach(func() {
+		var err error
+		testDB, err = dbAdapter.OpenDB(filepath.Join(GinkgoT().TempDir(), "main.db"), logger.Default.LogMode(logger.Silent))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("database", func() {
+		It("should pass while the database answers", func() {
+			Expect(dbAdapter.NewDatabaseCheck(testDB).Check(context.Background())).To(Succeed())
+		})
+
+		It("should fail once the connection is closed", func() {
+			sqlDB, err := testDB.DB()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sqlDB.Close()).To(Succeed())
+
+			Expect(dbAdapter.NewDatabaseCheck(testDB).Check(context.Background())).ToNot(Succeed())
+		})
+	})
+
+	Describe("migrations", func() {
+		It("should pass on a migrated database", func() {
+			Expect(dbAdapter.NewMigrationsCheck(testDB).Check(context.Background())).To(Succeed())
+		})
+
+		It("should report the missing tables", func() {
+			Expect(testDB.Migrator().DropTable(&models.WebhookDeadLetter{})).To(Succeed())
+
+			Expect(dbAdapter.NewMigrationsCheck(testDB).Check(context.Background())).To(MatchError("missing table webhook_dead_letters"))
+		})
+
+		It("should report the missing columns", func() {
+			Expect(testDB.Migrator().DropColumn(&models.Order{}, "late_at")).To(Succeed())
+
+			Expect(dbAdapter.NewMigrationsCheck(testDB).Check(context.Background())).To(MatchError("missing column orders.late_at"))
+		})
+	})
+})
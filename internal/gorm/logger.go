@@ -0,0 +1,76 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type slogLogger struct {
+	logger        *slog.Logger
+	level         logger.LogLevel
+	slowThreshold time.Duration
+}
+
+// NewLogger writes the statements as debug lines, warning about the ones slower than
+// slowThreshold, so every query is only printed when debugging. Missing records are not failures
+func NewLogger(l *slog.Logger, slowThreshold time.Duration) logger.Interface {
+	return &slogLogger{
+		logger:        l,
+		level:         logger.Info,
+		slowThreshold: slowThreshold,
+	}
+}
+
+func (l *slogLogger) LogMode(level logger.LogLevel) logger.Interface {
+	result := *l
+	result.level = level
+	return &result
+}
+
+func (l *slogLogger) Info(ctx context.Context, msg string, args ...interface{}) {
+	if l.level >= logger.Info {
+		l.logger.InfoContext(ctx, fmt.Sprintf(msg, args...))
+	}
+}
+
+func (l *slogLogger) Warn(ctx context.Context, msg string, args ...interface{}) {
+	if l.level >= logger.Warn {
+		l.logger.WarnContext(ctx, fmt.Sprintf(msg, args...))
+	}
+}
+
+func (l *slogLogger) Error(ctx context.Context, msg string, args ...interface{}) {
+	if l.level >= logger.Error {
+		l.logger.ErrorContext(ctx, fmt.Sprintf(msg, args...))
+	}
+}
+
+func (l *slogLogger) Trace(ctx context.Context, begin time.Time, fc func() (sql string, rowsAffected int64), err error) {
+	elapsed := time.Since(begin)
+
+	switch {
+	case err != nil && !errors.Is(err, gorm.ErrRecordNotFound) && l.level >= logger.Error:
+		sql, rows := fc()
+		l.logger.ErrorContext(ctx, "query failed", queryAttrs(sql, rows, elapsed, slog.Any("error", err))...)
+	case l.slowThreshold > 0 && elapsed > l.slowThreshold && l.level >= logger.Warn:
+		sql, rows := fc()
+		l.logger.WarnContext(ctx, "slow query", queryAttrs(sql, rows, elapsed, durationAttr("threshold_ms", l.slowThreshold))...)
+	case l.level >= logger.Info && l.logger.Enabled(ctx, slog.LevelDebug):
+		sql, rows := fc()
+		l.logger.DebugContext(ctx, "query", queryAttrs(sql, rows, elapsed)...)
+	}
+}
+
+func queryAttrs(sql string, rows int64, elapsed time.Duration, extra ...any) []any {
+	return append([]any{slog.String("sql", sql), slog.Int64("rows", rows), durationAttr("duration_ms", elapsed)}, extra...)
+}
+
+func durationAttr(key string, duration time.Duration) slog.Attr {
+	return slog.Float64(key, float64(duration)/float64(time.Millisecond))
+}
@@ -0,0 +1,68 @@
+package gorm_test
+
+import (
+	"bytes"
+	"log/slog"
+	"time"
+
+	dbAdapter "github.com/danbrato999/yuno-gveloz/internal/gorm"
+	"github.com/danbrato999/yuno-gveloz/internal/gorm/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("Logger", func() {
+	var output *bytes.Buffer
+
+	openDB := func(level slog.Level, slowThreshold time.Duration) *gorm.DB {
+		testDB, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(testDB.AutoMigrate(&models.Customer{})).To(Succeed())
+
+		logger := slog.New(slog.NewTextHandler(output, &slog.HandlerOptions{Level: level}))
+		return testDB.Session(&gorm.Session{Logger: dbAdapter.NewLogger(logger, slowThreshold)})
+	}
+
+	BeforeEach(func() {
+		output = &bytes.Buffer{}
+	})
+
+	It("should only print the queries when debugging", func() {
+		testDB := openDB(slog.LevelInfo, time.Hour)
+		Expect(testDB.Create(&models.Customer{Name: "Jane"}).Error).To(Succeed())
+		Expect(output.String()).To(BeEmpty())
+
+		testDB = openDB(slog.LevelDebug, time.Hour)
+		Expect(testDB.Create(&models.Customer{Name: "Jane"}).Error).To(Succeed())
+		Expect(output.String()).To(ContainSubstring("level=DEBUG msg=query"))
+		Expect(output.String()).To(ContainSubstring("INSERT INTO"))
+		Expect(output.String()).To(ContainSubstring("rows=1"))
+	})
+
+	It("should warn about the slow queries", func() {
+		testDB := openDB(slog.LevelInfo, time.Nanosecond)
+		Expect(testDB.Create(&models.Customer{Name: "Jane"}).Error).To(Succeed())
+
+		Expect(output.String()).To(ContainSubstring(`level=WARN msg="slow query"`))
+		Expect(output.String()).To(ContainSubstring("threshold_ms=1e-06"))
+	})
+
+	It("should log the failed queries", func() {
+		testDB := openDB(slog.LevelInfo, time.Hour)
+		Expect(testDB.Table("missing").Create(map[string]any{"name": "Jane"}).Error).To(HaveOccurred())
+
+		Expect(output.String()).To(ContainSubstring(`level=ERROR msg="query failed"`))
+		Expect(output.String()).To(ContainSubstring("no such table: missing"))
+	})
+
+	It("should not log the missing records as failures", func() {
+		testDB := openDB(slog.LevelInfo, time.Hour)
+
+		var customer models.Customer
+		Expect(testDB.First(&customer, 1).Error).To(MatchError(gorm.ErrRecordNotFound))
+
+		Expect(output.String()).To(BeEmpty())
+	})
+})
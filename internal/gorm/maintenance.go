@@ -0,0 +1,96 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/internal/gorm/stores"
+	"gorm.io/gorm"
+)
+
+const DefaultMaintenanceBatchSize = domain.DefaultArchiveBatchSize
+
+var ErrBackupExists = errors.New("backup file already exists")
+
+type CheckpointResult struct {
+	Busy         bool `json:"busy"`
+	LogFrames    int  `json:"log_frames"`
+	Checkpointed int  `json:"checkpointed_frames"`
+}
+
+// Maintenance groups the operations run on the sqlite database by its administrators
+type Maintenance struct {
+	db        *gorm.DB
+	clock     domain.Clock
+	archive   *stores.OrderArchiveStore
+	batchSize int
+}
+
+func NewMaintenance(db *gorm.DB, clock domain.Clock, batchSize int) *Maintenance {
+	return &Maintenance{
+		db:        db,
+		clock:     clock,
+		archive:   stores.NewOrderArchiveStore(db, clock),
+		batchSize: batchSize,
+	}
+}
+
+// Checkpoint copies the WAL contents into the database file and truncates the WAL
+func (m *Maintenance) Checkpoint() (*CheckpointResult, error) {
+	var (
+		busy   int
+		result CheckpointResult
+	)
+
+	err := m.db.Raw("PRAGMA wal_checkpoint(TRUNCATE)").Row().Scan(&busy, &result.LogFrames, &result.Checkpointed)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Busy = busy != 0
+	return &result, nil
+}
+
+func (m *Maintenance) Vacuum() error {
+	return m.db.Exec("VACUUM").Error
+}
+
+// Backup writes a consistent copy of the database to path while it keeps serving requests
+func (m *Maintenance) Backup(path string) error {
+	if _, err := os.Stat(path); err == nil {
+		return ErrBackupExists
+	}
+
+	return m.db.Exec("VACUUM INTO ?", path).Error
+}
+
+// PurgeCompletedOrders deletes the orders completed more than olderThan ago, returning how many were deleted
+func (m *Maintenance) PurgeCompletedOrders(ctx context.Context, olderThan time.Duration) (int, error) {
+	return m.inBatches(ctx, olderThan, m.archive.Purge)
+}
+
+// ArchiveCompletedOrders moves the orders completed more than olderThan ago into the archive tables
+func (m *Maintenance) ArchiveCompletedOrders(ctx context.Context, olderThan time.Duration) (int, error) {
+	return m.inBatches(ctx, olderThan, m.archive.Archive)
+}
+
+func (m *Maintenance) inBatches(
+	ctx context.Context,
+	olderThan time.Duration,
+	operation func(ctx context.Context, before time.Time, limit int) (int, error),
+) (int, error) {
+	before := m.clock.Now().Add(-olderThan)
+	total := 0
+
+	for {
+		count, err := operation(ctx, before, m.batchSize)
+		total += count
+
+		if err != nil || count < m.batchSize {
+			return total, err
+		}
+	}
+}
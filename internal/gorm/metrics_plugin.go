@@ -0,0 +1,73 @@
+package gorm
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const metricsStartKey = "gveloz:metrics_start"
+
+// QueryMetrics records the duration and failures of the database queries
+type QueryMetrics interface {
+	ObserveQuery(operation, table string, duration time.Duration, err error)
+}
+
+type metricsPlugin struct {
+	metrics QueryMetrics
+}
+
+// NewMetricsPlugin times every gorm operation, to be registered with db.Use. Records that
+// aren't found are not counted as failures
+func NewMetricsPlugin(metrics QueryMetrics) gorm.Plugin {
+	return &metricsPlugin{metrics: metrics}
+}
+
+func (p *metricsPlugin) Name() string {
+	return "gveloz:metrics"
+}
+
+func (p *metricsPlugin) Initialize(db *gorm.DB) error {
+	callbacks := db.Callback()
+
+	return errors.Join(
+		callbacks.Create().Before("*").Register("gveloz:metrics_before_create", p.before),
+		callbacks.Create().After("*").Register("gveloz:metrics_after_create", p.after("create")),
+		callbacks.Query().Before("*").Register("gveloz:metrics_before_query", p.before),
+		callbacks.Query().After("*").Register("gveloz:metrics_after_query", p.after("query")),
+		callbacks.Update().Before("*").Register("gveloz:metrics_before_update", p.before),
+		callbacks.Update().After("*").Register("gveloz:metrics_after_update", p.after("update")),
+		callbacks.Delete().Before("*").Register("gveloz:metrics_before_delete", p.before),
+		callbacks.Delete().After("*").Register("gveloz:metrics_after_delete", p.after("delete")),
+		callbacks.Row().Before("*").Register("gveloz:metrics_before_row", p.before),
+		callbacks.Row().After("*").Register("gveloz:metrics_after_row", p.after("row")),
+		callbacks.Raw().Before("*").Register("gveloz:metrics_before_raw", p.before),
+		callbacks.Raw().After("*").Register("gveloz:metrics_after_raw", p.after("raw")),
+	)
+}
+
+func (p *metricsPlugin) before(db *gorm.DB) {
+	db.InstanceSet(metricsStartKey, time.Now())
+}
+
+func (p *metricsPlugin) after(operation string) func(db *gorm.DB) {
+	return func(db *gorm.DB) {
+		value, ok := db.InstanceGet(metricsStartKey)
+		if !ok {
+			return
+		}
+
+		start, ok := value.(time.Time)
+		if !ok {
+			return
+		}
+
+		err := db.Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = nil
+		}
+
+		p.metrics.ObserveQuery(operation, db.Statement.Table, time.Since(start), err)
+	}
+}
@@ -0,0 +1,73 @@
+package gorm_test
+
+import (
+	"time"
+
+	dbAdapter "github.com/danbrato999/yuno-gveloz/internal/gorm"
+	"github.com/danbrato999/yuno-gveloz/internal/gorm/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type observedQuery struct {
+	operation string
+	table     string
+	failed    bool
+}
+
+type recordingMetrics struct {
+	queries []observedQuery
+}
+
+func (m *recordingMetrics) ObserveQuery(operation, table string, duration time.Duration, err error) {
+	Expect(duration).To(BeNumerically(">=", 0))
+	m.queries = append(m.queries, observedQuery{operation: operation, table: table, failed: err != nil})
+}
+
+var _ = Describe("MetricsPlugin", func() {
+	var (
+		testDB  *gorm.DB
+		metrics *recordingMetrics
+	)
+
+	BeforeEach(func() {
+		var err error
+		testDB, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(testDB.AutoMigrate(&models.Customer{})).To(Succeed())
+
+		metrics = &recordingMetrics{}
+		Expect(testDB.Use(dbAdapter.NewMetricsPlugin(metrics))).To(Succeed())
+	})
+
+	It("should observe every operation with its table", func() {
+		customer := models.Customer{Name: "Ana", Phone: "555"}
+		Expect(testDB.Create(&customer).Error).To(Succeed())
+		Expect(testDB.First(&models.Customer{}, customer.ID).Error).To(Succeed())
+		Expect(testDB.Model(&customer).Update("name", "Ana María").Error).To(Succeed())
+		Expect(testDB.Delete(&customer).Error).To(Succeed())
+
+		Expect(metrics.queries).To(Equal([]observedQuery{
+			{operation: "create", table: "customers"},
+			{operation: "query", table: "customers"},
+			{operation: "update", table: "customers"},
+			{operation: "delete", table: "customers"},
+		}))
+	})
+
+	It("should not count missing records as failures", func() {
+		Expect(testDB.First(&models.Customer{}, 99).Error).To(MatchError(gorm.ErrRecordNotFound))
+
+		Expect(metrics.queries).To(Equal([]observedQuery{{operation: "query", table: "customers"}}))
+	})
+
+	It("should count failed queries", func() {
+		Expect(testDB.Exec("SELECT * FROM missing_table").Error).To(HaveOccurred())
+
+		Expect(metrics.queries).To(HaveLen(1))
+		Expect(metrics.queries[0].operation).To(Equal("raw"))
+		Expect(metrics.queries[0].failed).To(BeTrue())
+	})
+})
@@ -0,0 +1,63 @@
+package models
+
+import (
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+)
+
+// ArchivedOrder keeps the completed orders moved out of the orders table, along with their
+// dishes, delivery and status history. Their ids are kept so they can still be found by id
+type ArchivedOrder struct {
+	ID               uint `gorm:"primaryKey"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	ArchivedAt       time.Time `gorm:"index"`
+	Status           domain.OrderStatus
+	Source           domain.OrderSource
+	Dishes           []ArchivedOrderDish `gorm:"foreignKey:OrderID"`
+	Time             time.Time
+	CustomerID       *uint                  `gorm:"index"`
+	Delivery         *ArchivedOrderDelivery `gorm:"foreignKey:OrderID"`
+	Statuses         []ArchivedOrderStatus  `gorm:"foreignKey:OrderID"`
+	ReadyAt          *time.Time
+	ReleaseAt        *time.Time
+	LateAt           *time.Time
+	LateStatus       domain.OrderStatus
+	ExternalProvider *string            `gorm:"index:idx_archived_orders_external"`
+	ExternalID       *string            `gorm:"index:idx_archived_orders_external"`
+	Cancellation     *OrderCancellation `gorm:"foreignKey:OrderID"`
+	Notes            string
+	Allergies        []domain.Allergy     `gorm:"serializer:json"`
+	Dietary          []domain.DietaryFlag `gorm:"serializer:json"`
+	TableID          *uint
+	TableSessionID   *uint `gorm:"index"`
+}
+
+type ArchivedOrderDish struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	OrderID   uint `gorm:"index"`
+	Name      string
+	Status    domain.DishStatus `gorm:"default:pending"`
+}
+
+type ArchivedOrderDelivery struct {
+	ID                uint `gorm:"primaryKey"`
+	CreatedAt         time.Time
+	OrderID           uint `gorm:"index"`
+	Address           string
+	ContactName       string
+	ContactPhone      string
+	FeeCents          uint
+	CourierName       string
+	CourierPhone      string
+	CourierAssignedAt *time.Time
+}
+
+type ArchivedOrderStatus struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	OrderID   uint `gorm:"index"`
+	Status    domain.OrderStatus
+}
@@ -0,0 +1,15 @@
+package models
+
+import (
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"gorm.io/gorm"
+)
+
+type Customer struct {
+	gorm.Model
+	Name        string
+	Phone       string `gorm:"index"`
+	Email       string
+	Notes       string
+	LoyaltyTier domain.LoyaltyTier
+}
@@ -9,8 +9,23 @@ import (
 
 type Order struct {
 	gorm.Model
-	Status domain.OrderStatus
-	Source domain.OrderSource
-	Dishes []OrderDish
-	Time   time.Time
+	Status     domain.OrderStatus
+	Source     domain.OrderSource
+	Dishes     []OrderDish
+	Time       time.Time
+	CustomerID *uint `gorm:"index"`
+	Delivery   *OrderDelivery
+	ReadyAt    *time.Time
+	ReleaseAt  *time.Time `gorm:"index"`
+	LateAt     *time.Time
+	LateStatus domain.OrderStatus
+	// Nullable so the orders created directly don't collide in the index
+	ExternalProvider *string `gorm:"uniqueIndex:idx_orders_external"`
+	ExternalID       *string `gorm:"uniqueIndex:idx_orders_external"`
+	Cancellation     *OrderCancellation
+	Notes            string
+	Allergies        []domain.Allergy     `gorm:"serializer:json"`
+	Dietary          []domain.DietaryFlag `gorm:"serializer:json"`
+	TableID          *uint
+	TableSessionID   *uint `gorm:"index"`
 }
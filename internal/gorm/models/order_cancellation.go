@@ -0,0 +1,26 @@
+package models
+
+import (
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"gorm.io/gorm"
+)
+
+// OrderCancellation is kept when its order is archived, the archived order keeps the same id
+type OrderCancellation struct {
+	gorm.Model
+	OrderID                uint `gorm:"uniqueIndex"`
+	Reason                 domain.CancellationReason
+	Note                   string
+	CancelledBy            domain.StaffRole
+	PreviousStatus         domain.OrderStatus
+	RefundType             domain.RefundType
+	RefundDeliveryFeeCents uint
+	Waste                  []WasteRecord `gorm:"foreignKey:OrderID;references:OrderID"`
+}
+
+type WasteRecord struct {
+	gorm.Model
+	OrderID uint `gorm:"index"`
+	Dish    string
+	Reason  domain.CancellationReason
+}
@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type OrderDelivery struct {
+	gorm.Model
+	OrderID           uint `gorm:"index"`
+	Address           string
+	ContactName       string
+	ContactPhone      string
+	FeeCents          uint
+	CourierName       string
+	CourierPhone      string
+	CourierAssignedAt *time.Time
+}
@@ -1,9 +1,26 @@
 package models
 
-import "gorm.io/gorm"
+import (
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"gorm.io/gorm"
+)
 
 type OrderDish struct {
 	gorm.Model
 	OrderID uint
 	Name    string
+	Status  domain.DishStatus `gorm:"default:pending"`
+}
+
+// OrderDishChange is kept when its order is archived, like the cancellations
+type OrderDishChange struct {
+	gorm.Model
+	OrderID        uint `gorm:"index"`
+	DishID         uint
+	Dish           string
+	Action         domain.DishAction
+	PreviousStatus domain.DishStatus
+	OrderStatus    domain.OrderStatus
+	Reason         domain.CancellationReason
+	Note           string
 }
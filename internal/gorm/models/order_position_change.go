@@ -0,0 +1,9 @@
+package models
+
+import "gorm.io/gorm"
+
+type OrderPositionChange struct {
+	gorm.Model
+	OrderID  uint `gorm:"index"`
+	Position uint
+}
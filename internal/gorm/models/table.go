@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"gorm.io/gorm"
+)
+
+type Table struct {
+	gorm.Model
+	Name  string `gorm:"index"`
+	Area  string
+	Seats uint
+}
+
+type TableSession struct {
+	gorm.Model
+	TableID  uint `gorm:"index"`
+	Covers   uint
+	Status   domain.TableSessionStatus
+	OpenedAt time.Time
+	ClosedAt *time.Time
+}
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"gorm.io/gorm"
+)
+
+type Webhook struct {
+	gorm.Model
+	URL    string
+	Secret string
+	// Comma separated list of the subscribed event types
+	EventTypes string
+}
+
+type WebhookDeadLetter struct {
+	gorm.Model
+	WebhookID uint `gorm:"index"`
+	EventType domain.OrderEventType
+	Payload   string
+	Attempts  int
+	LastError string
+	FailedAt  time.Time
+}
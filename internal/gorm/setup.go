@@ -3,8 +3,10 @@ package gorm
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"time"
 
+	"github.com/danbrato999/yuno-gveloz/domain"
 	"github.com/danbrato999/yuno-gveloz/domain/services"
 	"github.com/danbrato999/yuno-gveloz/internal/gorm/models"
 	"github.com/danbrato999/yuno-gveloz/internal/gorm/stores"
@@ -15,30 +17,59 @@ import (
 
 const DbFolder = "data"
 
-func migrate(db *gorm.DB) error {
-	return db.AutoMigrate(
-		&models.Order{},
-		&models.OrderDish{},
-		&models.OrderPosition{},
-		&models.OrderStatus{},
-	)
+var migratedModels = []any{
+	&models.Order{},
+	&models.OrderDish{},
+	&models.OrderDelivery{},
+	&models.OrderPosition{},
+	&models.OrderPositionChange{},
+	&models.OrderStatus{},
+	&models.Customer{},
+	&models.Webhook{},
+	&models.WebhookDeadLetter{},
+	&models.ArchivedOrder{},
+	&models.ArchivedOrderDish{},
+	&models.ArchivedOrderDelivery{},
+	&models.ArchivedOrderStatus{},
+	&models.OrderCancellation{},
+	&models.WasteRecord{},
+	&models.OrderDishChange{},
+	&models.Table{},
+	&models.TableSession{},
 }
 
-func GetDBConnection(dbName string) (*gorm.DB, error) {
-	if err := os.MkdirAll(DbFolder, os.ModePerm); err != nil {
+func Migrate(db *gorm.DB) error {
+	return db.AutoMigrate(migratedModels...)
+}
+
+func GetDBConnection(dbName string, dbLogger logger.Interface) (*gorm.DB, error) {
+	return OpenDB(DBPath(dbName), dbLogger)
+}
+
+func DBPath(dbName string) string {
+	return filepath.Join(DbFolder, dbName+".db")
+}
+
+// OpenDB connects to the sqlite database at the given path, creating and migrating it if needed
+func OpenDB(path string, dbLogger logger.Interface) (*gorm.DB, error) {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
 		return nil, fmt.Errorf("error creating db folder: %w", err)
 	}
 
-	dbFile := fmt.Sprintf("%s/%s.db?_journal_mode=WAL", DbFolder, dbName)
+	// Other processes, like gveloz-admin and the queue commands, write to the same file as the server,
+	// so writers wait up to 5s for each other instead of failing. Transactions take the write lock
+	// upfront, as sqlite fails at once when upgrading the read lock of one. Read-only transactions
+	// queue behind the writers too, plain reads don't
+	dbFile := fmt.Sprintf("%s?_journal_mode=WAL&_busy_timeout=5000&_txlock=immediate", path)
 
 	db, err := gorm.Open(sqlite.Open(dbFile), &gorm.Config{
-		Logger: logger.Default.LogMode(logger.Info),
+		Logger: dbLogger,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect database: %w", err)
 	}
 
-	if err = migrate(db); err != nil {
+	if err = Migrate(db); err != nil {
 		return nil, fmt.Errorf("failed to migrate database: %w", err)
 	}
 
@@ -55,14 +86,34 @@ func GetDBConnection(dbName string) (*gorm.DB, error) {
 	return db, nil
 }
 
-func NewOrderStore(db *gorm.DB) services.OrderStore {
-	return stores.NewOrderStore(db)
+func NewOrderStore(db *gorm.DB, clock domain.Clock) services.OrderStore {
+	return stores.NewOrderStore(db, clock)
+}
+
+func NewOrderPriorityStore(db *gorm.DB, clock domain.Clock) services.PriorityQueue {
+	return stores.NewOrderPositionStore(db, clock)
+}
+
+func NewOrderStatusStore(db *gorm.DB, clock domain.Clock) services.OrderStatusStore {
+	return stores.NewOrderStatusStore(db, clock)
+}
+
+func NewQueueIntegrityChecker(db *gorm.DB, clock domain.Clock) services.QueueIntegrityChecker {
+	return stores.NewOrderPositionStore(db, clock)
+}
+
+func NewCustomerStore(db *gorm.DB) services.CustomerStore {
+	return stores.NewCustomerStore(db)
+}
+
+func NewWebhookStore(db *gorm.DB) services.WebhookStore {
+	return stores.NewWebhookStore(db)
 }
 
-func NewOrderPriorityStore(db *gorm.DB) services.PriorityQueue {
-	return stores.NewOrderPositionStore(db)
+func NewOrderArchiveStore(db *gorm.DB, clock domain.Clock) services.OrderArchiveStore {
+	return stores.NewOrderArchiveStore(db, clock)
 }
 
-func NewOrderStatusStore(db *gorm.DB) services.OrderStatusStore {
-	return stores.NewOrderStatusStore(db)
+func NewTableStore(db *gorm.DB) services.TableStore {
+	return stores.NewTableStore(db)
 }
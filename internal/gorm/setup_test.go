@@ -0,0 +1,90 @@
+package gorm_test
+
+import (
+	"path/filepath"
+	"time"
+
+	dbAdapter "github.com/danbrato999/yuno-gveloz/internal/gorm"
+	"github.com/danbrato999/yuno-gveloz/internal/gorm/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+var _ = Describe("OpenDB", func() {
+	var (
+		dbPath string
+		server *gorm.DB
+	)
+
+	silent := logger.Default.LogMode(logger.Silent)
+
+	BeforeEach(func() {
+		var err error
+		dbPath = filepath.Join(GinkgoT().TempDir(), "main.db")
+		server, err = dbAdapter.OpenDB(dbPath, silent)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	// Holds the write lock like a long request of the server, while another process writes
+	holdWriteLock := func() chan error {
+		locked := make(chan struct{})
+		done := make(chan error, 1)
+
+		go func() {
+			defer GinkgoRecover()
+
+			done <- server.Transaction(func(tx *gorm.DB) error {
+				if err := tx.Create(&models.Table{Name: "T1"}).Error; err != nil {
+					return err
+				}
+
+				close(locked)
+				time.Sleep(200 * time.Millisecond)
+				return nil
+			})
+		}()
+
+		Eventually(locked).Should(BeClosed())
+		return done
+	}
+
+	readThenWrite := func(db *gorm.DB) error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			var count int64
+			if err := tx.Model(&models.Table{}).Count(&count).Error; err != nil {
+				return err
+			}
+
+			return tx.Create(&models.Table{Name: "T2"}).Error
+		})
+	}
+
+	DescribeTable("transactions of other processes reading before they write",
+		func(open func() (*gorm.DB, error), fails bool) {
+			other, err := open()
+			Expect(err).NotTo(HaveOccurred())
+
+			done := holdWriteLock()
+			err = readThenWrite(other)
+
+			Expect(<-done).To(Succeed())
+			if fails {
+				Expect(err).To(MatchError(ContainSubstring("database is locked")))
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+			}
+		},
+		Entry("wait for the write lock", func() (*gorm.DB, error) {
+			return dbAdapter.OpenDB(dbPath, silent)
+		}, false),
+		Entry("fail at once without a busy timeout", func() (*gorm.DB, error) {
+			return gorm.Open(sqlite.Open(dbPath+"?_journal_mode=WAL"), &gorm.Config{Logger: silent})
+		}, true),
+		Entry("fail when upgrading their read lock, even with a busy timeout", func() (*gorm.DB, error) {
+			return gorm.Open(sqlite.Open(dbPath+"?_journal_mode=WAL&_busy_timeout=5000"), &gorm.Config{Logger: silent})
+		}, true),
+	)
+})
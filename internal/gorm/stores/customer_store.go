@@ -0,0 +1,138 @@
+package stores
+
+import (
+	"context"
+	"errors"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/danbrato999/yuno-gveloz/internal/gorm/models"
+	"gorm.io/gorm"
+)
+
+type customerStore struct {
+	db *gorm.DB
+}
+
+func NewCustomerStore(db *gorm.DB) services.CustomerStore {
+	return &customerStore{
+		db: db,
+	}
+}
+
+func (c *customerStore) Save(ctx context.Context, customer domain.Customer) (_ *domain.Customer, err error) {
+	db, span := startSpan(ctx, c.db, "CustomerStore.Save", customerIDAttribute(customer.ID))
+	defer func() { endSpan(span, err) }()
+
+	dbCustomer := CustomerToDB(customer)
+	query := db
+
+	// Save would otherwise reset the creation time of existing customers
+	if dbCustomer.ID > 0 {
+		query = query.Omit("created_at")
+	}
+
+	if err = query.Save(&dbCustomer).Error; err != nil {
+		return nil, err
+	}
+
+	customer.ID = dbCustomer.ID
+	return &customer, nil
+}
+
+func (c *customerStore) FindByID(ctx context.Context, id uint) (_ *domain.Customer, err error) {
+	db, span := startSpan(ctx, c.db, "CustomerStore.FindByID", customerIDAttribute(id))
+	defer func() { endSpan(span, err) }()
+
+	var customer models.Customer
+
+	err = db.First(&customer, id).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	result := CustomerFromDB(customer)
+	return &result, nil
+}
+
+func (c *customerStore) FindByPhone(ctx context.Context, phone string) (_ *domain.Customer, err error) {
+	db, span := startSpan(ctx, c.db, "CustomerStore.FindByPhone")
+	defer func() { endSpan(span, err) }()
+
+	var customer models.Customer
+
+	err = db.Where("phone = ?", phone).First(&customer).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	result := CustomerFromDB(customer)
+	return &result, nil
+}
+
+func (c *customerStore) GetAll(ctx context.Context) (_ []domain.Customer, err error) {
+	db, span := startSpan(ctx, c.db, "CustomerStore.GetAll")
+	defer func() { endSpan(span, err) }()
+
+	var customers []models.Customer
+
+	if err = db.Order("name").Find(&customers).Error; err != nil {
+		return nil, err
+	}
+
+	results := make([]domain.Customer, len(customers))
+
+	for i, customer := range customers {
+		results[i] = CustomerFromDB(customer)
+	}
+
+	return results, nil
+}
+
+func (c *customerStore) Delete(ctx context.Context, id uint) (err error) {
+	db, span := startSpan(ctx, c.db, "CustomerStore.Delete", customerIDAttribute(id))
+	defer func() { endSpan(span, err) }()
+
+	return db.Delete(&models.Customer{}, id).Error
+}
+
+func CustomerFromDB(customer models.Customer) domain.Customer {
+	return domain.Customer{
+		ID: customer.ID,
+		NewCustomer: domain.NewCustomer{
+			Name:        customer.Name,
+			Phone:       customer.Phone,
+			Email:       customer.Email,
+			Notes:       customer.Notes,
+			LoyaltyTier: customer.LoyaltyTier,
+		},
+	}
+}
+
+func CustomerToDB(customer domain.Customer) models.Customer {
+	dbCustomer := models.Customer{
+		Name:        customer.Name,
+		Phone:       customer.Phone,
+		Email:       customer.Email,
+		Notes:       customer.Notes,
+		LoyaltyTier: customer.LoyaltyTier,
+	}
+
+	if customer.ID > 0 {
+		dbCustomer.Model = gorm.Model{
+			ID: customer.ID,
+		}
+	}
+
+	return dbCustomer
+}
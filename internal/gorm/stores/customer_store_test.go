@@ -0,0 +1,105 @@
+package stores_test
+
+import (
+	"context"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/danbrato999/yuno-gveloz/internal/gorm/models"
+	"github.com/danbrato999/yuno-gveloz/internal/gorm/stores"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("CustomerStore", func() {
+	var (
+		testDB             *gorm.DB
+		existingCustomerID uint
+		store              services.CustomerStore
+	)
+
+	BeforeEach(func() {
+		var err error
+		testDB, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(testDB.AutoMigrate(&models.Customer{})).To(Succeed())
+
+		store = stores.NewCustomerStore(testDB)
+
+		existing := models.Customer{
+			Name:        "Ana",
+			Phone:       "+5491155550000",
+			LoyaltyTier: domain.LoyaltyTierVIP,
+		}
+		Expect(testDB.Save(&existing).Error).NotTo(HaveOccurred())
+
+		existingCustomerID = existing.ID
+	})
+
+	Describe("FindByID", func() {
+		It("returns the customer when it exists", func() {
+			customer, err := store.FindByID(context.Background(), existingCustomerID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(customer).NotTo(BeNil())
+			Expect(customer.Name).To(Equal("Ana"))
+			Expect(customer.LoyaltyTier).To(Equal(domain.LoyaltyTierVIP))
+		})
+
+		It("returns nil when the customer does not exist", func() {
+			customer, err := store.FindByID(context.Background(), 999)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(customer).To(BeNil())
+		})
+	})
+
+	Describe("FindByPhone", func() {
+		It("returns the customer with the phone", func() {
+			customer, err := store.FindByPhone(context.Background(), "+5491155550000")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(customer).NotTo(BeNil())
+			Expect(customer.ID).To(Equal(existingCustomerID))
+		})
+
+		It("ignores deleted customers", func() {
+			Expect(store.Delete(context.Background(), existingCustomerID)).To(Succeed())
+
+			customer, err := store.FindByPhone(context.Background(), "+5491155550000")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(customer).To(BeNil())
+		})
+	})
+
+	Describe("Save", func() {
+		It("creates new customers", func() {
+			customer, err := store.Save(context.Background(), domain.Customer{
+				NewCustomer: domain.NewCustomer{Name: "Bruno", Phone: "5550001"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(customer.ID).NotTo(BeZero())
+
+			customers, err := store.GetAll(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(customers).To(HaveLen(2))
+		})
+
+		It("updates existing customers keeping their creation time", func() {
+			var before models.Customer
+			Expect(testDB.First(&before, existingCustomerID).Error).To(Succeed())
+
+			_, err := store.Save(context.Background(), domain.Customer{
+				ID:          existingCustomerID,
+				NewCustomer: domain.NewCustomer{Name: "Ana Maria", Phone: "+5491155550000", Notes: "Window seat"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			var after models.Customer
+			Expect(testDB.First(&after, existingCustomerID).Error).To(Succeed())
+			Expect(after.Name).To(Equal("Ana Maria"))
+			Expect(after.Notes).To(Equal("Window seat"))
+			Expect(after.CreatedAt).To(BeTemporally("==", before.CreatedAt))
+		})
+	})
+})
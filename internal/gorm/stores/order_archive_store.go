@@ -0,0 +1,305 @@
+package stores
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/internal/gorm/models"
+	"go.opentelemetry.io/otel/attribute"
+	"gorm.io/gorm"
+)
+
+type OrderArchiveStore struct {
+	db    *gorm.DB
+	clock domain.Clock
+}
+
+func NewOrderArchiveStore(db *gorm.DB, clock domain.Clock) *OrderArchiveStore {
+	return &OrderArchiveStore{
+		db:    db,
+		clock: clock,
+	}
+}
+
+// Archive moves up to limit orders completed before the given time into the archive tables,
+// returning how many were moved
+func (o *OrderArchiveStore) Archive(ctx context.Context, before time.Time, limit int) (_ int, err error) {
+	db, span := startSpan(ctx, o.db, "OrderArchiveStore.Archive", attribute.Int("db.batch_size", limit))
+	defer func() { endSpan(span, err) }()
+
+	var archived int
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		ids, err := completedOrderIDs(tx, before, limit)
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+
+		var orders []models.Order
+		if err = tx.Unscoped().Preload("Dishes").Preload("Delivery").Find(&orders, ids).Error; err != nil {
+			return err
+		}
+
+		var statuses []models.OrderStatus
+		if err = tx.Unscoped().Where("order_id IN ?", ids).Order("id").Find(&statuses).Error; err != nil {
+			return err
+		}
+
+		records := archivedOrdersFromDB(orders, statuses, o.clock.Now())
+		if err = tx.Create(&records).Error; err != nil {
+			return err
+		}
+
+		archived = len(ids)
+		return deleteOrders(tx, ids)
+	})
+
+	return archived, err
+}
+
+// Purge deletes up to limit orders completed before the given time, returning how many were deleted
+func (o *OrderArchiveStore) Purge(ctx context.Context, before time.Time, limit int) (_ int, err error) {
+	db, span := startSpan(ctx, o.db, "OrderArchiveStore.Purge", attribute.Int("db.batch_size", limit))
+	defer func() { endSpan(span, err) }()
+
+	var purged int
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		ids, err := completedOrderIDs(tx, before, limit)
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+
+		purged = len(ids)
+		return deleteOrders(tx, ids)
+	})
+
+	return purged, err
+}
+
+func (o *OrderArchiveStore) FindByID(ctx context.Context, id uint) (_ *domain.OrderWithStatusHistory, err error) {
+	db, span := startSpan(ctx, o.db, "OrderArchiveStore.FindByID", orderIDAttribute(id))
+	defer func() { endSpan(span, err) }()
+
+	var order models.ArchivedOrder
+
+	err = db.Preload("Dishes").Preload("Delivery").Preload("Cancellation.Waste").Preload("Statuses", func(db *gorm.DB) *gorm.DB {
+		return db.Order("created_at, id")
+	}).First(&order, id).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	history := make([]domain.OrderStatusHistory, len(order.Statuses))
+
+	for i, status := range order.Statuses {
+		timestamp := status.CreatedAt
+		history[i] = domain.OrderStatusHistory{
+			Status:    status.Status,
+			Timestamp: &timestamp,
+		}
+	}
+
+	return &domain.OrderWithStatusHistory{
+		Order:         archivedOrderToDomain(order),
+		StatusHistory: history,
+	}, nil
+}
+
+// GetAll only applies the filters meaningful for completed orders, and sorts them by id
+func (o *OrderArchiveStore) GetAll(ctx context.Context, filters *domain.OrderFilters) (_ []domain.Order, err error) {
+	db, span := startSpan(ctx, o.db, "OrderArchiveStore.GetAll")
+	defer func() { endSpan(span, err) }()
+
+	var orders []models.ArchivedOrder
+
+	query := db.Model(&models.ArchivedOrder{}).Preload("Dishes").Preload("Delivery").Preload("Cancellation.Waste").Order("id")
+
+	if filters != nil {
+		if len(filters.AnyStatus) > 0 {
+			query.Where("status in (?)", filters.AnyStatus)
+		}
+
+		if filters.CustomerID != nil {
+			query.Where("customer_id = ?", *filters.CustomerID)
+		}
+
+		if len(filters.TableSessionIDs) > 0 {
+			query.Where("table_session_id in (?)", filters.TableSessionIDs)
+		}
+
+		if filters.Source != nil {
+			query.Where("source = ?", *filters.Source)
+		}
+
+		if filters.Late != nil && *filters.Late {
+			query.Where("late_status = status")
+		}
+
+		if filters.Late != nil && !*filters.Late {
+			query.Where("(late_status IS NULL OR late_status <> status)")
+		}
+	}
+
+	if err = query.Find(&orders).Error; err != nil {
+		return nil, err
+	}
+
+	results := make([]domain.Order, len(orders))
+
+	for i, order := range orders {
+		results[i] = archivedOrderToDomain(order)
+	}
+
+	return results, nil
+}
+
+func completedOrderIDs(tx *gorm.DB, before time.Time, limit int) ([]uint, error) {
+	var ids []uint
+
+	err := tx.Unscoped().
+		Model(&models.Order{}).
+		Where("status IN ? AND updated_at < ?", domain.FinalStatuses, before).
+		Order("id").
+		Limit(limit).
+		Pluck("id", &ids).Error
+
+	return ids, err
+}
+
+// Orders are deleted for good along with every row referencing them, but for their cancellation,
+// waste and dish history records
+func deleteOrders(tx *gorm.DB, ids []uint) error {
+	related := []any{
+		&models.OrderDish{},
+		&models.OrderDelivery{},
+		&models.OrderStatus{},
+		&models.OrderPosition{},
+		&models.OrderPositionChange{},
+	}
+
+	for _, model := range related {
+		if err := tx.Unscoped().Where("order_id IN ?", ids).Delete(model).Error; err != nil {
+			return err
+		}
+	}
+
+	return tx.Unscoped().Delete(&models.Order{}, ids).Error
+}
+
+func archivedOrdersFromDB(orders []models.Order, statuses []models.OrderStatus, now time.Time) []models.ArchivedOrder {
+	statusesByOrder := make(map[uint][]models.ArchivedOrderStatus, len(orders))
+
+	for _, status := range statuses {
+		statusesByOrder[status.OrderID] = append(statusesByOrder[status.OrderID], models.ArchivedOrderStatus{
+			ID:        status.ID,
+			CreatedAt: status.CreatedAt,
+			OrderID:   status.OrderID,
+			Status:    status.Status,
+		})
+	}
+
+	result := make([]models.ArchivedOrder, len(orders))
+
+	for i, order := range orders {
+		result[i] = models.ArchivedOrder{
+			ID:               order.ID,
+			CreatedAt:        order.CreatedAt,
+			UpdatedAt:        order.UpdatedAt,
+			ArchivedAt:       now,
+			Status:           order.Status,
+			Source:           order.Source,
+			Dishes:           make([]models.ArchivedOrderDish, len(order.Dishes)),
+			Time:             order.Time,
+			CustomerID:       order.CustomerID,
+			Statuses:         statusesByOrder[order.ID],
+			ReadyAt:          order.ReadyAt,
+			ReleaseAt:        order.ReleaseAt,
+			LateAt:           order.LateAt,
+			LateStatus:       order.LateStatus,
+			ExternalProvider: order.ExternalProvider,
+			ExternalID:       order.ExternalID,
+			Notes:            order.Notes,
+			Allergies:        order.Allergies,
+			Dietary:          order.Dietary,
+			TableID:          order.TableID,
+			TableSessionID:   order.TableSessionID,
+		}
+
+		for j, dish := range order.Dishes {
+			result[i].Dishes[j] = models.ArchivedOrderDish{
+				ID:        dish.ID,
+				CreatedAt: dish.CreatedAt,
+				OrderID:   order.ID,
+				Name:      dish.Name,
+				Status:    dish.Status,
+			}
+		}
+
+		if delivery := order.Delivery; delivery != nil {
+			result[i].Delivery = &models.ArchivedOrderDelivery{
+				ID:                delivery.ID,
+				CreatedAt:         delivery.CreatedAt,
+				OrderID:           order.ID,
+				Address:           delivery.Address,
+				ContactName:       delivery.ContactName,
+				ContactPhone:      delivery.ContactPhone,
+				FeeCents:          delivery.FeeCents,
+				CourierName:       delivery.CourierName,
+				CourierPhone:      delivery.CourierPhone,
+				CourierAssignedAt: delivery.CourierAssignedAt,
+			}
+		}
+	}
+
+	return result
+}
+
+// Archived orders are read back through the same mapping as the live ones
+func archivedOrderToDomain(order models.ArchivedOrder) domain.Order {
+	dbOrder := models.Order{
+		Model:            gorm.Model{ID: order.ID, CreatedAt: order.CreatedAt, UpdatedAt: order.UpdatedAt},
+		Status:           order.Status,
+		Source:           order.Source,
+		Dishes:           make([]models.OrderDish, len(order.Dishes)),
+		Time:             order.Time,
+		CustomerID:       order.CustomerID,
+		ReadyAt:          order.ReadyAt,
+		ReleaseAt:        order.ReleaseAt,
+		LateAt:           order.LateAt,
+		LateStatus:       order.LateStatus,
+		ExternalProvider: order.ExternalProvider,
+		ExternalID:       order.ExternalID,
+		Cancellation:     order.Cancellation,
+		Notes:            order.Notes,
+		Allergies:        order.Allergies,
+		Dietary:          order.Dietary,
+		TableID:          order.TableID,
+		TableSessionID:   order.TableSessionID,
+	}
+
+	for i, dish := range order.Dishes {
+		dbOrder.Dishes[i] = models.OrderDish{Model: gorm.Model{ID: dish.ID}, Name: dish.Name, Status: dish.Status}
+	}
+
+	if delivery := order.Delivery; delivery != nil {
+		dbOrder.Delivery = &models.OrderDelivery{
+			Address:           delivery.Address,
+			ContactName:       delivery.ContactName,
+			ContactPhone:      delivery.ContactPhone,
+			FeeCents:          delivery.FeeCents,
+			CourierName:       delivery.CourierName,
+			CourierPhone:      delivery.CourierPhone,
+			CourierAssignedAt: delivery.CourierAssignedAt,
+		}
+	}
+
+	return OrderFromDB(dbOrder)
+}
@@ -0,0 +1,195 @@
+package stores_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/fakeclock"
+	"github.com/danbrato999/yuno-gveloz/internal/gorm/models"
+	"github.com/danbrato999/yuno-gveloz/internal/gorm/stores"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("OrderArchiveStore", func() {
+	var (
+		testDB    *gorm.DB
+		store     *stores.OrderArchiveStore
+		now       time.Time
+		oldDoneID uint
+	)
+
+	createOrder := func(status domain.OrderStatus, updatedAt time.Time) uint {
+		order := models.Order{
+			Model:  gorm.Model{CreatedAt: updatedAt, UpdatedAt: updatedAt},
+			Source: domain.OrderSourceDelivery,
+			Status: status,
+			Time:   updatedAt,
+			Dishes: []models.OrderDish{{Name: "Pizza"}, {Name: "Salad"}},
+			Delivery: &models.OrderDelivery{
+				Address:      "Calle 10",
+				ContactName:  "Ana",
+				ContactPhone: "555",
+				CourierName:  "Luis",
+			},
+		}
+
+		Expect(testDB.Create(&order).Error).ToNot(HaveOccurred())
+		Expect(testDB.Model(&order).UpdateColumn("updated_at", updatedAt).Error).ToNot(HaveOccurred())
+		Expect(testDB.Create(&models.OrderStatus{OrderID: order.ID, Status: domain.OrderStatusPending}).Error).ToNot(HaveOccurred())
+		Expect(testDB.Create(&models.OrderStatus{OrderID: order.ID, Status: status}).Error).ToNot(HaveOccurred())
+		Expect(testDB.Create(&models.OrderPositionChange{OrderID: order.ID, Position: 1}).Error).ToNot(HaveOccurred())
+
+		return order.ID
+	}
+
+	count := func(model any) int64 {
+		var total int64
+		Expect(testDB.Unscoped().Model(model).Count(&total).Error).ToNot(HaveOccurred())
+		return total
+	}
+
+	BeforeEach(func() {
+		var err error
+		testDB, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = testDB.AutoMigrate(
+			&models.Order{},
+			&models.OrderDish{},
+			&models.OrderDelivery{},
+			&models.OrderStatus{},
+			&models.OrderPosition{},
+			&models.OrderPositionChange{},
+			&models.ArchivedOrder{},
+			&models.ArchivedOrderDish{},
+			&models.ArchivedOrderDelivery{},
+			&models.ArchivedOrderStatus{},
+			&models.OrderCancellation{},
+			&models.WasteRecord{},
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		now = time.Date(2025, 2, 10, 12, 0, 0, 0, time.UTC)
+		store = stores.NewOrderArchiveStore(testDB, fakeclock.New(now))
+
+		oldDoneID = createOrder(domain.OrderStatusDelivered, now.Add(-40*24*time.Hour))
+		createOrder(domain.OrderStatusPreparing, now.Add(-40*24*time.Hour))
+		createOrder(domain.OrderStatusCancelled, now.Add(-time.Hour))
+	})
+
+	Describe("Archive", func() {
+		It("moves the old completed orders with their related rows", func() {
+			archived, err := store.Archive(context.Background(), now.Add(-30*24*time.Hour), 10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(archived).To(Equal(1))
+
+			Expect(count(&models.Order{})).To(BeNumerically("==", 2))
+			Expect(count(&models.OrderDish{})).To(BeNumerically("==", 4))
+			Expect(count(&models.OrderStatus{})).To(BeNumerically("==", 4))
+			Expect(count(&models.OrderDelivery{})).To(BeNumerically("==", 2))
+			Expect(count(&models.OrderPositionChange{})).To(BeNumerically("==", 2))
+
+			var order models.ArchivedOrder
+			err = testDB.Preload("Dishes").Preload("Delivery").Preload("Statuses").First(&order, oldDoneID).Error
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order.Status).To(Equal(domain.OrderStatusDelivered))
+			Expect(order.ArchivedAt).To(BeTemporally("==", now))
+			Expect(order.Dishes).To(HaveLen(2))
+			Expect(order.Delivery.CourierName).To(Equal("Luis"))
+			Expect(order.Statuses).To(HaveLen(2))
+			Expect(order.Statuses[1].Status).To(Equal(domain.OrderStatusDelivered))
+		})
+
+		It("moves at most limit orders", func() {
+			archived, err := store.Archive(context.Background(), now, 1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(archived).To(Equal(1))
+
+			archived, err = store.Archive(context.Background(), now, 1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(archived).To(Equal(1))
+
+			archived, err = store.Archive(context.Background(), now, 1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(archived).To(Equal(0))
+			Expect(count(&models.ArchivedOrder{})).To(BeNumerically("==", 2))
+		})
+	})
+
+	Describe("Purge", func() {
+		It("deletes the old completed orders without archiving them", func() {
+			purged, err := store.Purge(context.Background(), now.Add(-30*24*time.Hour), 10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(purged).To(Equal(1))
+
+			Expect(count(&models.Order{})).To(BeNumerically("==", 2))
+			Expect(count(&models.OrderDish{})).To(BeNumerically("==", 4))
+			Expect(count(&models.OrderStatus{})).To(BeNumerically("==", 4))
+			Expect(count(&models.ArchivedOrder{})).To(BeNumerically("==", 0))
+		})
+	})
+
+	Describe("FindByID", func() {
+		It("returns the archived order with its history", func() {
+			_, err := store.Archive(context.Background(), now.Add(-30*24*time.Hour), 10)
+			Expect(err).ToNot(HaveOccurred())
+
+			order, err := store.FindByID(context.Background(), oldDoneID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order.ID).To(Equal(oldDoneID))
+			Expect(order.Status).To(Equal(domain.OrderStatusDelivered))
+			Expect(order.Dishes).To(HaveExactElements(
+				domain.Dish{ID: order.Dishes[0].ID, Name: "Pizza", Status: domain.DishStatusPending},
+				domain.Dish{ID: order.Dishes[1].ID, Name: "Salad", Status: domain.DishStatusPending},
+			))
+			Expect(order.Courier.Name).To(Equal("Luis"))
+			Expect(order.StatusHistory).To(HaveLen(2))
+			Expect(order.StatusHistory[0].Status).To(Equal(domain.OrderStatusPending))
+		})
+
+		It("keeps the cancellation of archived orders", func() {
+			cancelledID := createOrder(domain.OrderStatusCancelled, now.Add(-40*24*time.Hour))
+			Expect(testDB.Create(&models.OrderCancellation{
+				OrderID:    cancelledID,
+				Reason:     domain.CancellationReasonOutOfStock,
+				RefundType: domain.RefundFull,
+				Waste:      []models.WasteRecord{{OrderID: cancelledID, Dish: "Pizza"}},
+			}).Error).ToNot(HaveOccurred())
+
+			_, err := store.Archive(context.Background(), now.Add(-30*24*time.Hour), 10)
+			Expect(err).ToNot(HaveOccurred())
+
+			order, err := store.FindByID(context.Background(), cancelledID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order.Cancellation).NotTo(BeNil())
+			Expect(order.Cancellation.Reason).To(Equal(domain.CancellationReasonOutOfStock))
+			Expect(order.Cancellation.Waste).To(Equal([]domain.Dish{{Name: "Pizza"}}))
+		})
+
+		It("returns nil when the order is not archived", func() {
+			order, err := store.FindByID(context.Background(), oldDoneID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order).To(BeNil())
+		})
+	})
+
+	Describe("GetAll", func() {
+		It("returns the archived orders matching the filters", func() {
+			_, err := store.Archive(context.Background(), now, 10)
+			Expect(err).ToNot(HaveOccurred())
+
+			orders, err := store.GetAll(context.Background(), &domain.OrderFilters{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(orders).To(HaveLen(2))
+
+			orders, err = store.GetAll(context.Background(), &domain.OrderFilters{AnyStatus: []domain.OrderStatus{domain.OrderStatusCancelled}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(orders).To(HaveLen(1))
+			Expect(orders[0].Status).To(Equal(domain.OrderStatusCancelled))
+		})
+	})
+})
@@ -0,0 +1,136 @@
+package stores
+
+import (
+	"context"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/internal/gorm/models"
+	"gorm.io/gorm"
+)
+
+func (o *OrderPositionStore) Verify(ctx context.Context) (_ *domain.QueueIntegrityReport, err error) {
+	db, span := startSpan(ctx, o.db, "OrderPositionStore.Verify")
+	defer func() { endSpan(span, err) }()
+
+	return verifyQueue(db)
+}
+
+func (o *OrderPositionStore) Repair(ctx context.Context) (_ *domain.QueueIntegrityReport, err error) {
+	db, span := startSpan(ctx, o.db, "OrderPositionStore.Repair")
+	defer func() { endSpan(span, err) }()
+
+	var report *domain.QueueIntegrityReport
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		var err error
+
+		report, err = verifyQueue(tx)
+		if err != nil || report.Healthy {
+			return err
+		}
+
+		if len(report.Orphaned) > 0 {
+			err = tx.Where("order_id IN ?", report.Orphaned).Delete(&models.OrderPosition{}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		var positions []models.OrderPosition
+		if err = tx.Order("position, order_id").Find(&positions).Error; err != nil {
+			return err
+		}
+
+		for _, id := range report.Missing {
+			positions = append(positions, models.OrderPosition{OrderID: id})
+		}
+
+		var changed []uint
+
+		for i := range positions {
+			expected := uint(i + 1)
+
+			if positions[i].Position == expected {
+				continue
+			}
+
+			positions[i].Position = expected
+			if err = tx.Save(&positions[i]).Error; err != nil {
+				return err
+			}
+
+			changed = append(changed, positions[i].OrderID)
+		}
+
+		if len(changed) == 0 {
+			return nil
+		}
+
+		return o.recordPositionChanges(tx, "order_id IN ?", changed)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+func verifyQueue(tx *gorm.DB) (*domain.QueueIntegrityReport, error) {
+	var positions []models.OrderPosition
+
+	if err := tx.Order("position, order_id").Find(&positions).Error; err != nil {
+		return nil, err
+	}
+
+	report := &domain.QueueIntegrityReport{
+		Gaps:       []uint{},
+		Duplicates: []uint{},
+		Orphaned:   []uint{},
+		Missing:    []uint{},
+	}
+
+	var last uint
+	holders := make(map[uint]int, len(positions))
+
+	for _, position := range positions {
+		holders[position.Position]++
+		last = max(last, position.Position)
+	}
+
+	for position := uint(1); position <= last; position++ {
+		switch {
+		case holders[position] == 0:
+			report.Gaps = append(report.Gaps, position)
+		case holders[position] > 1:
+			report.Duplicates = append(report.Duplicates, position)
+		}
+	}
+
+	err := tx.Raw(
+		"select op.order_id from order_positions op "+
+			"left join orders o on o.id = op.order_id and o.deleted_at is null "+
+			"where o.id is null or o.status not in ? order by op.position, op.order_id",
+		domain.KitchenStatuses,
+	).Scan(&report.Orphaned).Error
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Raw(
+		"select o.id from orders o "+
+			"left join order_positions op on op.order_id = o.id "+
+			"where o.deleted_at is null and o.status in ? and op.order_id is null order by o.id",
+		domain.KitchenStatuses,
+	).Scan(&report.Missing).Error
+	if err != nil {
+		return nil, err
+	}
+
+	report.Healthy = len(report.Gaps) == 0 &&
+		len(report.Duplicates) == 0 &&
+		len(report.Orphaned) == 0 &&
+		len(report.Missing) == 0
+
+	return report, nil
+}
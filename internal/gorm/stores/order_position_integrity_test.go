@@ -0,0 +1,142 @@
+package stores_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/fakeclock"
+	"github.com/danbrato999/yuno-gveloz/internal/gorm/models"
+	"github.com/danbrato999/yuno-gveloz/internal/gorm/stores"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("OrderPositionStore integrity", func() {
+	var (
+		testDB *gorm.DB
+		orders []models.Order
+		store  *stores.OrderPositionStore
+	)
+
+	queuePositions := func() []models.OrderPosition {
+		var positions []models.OrderPosition
+		Expect(testDB.Order("position").Find(&positions).Error).ToNot(HaveOccurred())
+		return positions
+	}
+
+	BeforeEach(func() {
+		var err error
+		testDB, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = testDB.AutoMigrate(&models.Order{}, &models.OrderPosition{}, &models.OrderPositionChange{})
+		Expect(err).NotTo(HaveOccurred())
+
+		store = stores.NewOrderPositionStore(testDB, fakeclock.New(time.Now()))
+
+		orders = make([]models.Order, 4)
+
+		for i := range orders {
+			orders[i] = models.Order{
+				Source: domain.OrderSourcePhone,
+				Status: domain.OrderStatusPending,
+				Time:   time.Now(),
+			}
+			Expect(testDB.Save(&orders[i]).Error).NotTo(HaveOccurred())
+		}
+	})
+
+	When("the queue is consistent", func() {
+		BeforeEach(func() {
+			for i, order := range orders {
+				Expect(testDB.Save(&models.OrderPosition{OrderID: order.ID, Position: uint(i + 1)}).Error).To(Succeed())
+			}
+		})
+
+		It("should report a healthy queue", func() {
+			report, err := store.Verify(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report.Healthy).To(BeTrue())
+			Expect(report.Gaps).To(BeEmpty())
+			Expect(report.Duplicates).To(BeEmpty())
+			Expect(report.Orphaned).To(BeEmpty())
+			Expect(report.Missing).To(BeEmpty())
+		})
+
+		It("should not change anything when repairing", func() {
+			report, err := store.Repair(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report.Healthy).To(BeTrue())
+			Expect(queuePositions()).To(HaveLen(4))
+		})
+	})
+
+	When("the queue is corrupted", func() {
+		BeforeEach(func() {
+			orders[3].Status = domain.OrderStatusDone
+			Expect(testDB.Save(&orders[3]).Error).To(Succeed())
+
+			positions := []models.OrderPosition{
+				{OrderID: orders[3].ID, Position: 1},
+				{OrderID: orders[1].ID, Position: 3},
+				{OrderID: orders[0].ID, Position: 3},
+				{OrderID: 999, Position: 6},
+			}
+			Expect(testDB.Create(&positions).Error).To(Succeed())
+		})
+
+		It("should report every issue", func() {
+			report, err := store.Verify(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report.Healthy).To(BeFalse())
+			Expect(report.Gaps).To(Equal([]uint{2, 4, 5}))
+			Expect(report.Duplicates).To(Equal([]uint{3}))
+			Expect(report.Orphaned).To(Equal([]uint{orders[3].ID, 999}))
+			Expect(report.Missing).To(Equal([]uint{orders[2].ID}))
+		})
+
+		It("should renumber the queue densely keeping the relative order", func() {
+			report, err := store.Repair(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report.Healthy).To(BeFalse())
+
+			Expect(queuePositions()).To(Equal([]models.OrderPosition{
+				{OrderID: orders[0].ID, Position: 1},
+				{OrderID: orders[1].ID, Position: 2},
+				{OrderID: orders[2].ID, Position: 3},
+			}))
+
+			report, err = store.Verify(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report.Healthy).To(BeTrue())
+		})
+	})
+
+	When("delivery orders left the kitchen", func() {
+		BeforeEach(func() {
+			orders[0].Source = domain.OrderSourceDelivery
+			orders[0].Status = domain.OrderStatusAwaitingCourier
+			orders[1].Source = domain.OrderSourceDelivery
+			orders[1].Status = domain.OrderStatusOutForDelivery
+			Expect(testDB.Save(&orders[0]).Error).To(Succeed())
+			Expect(testDB.Save(&orders[1]).Error).To(Succeed())
+
+			positions := []models.OrderPosition{
+				{OrderID: orders[0].ID, Position: 1},
+				{OrderID: orders[2].ID, Position: 2},
+				{OrderID: orders[3].ID, Position: 3},
+			}
+			Expect(testDB.Create(&positions).Error).To(Succeed())
+		})
+
+		It("should report their positions as orphaned, and not expect them in the queue", func() {
+			report, err := store.Verify(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report.Orphaned).To(Equal([]uint{orders[0].ID}))
+			Expect(report.Missing).To(BeEmpty())
+		})
+	})
+})
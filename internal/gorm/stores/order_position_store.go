@@ -1,6 +1,7 @@
 package stores
 
 import (
+	"context"
 	"errors"
 
 	"github.com/danbrato999/yuno-gveloz/domain"
@@ -9,47 +10,60 @@ import (
 )
 
 type OrderPositionStore struct {
-	db *gorm.DB
+	db    *gorm.DB
+	clock domain.Clock
 }
 
-func NewOrderPositionStore(db *gorm.DB) *OrderPositionStore {
+func NewOrderPositionStore(db *gorm.DB, clock domain.Clock) *OrderPositionStore {
 	return &OrderPositionStore{
-		db: db,
+		db:    db,
+		clock: clock,
 	}
 }
 
-func (o *OrderPositionStore) Add(order *domain.Order) error {
-	err := o.db.
-		Where("order_id = ?", order.ID).
-		First(new(models.OrderPosition)).
-		Error
+func (o *OrderPositionStore) Add(ctx context.Context, order *domain.Order) (err error) {
+	db, span := startSpan(ctx, o.db, "PriorityQueue.Add", orderIDAttribute(order.ID))
+	defer func() { endSpan(span, err) }()
 
-	if err == nil {
-		return domain.ErrIncorrectOrderQueueing
-	}
+	return db.Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Where("order_id = ?", order.ID).
+			First(new(models.OrderPosition)).
+			Error
 
-	if !errors.Is(err, gorm.ErrRecordNotFound) {
-		return err
-	}
+		if err == nil {
+			return domain.ErrIncorrectOrderQueueing
+		}
+
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
 
-	return o.db.Transaction(func(tx *gorm.DB) error {
 		var latest uint
-		err := tx.Model(&models.OrderPosition{}).Select("COALESCE(MAX(position), 0)").Scan(&latest).Error
+		err = tx.Model(&models.OrderPosition{}).Select("COALESCE(MAX(position), 0)").Scan(&latest).Error
 		if err != nil {
 			return err
 		}
 
-		return tx.Save(&models.OrderPosition{
+		err = tx.Save(&models.OrderPosition{
 			OrderID:  order.ID,
 			Position: latest + 1,
 		}).Error
+		if err != nil {
+			return err
+		}
+
+		return o.recordPositionChanges(tx, "order_id = ?", order.ID)
 	})
 }
 
-func (o *OrderPositionStore) ShuffleAfter(id, targetID uint) error {
+func (o *OrderPositionStore) ShuffleAfter(ctx context.Context, id, targetID uint) (err error) {
+	db, span := startSpan(ctx, o.db, "PriorityQueue.ShuffleAfter", orderIDAttribute(id))
+	defer func() { endSpan(span, err) }()
+
 	var positions []models.OrderPosition
 
-	return o.db.Transaction(func(tx *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
 		err := tx.Where("order_id IN (?, ?)", id, targetID).Find(&positions).Error
 
 		if err != nil {
@@ -89,12 +103,20 @@ func (o *OrderPositionStore) ShuffleAfter(id, targetID uint) error {
 			return err
 		}
 
-		return tx.Exec("update order_positions set position = ? where order_id = ?", targetPos, id).Error
+		err = tx.Exec("update order_positions set position = ? where order_id = ?", targetPos, id).Error
+		if err != nil {
+			return err
+		}
+
+		return o.recordPositionChanges(tx, "position BETWEEN ? AND ?", min(currentPos, targetPos), max(currentPos, targetPos))
 	})
 }
 
-func (o *OrderPositionStore) Remove(id uint) error {
-	return o.db.Transaction(func(tx *gorm.DB) error {
+func (o *OrderPositionStore) Remove(ctx context.Context, id uint) (err error) {
+	db, span := startSpan(ctx, o.db, "PriorityQueue.Remove", orderIDAttribute(id))
+	defer func() { endSpan(span, err) }()
+
+	return db.Transaction(func(tx *gorm.DB) error {
 		var current models.OrderPosition
 
 		err := tx.Where("order_id = ?", id).First(&current).Error
@@ -112,6 +134,128 @@ func (o *OrderPositionStore) Remove(id uint) error {
 			return err
 		}
 
-		return tx.Unscoped().Delete(&current).Error
+		if err = tx.Unscoped().Delete(&current).Error; err != nil {
+			return err
+		}
+
+		return o.recordPositionChanges(tx, "position >= ?", current.Position)
 	})
 }
+
+func (o *OrderPositionStore) GetPositions(ctx context.Context) (_ []domain.QueuePosition, err error) {
+	db, span := startSpan(ctx, o.db, "PriorityQueue.GetPositions")
+	defer func() { endSpan(span, err) }()
+
+	var positions []models.OrderPosition
+
+	if err = db.Order("position").Find(&positions).Error; err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint, len(positions))
+	for i, position := range positions {
+		ids[i] = position.OrderID
+	}
+
+	history, err := getPositionHistory(db, ids...)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.QueuePosition, len(positions))
+
+	for i, position := range positions {
+		result[i] = queuePositionFromDB(position, history[position.OrderID])
+	}
+
+	return result, nil
+}
+
+func (o *OrderPositionStore) Length(ctx context.Context) (_ int64, err error) {
+	db, span := startSpan(ctx, o.db, "PriorityQueue.Length")
+	defer func() { endSpan(span, err) }()
+
+	var length int64
+
+	err = db.Model(&models.OrderPosition{}).Count(&length).Error
+
+	return length, err
+}
+
+func (o *OrderPositionStore) GetPosition(ctx context.Context, id uint) (_ *domain.QueuePosition, err error) {
+	db, span := startSpan(ctx, o.db, "PriorityQueue.GetPosition", orderIDAttribute(id))
+	defer func() { endSpan(span, err) }()
+
+	var position models.OrderPosition
+
+	err = db.Where("order_id = ?", id).First(&position).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	history, err := getPositionHistory(db, id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := queuePositionFromDB(position, history[id])
+	return &result, nil
+}
+
+func getPositionHistory(db *gorm.DB, ids ...uint) (map[uint][]models.OrderPositionChange, error) {
+	var changes []models.OrderPositionChange
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	if err := db.Where("order_id IN ?", ids).Order("id").Find(&changes).Error; err != nil {
+		return nil, err
+	}
+
+	result := make(map[uint][]models.OrderPositionChange, len(ids))
+
+	for _, change := range changes {
+		result[change.OrderID] = append(result[change.OrderID], change)
+	}
+
+	return result, nil
+}
+
+// Stores the current position of every order matching the query, so the history
+// also reflects orders shifted as a side effect of another order moving
+func (o *OrderPositionStore) recordPositionChanges(tx *gorm.DB, query string, args ...any) error {
+	now := o.clock.Now()
+
+	return tx.Exec(
+		"insert into order_position_changes (created_at, updated_at, order_id, position) "+
+			"select ?, ?, order_id, position from order_positions where "+query,
+		append([]any{now, now}, args...)...,
+	).Error
+}
+
+func queuePositionFromDB(position models.OrderPosition, changes []models.OrderPositionChange) domain.QueuePosition {
+	result := domain.QueuePosition{
+		OrderID:  position.OrderID,
+		Position: position.Position,
+		History:  make([]domain.PositionChange, len(changes)),
+	}
+
+	for i, change := range changes {
+		result.History[i] = domain.PositionChange{
+			Position:  change.Position,
+			Timestamp: &change.CreatedAt,
+		}
+	}
+
+	if len(result.History) > 0 {
+		result.QueuedAt = result.History[0].Timestamp
+	}
+
+	return result
+}
@@ -1,9 +1,11 @@
 package stores_test
 
 import (
+	"context"
 	"time"
 
 	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/fakeclock"
 	"github.com/danbrato999/yuno-gveloz/internal/gorm/models"
 	"github.com/danbrato999/yuno-gveloz/internal/gorm/stores"
 	. "github.com/onsi/ginkgo/v2"
@@ -25,10 +27,10 @@ var _ = Describe("OrderPriorityStore", func() {
 		Expect(testDB).NotTo(BeNil())
 		Expect(err).NotTo(HaveOccurred())
 
-		err = testDB.AutoMigrate(&models.Order{}, &models.OrderPosition{})
+		err = testDB.AutoMigrate(&models.Order{}, &models.OrderPosition{}, &models.OrderPositionChange{})
 		Expect(err).NotTo(HaveOccurred())
 
-		store = *stores.NewOrderPositionStore(testDB)
+		store = *stores.NewOrderPositionStore(testDB, fakeclock.New(time.Now()))
 
 		times := []int{-10, -5, -2}
 		orderQueue = make([]models.Order, len(times))
@@ -61,7 +63,7 @@ var _ = Describe("OrderPriorityStore", func() {
 				}
 				Expect(testDB.Save(&newOrder).Error).NotTo(HaveOccurred())
 
-				err := store.Add(&domain.Order{ID: newOrder.ID})
+				err := store.Add(context.Background(), &domain.Order{ID: newOrder.ID})
 				Expect(err).ToNot(HaveOccurred())
 
 				var positions []models.OrderPosition
@@ -82,7 +84,7 @@ var _ = Describe("OrderPriorityStore", func() {
 			})
 
 			It("should add the order at position 1", func() {
-				Expect(store.Add(&domain.Order{ID: orderQueue[0].ID})).To(Succeed())
+				Expect(store.Add(context.Background(), &domain.Order{ID: orderQueue[0].ID})).To(Succeed())
 
 				var positions []models.OrderPosition
 				err := testDB.Order("position").Find(&positions).Error
@@ -95,7 +97,7 @@ var _ = Describe("OrderPriorityStore", func() {
 
 		When("adding an existing order to the queue", func() {
 			It("should return an error", func() {
-				err := store.Add(&domain.Order{ID: orderQueue[0].ID})
+				err := store.Add(context.Background(), &domain.Order{ID: orderQueue[0].ID})
 				Expect(err).To(Equal(domain.ErrIncorrectOrderQueueing))
 			})
 		})
@@ -103,7 +105,7 @@ var _ = Describe("OrderPriorityStore", func() {
 
 	Describe("Remove", func() {
 		It("should properly remove an order at the beginning", func() {
-			err := store.Remove(orderQueue[0].ID)
+			err := store.Remove(context.Background(), orderQueue[0].ID)
 			Expect(err).ToNot(HaveOccurred())
 
 			var positions []models.OrderPosition
@@ -116,7 +118,7 @@ var _ = Describe("OrderPriorityStore", func() {
 		})
 
 		It("should properly remove an order at the bottom", func() {
-			err := store.Remove(orderQueue[2].ID)
+			err := store.Remove(context.Background(), orderQueue[2].ID)
 			Expect(err).ToNot(HaveOccurred())
 
 			var positions []models.OrderPosition
@@ -129,7 +131,7 @@ var _ = Describe("OrderPriorityStore", func() {
 		})
 
 		It("should do nothing when removing an non existing order", func() {
-			err := store.Remove(uint(666))
+			err := store.Remove(context.Background(), uint(666))
 			Expect(err).ToNot(HaveOccurred())
 
 			var positions []models.OrderPosition
@@ -145,7 +147,7 @@ var _ = Describe("OrderPriorityStore", func() {
 
 	Describe("ShuffleAfter", func() {
 		It("should move an order forward in the queue", func() {
-			err := store.ShuffleAfter(orderQueue[0].ID, orderQueue[1].ID)
+			err := store.ShuffleAfter(context.Background(), orderQueue[0].ID, orderQueue[1].ID)
 			Expect(err).ToNot(HaveOccurred())
 
 			var positions []models.OrderPosition
@@ -159,7 +161,7 @@ var _ = Describe("OrderPriorityStore", func() {
 		})
 
 		It("should move an order backward in the queue", func() {
-			err := store.ShuffleAfter(orderQueue[2].ID, orderQueue[0].ID)
+			err := store.ShuffleAfter(context.Background(), orderQueue[2].ID, orderQueue[0].ID)
 			Expect(err).ToNot(HaveOccurred())
 
 			var positions []models.OrderPosition
@@ -173,7 +175,7 @@ var _ = Describe("OrderPriorityStore", func() {
 		})
 
 		It("should not change order if already in correct place", func() {
-			err := store.ShuffleAfter(orderQueue[1].ID, orderQueue[0].ID)
+			err := store.ShuffleAfter(context.Background(), orderQueue[1].ID, orderQueue[0].ID)
 			Expect(err).ToNot(HaveOccurred())
 
 			var positions []models.OrderPosition
@@ -187,7 +189,7 @@ var _ = Describe("OrderPriorityStore", func() {
 		})
 
 		It("should do nothing if one of the orders does not exist", func() {
-			err := store.ShuffleAfter(uint(999), orderQueue[0].ID)
+			err := store.ShuffleAfter(context.Background(), uint(999), orderQueue[0].ID)
 			Expect(err).ToNot(HaveOccurred())
 
 			var positions []models.OrderPosition
@@ -201,7 +203,7 @@ var _ = Describe("OrderPriorityStore", func() {
 		})
 
 		It("should do nothing if both orders do not exist", func() {
-			err := store.ShuffleAfter(uint(999), uint(888))
+			err := store.ShuffleAfter(context.Background(), uint(999), uint(888))
 			Expect(err).ToNot(HaveOccurred())
 
 			var positions []models.OrderPosition
@@ -214,4 +216,74 @@ var _ = Describe("OrderPriorityStore", func() {
 			}))
 		})
 	})
+
+	Describe("GetPositions", func() {
+		It("should return every queued order sorted by position", func() {
+			Expect(store.ShuffleAfter(context.Background(), orderQueue[0].ID, orderQueue[2].ID)).To(Succeed())
+
+			positions, err := store.GetPositions(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(positions).To(HaveLen(3))
+			Expect(positions[0].OrderID).To(Equal(orderQueue[1].ID))
+			Expect(positions[1].OrderID).To(Equal(orderQueue[2].ID))
+			Expect(positions[2].OrderID).To(Equal(orderQueue[0].ID))
+			Expect(positions[2].Position).To(Equal(uint(3)))
+			Expect(positions[2].History).To(HaveLen(1))
+		})
+
+		It("should return an empty list when no orders are queued", func() {
+			Expect(testDB.Exec("DELETE FROM order_positions").Error).ToNot(HaveOccurred())
+
+			positions, err := store.GetPositions(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(positions).To(BeEmpty())
+		})
+	})
+
+	Describe("Length", func() {
+		It("should count the queued orders", func() {
+			Expect(store.Remove(context.Background(), orderQueue[1].ID)).To(Succeed())
+
+			length, err := store.Length(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(length).To(Equal(int64(2)))
+		})
+	})
+
+	Describe("GetPosition", func() {
+		When("the order is queued", func() {
+			It("should record the history of its position changes", func() {
+				newOrder := models.Order{
+					Source: domain.OrderSourceInPerson,
+					Status: domain.OrderStatusPending,
+					Time:   time.Now(),
+				}
+				Expect(testDB.Save(&newOrder).Error).NotTo(HaveOccurred())
+
+				Expect(store.Add(context.Background(), &domain.Order{ID: newOrder.ID})).To(Succeed())
+				Expect(store.ShuffleAfter(context.Background(), newOrder.ID, orderQueue[0].ID)).To(Succeed())
+				Expect(store.Remove(context.Background(), orderQueue[0].ID)).To(Succeed())
+
+				position, err := store.GetPosition(context.Background(), newOrder.ID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(position).ToNot(BeNil())
+				Expect(position.Position).To(Equal(uint(1)))
+				Expect(position.QueuedAt).ToNot(BeNil())
+
+				history := make([]uint, len(position.History))
+				for i, change := range position.History {
+					history[i] = change.Position
+				}
+				Expect(history).To(Equal([]uint{4, 2, 1}))
+			})
+		})
+
+		When("the order is not queued", func() {
+			It("should return nil", func() {
+				position, err := store.GetPosition(context.Background(), uint(666))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(position).To(BeNil())
+			})
+		})
+	})
 })
@@ -1,37 +1,68 @@
 package stores
 
 import (
+	"context"
+
 	"github.com/danbrato999/yuno-gveloz/domain"
 	"github.com/danbrato999/yuno-gveloz/domain/services"
 	"github.com/danbrato999/yuno-gveloz/internal/gorm/models"
+	"go.opentelemetry.io/otel/attribute"
 	"gorm.io/gorm"
 )
 
 type orderStatusStore struct {
-	db *gorm.DB
+	db    *gorm.DB
+	clock domain.Clock
 }
 
-func NewOrderStatusStore(db *gorm.DB) services.OrderStatusStore {
+func NewOrderStatusStore(db *gorm.DB, clock domain.Clock) services.OrderStatusStore {
 	return &orderStatusStore{
-		db: db,
+		db:    db,
+		clock: clock,
 	}
 }
 
 // TODO: Check order exists
 // TODO: Check current status is not latest
-func (o *orderStatusStore) AddCurrentStatus(order *domain.Order) error {
+func (o *orderStatusStore) AddCurrentStatus(ctx context.Context, order *domain.Order) (err error) {
+	db, span := startSpan(ctx, o.db, "OrderStatusStore.AddCurrentStatus", orderIDAttribute(order.ID))
+	defer func() { endSpan(span, err) }()
+
 	status := models.OrderStatus{
+		Model: gorm.Model{
+			CreatedAt: o.clock.Now(),
+		},
 		OrderID: order.ID,
 		Status:  order.Status,
 	}
 
-	return o.db.Save(&status).Error
+	return db.Save(&status).Error
+}
+
+func (o *orderStatusStore) AddHistory(ctx context.Context, id uint, history []domain.OrderStatusHistory) (err error) {
+	db, span := startSpan(ctx, o.db, "OrderStatusStore.AddHistory", orderIDAttribute(id))
+	defer func() { endSpan(span, err) }()
+
+	statuses := make([]models.OrderStatus, len(history))
+
+	for i, change := range history {
+		statuses[i] = models.OrderStatus{
+			Model:   gorm.Model{CreatedAt: *change.Timestamp},
+			OrderID: id,
+			Status:  change.Status,
+		}
+	}
+
+	return db.Create(&statuses).Error
 }
 
-func (o *orderStatusStore) GetHistory(id uint) ([]domain.OrderStatusHistory, error) {
+func (o *orderStatusStore) GetHistory(ctx context.Context, id uint) (_ []domain.OrderStatusHistory, err error) {
+	db, span := startSpan(ctx, o.db, "OrderStatusStore.GetHistory", orderIDAttribute(id))
+	defer func() { endSpan(span, err) }()
+
 	var history []models.OrderStatus
 
-	if err := o.db.Where("order_id = ?", id).Find(&history).Error; err != nil {
+	if err = db.Where("order_id = ?", id).Order("created_at, id").Find(&history).Error; err != nil {
 		return nil, err
 	}
 
@@ -46,3 +77,25 @@ func (o *orderStatusStore) GetHistory(id uint) ([]domain.OrderStatusHistory, err
 
 	return result, nil
 }
+
+func (o *orderStatusStore) GetHistories(ctx context.Context, ids []uint) (_ map[uint][]domain.OrderStatusHistory, err error) {
+	db, span := startSpan(ctx, o.db, "OrderStatusStore.GetHistories", attribute.Int("orders.count", len(ids)))
+	defer func() { endSpan(span, err) }()
+
+	var history []models.OrderStatus
+
+	if err = db.Where("order_id IN ?", ids).Order("created_at, id").Find(&history).Error; err != nil {
+		return nil, err
+	}
+
+	result := make(map[uint][]domain.OrderStatusHistory, len(ids))
+
+	for _, status := range history {
+		result[status.OrderID] = append(result[status.OrderID], domain.OrderStatusHistory{
+			Status:    status.Status,
+			Timestamp: &status.CreatedAt,
+		})
+	}
+
+	return result, nil
+}
@@ -1,9 +1,11 @@
 package stores_test
 
 import (
+	"context"
 	"time"
 
 	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/fakeclock"
 	"github.com/danbrato999/yuno-gveloz/domain/services"
 	"github.com/danbrato999/yuno-gveloz/internal/gorm/models"
 	"github.com/danbrato999/yuno-gveloz/internal/gorm/stores"
@@ -18,6 +20,8 @@ var _ = Describe("OrderStatusStore", func() {
 		testDB          *gorm.DB
 		existingOrderID uint
 		store           services.OrderStatusStore
+		clock           *fakeclock.Clock
+		createdAt       time.Time
 	)
 
 	BeforeEach(func() {
@@ -29,7 +33,9 @@ var _ = Describe("OrderStatusStore", func() {
 		err = testDB.AutoMigrate(&models.Order{}, &models.OrderStatus{})
 		Expect(err).NotTo(HaveOccurred())
 
-		store = stores.NewOrderStatusStore(testDB)
+		createdAt = time.Date(2025, 2, 10, 12, 0, 0, 0, time.UTC)
+		clock = fakeclock.New(createdAt)
+		store = stores.NewOrderStatusStore(testDB, clock)
 
 		testOrder := models.Order{
 			Source: domain.OrderSourcePhone,
@@ -40,12 +46,7 @@ var _ = Describe("OrderStatusStore", func() {
 		err = testDB.Save(&testOrder).Error
 		Expect(err).NotTo(HaveOccurred())
 
-		existingStatus := models.OrderStatus{
-			OrderID: testOrder.ID,
-			Status:  testOrder.Status,
-		}
-
-		Expect(testDB.Save(&existingStatus).Error).ToNot(HaveOccurred())
+		Expect(store.AddCurrentStatus(context.Background(), &domain.Order{ID: testOrder.ID, Status: testOrder.Status})).To(Succeed())
 
 		existingOrderID = testOrder.ID
 	})
@@ -58,7 +59,7 @@ var _ = Describe("OrderStatusStore", func() {
 					Status: domain.OrderStatusPreparing,
 				}
 
-				Expect(store.AddCurrentStatus(order)).ToNot(HaveOccurred())
+				Expect(store.AddCurrentStatus(context.Background(), order)).ToNot(HaveOccurred())
 
 				var total int64
 
@@ -74,12 +75,68 @@ var _ = Describe("OrderStatusStore", func() {
 		})
 	})
 
+	Describe("AddHistory", func() {
+		It("keeps the time of each status change", func() {
+			preparingAt := createdAt.Add(-time.Hour)
+			doneAt := preparingAt.Add(10 * time.Minute)
+
+			Expect(store.AddHistory(context.Background(), existingOrderID, []domain.OrderStatusHistory{
+				{Status: domain.OrderStatusPreparing, Timestamp: &preparingAt},
+				{Status: domain.OrderStatusDone, Timestamp: &doneAt},
+			})).To(Succeed())
+
+			history, err := store.GetHistory(context.Background(), existingOrderID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(history).To(HaveLen(3))
+			Expect(history[0].Status).To(Equal(domain.OrderStatusPreparing))
+			Expect(*history[0].Timestamp).To(BeTemporally("==", preparingAt))
+			Expect(history[1].Status).To(Equal(domain.OrderStatusDone))
+			Expect(*history[1].Timestamp).To(BeTemporally("==", doneAt))
+		})
+	})
+
 	Describe("GetHistory", func() {
 		It("returns the statuses of an order", func() {
-			history, err := store.GetHistory(existingOrderID)
+			history, err := store.GetHistory(context.Background(), existingOrderID)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(history).To(HaveLen(1))
 			Expect(history[0].Status).To(Equal(domain.OrderStatusPending))
+			Expect(*history[0].Timestamp).To(BeTemporally("==", createdAt))
+		})
+
+		It("returns the time at which each status was reached", func() {
+			preparingAt := clock.Advance(5 * time.Minute)
+			Expect(store.AddCurrentStatus(context.Background(), &domain.Order{ID: existingOrderID, Status: domain.OrderStatusPreparing})).To(Succeed())
+
+			readyAt := clock.Advance(12 * time.Minute)
+			Expect(store.AddCurrentStatus(context.Background(), &domain.Order{ID: existingOrderID, Status: domain.OrderStatusReady})).To(Succeed())
+
+			history, err := store.GetHistory(context.Background(), existingOrderID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(history).To(HaveLen(3))
+			Expect(history[1].Status).To(Equal(domain.OrderStatusPreparing))
+			Expect(*history[1].Timestamp).To(BeTemporally("==", preparingAt))
+			Expect(history[2].Status).To(Equal(domain.OrderStatusReady))
+			Expect(*history[2].Timestamp).To(BeTemporally("==", readyAt))
+		})
+	})
+
+	Describe("GetHistories", func() {
+		It("returns the statuses grouped by order", func() {
+			otherOrder := models.Order{Source: domain.OrderSourceDelivery, Status: domain.OrderStatusDone, Time: time.Now()}
+			Expect(testDB.Save(&otherOrder).Error).To(Succeed())
+
+			clock.Advance(time.Minute)
+			Expect(store.AddCurrentStatus(context.Background(), &domain.Order{ID: existingOrderID, Status: domain.OrderStatusPreparing})).To(Succeed())
+			Expect(store.AddCurrentStatus(context.Background(), &domain.Order{ID: otherOrder.ID, Status: domain.OrderStatusDone})).To(Succeed())
+
+			histories, err := store.GetHistories(context.Background(), []uint{existingOrderID, otherOrder.ID, 999})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(histories).To(HaveLen(2))
+			Expect(histories[existingOrderID]).To(HaveLen(2))
+			Expect(histories[existingOrderID][1].Status).To(Equal(domain.OrderStatusPreparing))
+			Expect(histories[otherOrder.ID]).To(HaveLen(1))
+			Expect(histories[otherOrder.ID][0].Status).To(Equal(domain.OrderStatusDone))
 		})
 	})
 })
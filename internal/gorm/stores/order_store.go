@@ -1,29 +1,41 @@
 package stores
 
 import (
+	"context"
 	"errors"
+	"slices"
+	"time"
 
 	"github.com/danbrato999/yuno-gveloz/domain"
 	"github.com/danbrato999/yuno-gveloz/domain/services"
 	"github.com/danbrato999/yuno-gveloz/internal/gorm/models"
+	"go.opentelemetry.io/otel/attribute"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
+// Orders read per query by GetAll, well within the SQL variables limit of their preloads
+const getAllBatchSize = 10000
+
 type orderStore struct {
-	db *gorm.DB
+	db    *gorm.DB
+	clock domain.Clock
 }
 
-func NewOrderStore(db *gorm.DB) services.OrderStore {
+func NewOrderStore(db *gorm.DB, clock domain.Clock) services.OrderStore {
 	return &orderStore{
-		db: db,
+		db:    db,
+		clock: clock,
 	}
 }
 
-func (o *orderStore) FindByID(id uint) (*domain.Order, error) {
+func (o *orderStore) FindByID(ctx context.Context, id uint) (_ *domain.Order, err error) {
+	db, span := startSpan(ctx, o.db, "OrderStore.FindByID", orderIDAttribute(id))
+	defer func() { endSpan(span, err) }()
+
 	var order models.Order
 
-	err := o.db.Preload("Dishes").First(&order, id).Error
+	err = db.Preload("Dishes").Preload("Delivery").Preload("Cancellation.Waste").First(&order, id).Error
 
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -37,50 +49,240 @@ func (o *orderStore) FindByID(id uint) (*domain.Order, error) {
 	return &result, nil
 }
 
-func (o *orderStore) GetAll(filters *domain.OrderFilters) ([]domain.Order, error) {
-	var (
-		orders []models.Order
-		batch  []models.Order
-	)
+func (o *orderStore) FindByExternalID(ctx context.Context, provider string, externalID string) (_ *domain.Order, err error) {
+	db, span := startSpan(ctx, o.db, "OrderStore.FindByExternalID", attribute.String("order.external_provider", provider))
+	defer func() { endSpan(span, err) }()
+
+	var order models.Order
+
+	err = db.Preload("Dishes").Preload("Delivery").Preload("Cancellation.Waste").
+		Where("external_provider = ? AND external_id = ?", provider, externalID).
+		First(&order).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	result := OrderFromDB(order)
+	return &result, nil
+}
+
+// GetAll lists the sorted ids at once, then reads their orders in batches, as preloading every order
+// at once would go over the SQL variables limit
+func (o *orderStore) GetAll(ctx context.Context, filters *domain.OrderFilters) (_ []domain.Order, err error) {
+	db, span := startSpan(ctx, o.db, "OrderStore.GetAll")
+	defer func() { endSpan(span, err) }()
+
+	var ids []uint
+	query := filteredQuery(db, filters)
+
+	if filters != nil && filters.Limit > 0 {
+		query = query.Order("orders.id").Limit(filters.Limit).Offset(filters.Offset)
+	}
+
+	if err = query.Pluck("orders.id", &ids).Error; err != nil {
+		return nil, err
+	}
+
+	results := make([]domain.Order, 0, len(ids))
+
+	for batchIDs := range slices.Chunk(ids, getAllBatchSize) {
+		var batch []models.Order
+
+		if err = withAssociations(db).Find(&batch, batchIDs).Error; err != nil {
+			return nil, err
+		}
+
+		byID := make(map[uint]models.Order, len(batch))
+		for _, order := range batch {
+			byID[order.ID] = order
+		}
+
+		// Orders deleted since the ids were listed are left out
+		for _, id := range batchIDs {
+			if order, ok := byID[id]; ok {
+				results = append(results, OrderFromDB(order))
+			}
+		}
+	}
+
+	return results, nil
+}
+
+func (o *orderStore) Count(ctx context.Context, filters *domain.OrderFilters) (_ int, err error) {
+	db, span := startSpan(ctx, o.db, "OrderStore.Count")
+	defer func() { endSpan(span, err) }()
 
-	query := o.db.Table("orders").Preload("Dishes")
+	var count int64
+
+	if err = filteredQuery(db, filters).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
+func (o *orderStore) Iterate(
+	ctx context.Context,
+	filters *domain.OrderFilters,
+	batchSize int,
+	fn func(batch []domain.Order) error,
+) (err error) {
+	db, span := startSpan(ctx, o.db, "OrderStore.Iterate", attribute.Int("db.batch_size", batchSize))
+	defer func() { endSpan(span, err) }()
+
+	// Batches move forward by id, so sorting them any other way would skip or repeat orders
+	if filters != nil {
+		unsorted := *filters
+		unsorted.ReleaseSort = false
+		unsorted.PrioritySort = false
+		unsorted.Limit = 0
+		unsorted.Offset = 0
+		filters = &unsorted
+	}
+
+	var batch []models.Order
+
+	return withAssociations(filteredQuery(db, filters)).FindInBatches(&batch, batchSize, func(tx2 *gorm.DB, batchSize int) error {
+		results := make([]domain.Order, len(batch))
+
+		for i, order := range batch {
+			results[i] = OrderFromDB(order)
+		}
+
+		return fn(results)
+	}).Error
+}
+
+func withAssociations(db *gorm.DB) *gorm.DB {
+	return db.Preload("Dishes").Preload("Delivery").Preload("Cancellation.Waste")
+}
+
+func filteredQuery(db *gorm.DB, filters *domain.OrderFilters) *gorm.DB {
+	query := db.Model(&models.Order{})
 
 	if filters != nil {
 		if len(filters.AnyStatus) > 0 {
 			query.Where("status in (?)", filters.AnyStatus)
 		}
 
+		if filters.CustomerID != nil {
+			query.Where("customer_id = ?", *filters.CustomerID)
+		}
+
+		if len(filters.TableSessionIDs) > 0 {
+			query.Where("table_session_id in (?)", filters.TableSessionIDs)
+		}
+
+		if filters.Source != nil {
+			query.Where("source = ?", *filters.Source)
+		}
+
+		if filters.Late != nil && *filters.Late {
+			query.Where("orders.late_status = orders.status")
+		}
+
+		if filters.Late != nil && !*filters.Late {
+			query.Where("(orders.late_status IS NULL OR orders.late_status <> orders.status)")
+		}
+
+		if filters.ReleasedBy != nil {
+			query.Where("release_at <= ?", *filters.ReleasedBy)
+		}
+
+		if filters.ReleaseSort {
+			query.Order("release_at")
+		}
+
 		if filters.PrioritySort {
 			query.Joins("LEFT JOIN order_positions op ON op.order_id = orders.id").Order("op.position")
 		}
 	}
 
-	if err := query.FindInBatches(&batch, 10000, func(tx2 *gorm.DB, batchSize int) error {
-		orders = append(orders, batch...)
-		return nil
-	}).Error; err != nil {
+	return query
+}
+
+func (o *orderStore) Save(ctx context.Context, order domain.Order) (_ *domain.Order, err error) {
+	db, span := startSpan(ctx, o.db, "OrderStore.Save", orderIDAttribute(order.ID))
+	defer func() { endSpan(span, err) }()
+
+	dbOrder := OrderToDB(order)
+	// Late columns are only written by MarkLate, so saving an order never flags it for its new status
+	omitted := []string{clause.Associations, "late_at", "late_status"}
+
+	isNew := dbOrder.ID == 0
+
+	// Save would otherwise reset the creation time of existing orders. Imported ones bring their own
+	if isNew && order.CreatedAt != nil {
+		dbOrder.CreatedAt = *order.CreatedAt
+	} else if isNew {
+		dbOrder.CreatedAt = o.clock.Now()
+	} else {
+		omitted = append(omitted, "created_at")
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		if err2 := tx.Omit(omitted...).Save(&dbOrder).Error; err2 != nil {
+			return err2
+		}
+
+		if isNew {
+			if err2 := tx.Model(&dbOrder).Association("Dishes").Replace(dbOrder.Dishes); err2 != nil {
+				return err2
+			}
+		}
+
+		if dbOrder.Delivery == nil {
+			return nil
+		}
+
+		return saveDelivery(tx, dbOrder.ID, dbOrder.Delivery)
+	})
+
+	// The only unique index a new order can hit is the one on its external reference
+	if isNew && order.External != nil && isDuplicatedKey(db, err) {
+		return nil, domain.ErrDuplicateExternalOrder
+	}
+
+	if err != nil {
 		return nil, err
 	}
 
-	results := make([]domain.Order, len(orders))
+	order.ID = dbOrder.ID
+	order.Dishes = OrderFromDB(dbOrder).Dishes
+	order.Alerts = order.KitchenAlerts()
+	span.SetAttributes(orderIDAttribute(order.ID))
 
-	for i, order := range orders {
-		results[i] = OrderFromDB(order)
+	if order.CreatedAt == nil && !dbOrder.CreatedAt.IsZero() {
+		order.CreatedAt = &dbOrder.CreatedAt
 	}
 
-	return results, nil
+	return &order, nil
 }
 
-func (o *orderStore) Save(order domain.Order) (*domain.Order, error) {
+func (o *orderStore) ReplaceDishes(ctx context.Context, order domain.Order) (_ *domain.Order, err error) {
+	db, span := startSpan(ctx, o.db, "OrderStore.ReplaceDishes", orderIDAttribute(order.ID))
+	defer func() { endSpan(span, err) }()
+
 	dbOrder := OrderToDB(order)
 
-	err := o.db.Transaction(func(tx *gorm.DB) error {
-		if err2 := tx.Omit(clause.Associations).Save(&dbOrder).Error; err2 != nil {
-			return err2
+	err = db.Transaction(func(tx *gorm.DB) error {
+		// The dishes could be replaced in the status the order had when it was read
+		result := tx.
+			Model(&models.Order{}).
+			Where("id = ? AND status = ?", order.ID, order.Status).
+			Update("updated_at", o.clock.Now())
+
+		if result.Error != nil {
+			return result.Error
 		}
 
-		if len(dbOrder.Dishes) == 0 {
-			return nil
+		if result.RowsAffected == 0 {
+			return domain.ErrInvalidOrderUpdate
 		}
 
 		return tx.Model(&dbOrder).Association("Dishes").Replace(dbOrder.Dishes)
@@ -90,28 +292,265 @@ func (o *orderStore) Save(order domain.Order) (*domain.Order, error) {
 		return nil, err
 	}
 
-	order.ID = dbOrder.ID
+	order.Dishes = OrderFromDB(dbOrder).Dishes
+	order.Alerts = order.KitchenAlerts()
+
+	return &order, nil
+}
+
+func (o *orderStore) MarkLate(ctx context.Context, id uint, status domain.OrderStatus, at time.Time) (_ bool, err error) {
+	db, span := startSpan(ctx, o.db, "OrderStore.MarkLate", orderIDAttribute(id))
+	defer func() { endSpan(span, err) }()
+
+	result := db.
+		Model(&models.Order{}).
+		Where("id = ? AND status = ?", id, status).
+		Updates(map[string]any{"late_at": at, "late_status": status})
+
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (o *orderStore) Cancel(ctx context.Context, order domain.Order) (_ *domain.Order, err error) {
+	db, span := startSpan(ctx, o.db, "OrderStore.Cancel", orderIDAttribute(order.ID))
+	defer func() { endSpan(span, err) }()
+
+	if order.Cancellation == nil {
+		return nil, domain.ErrInvalidCancellation
+	}
+
+	cancellation := cancellationToDB(order.ID, *order.Cancellation)
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		// The cancellation was allowed for the status the order had when it was read
+		result := tx.
+			Model(&models.Order{}).
+			Where("id = ? AND status = ?", order.ID, order.Cancellation.PreviousStatus).
+			Update("status", domain.OrderStatusCancelled)
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return domain.ErrInvalidOrderUpdate
+		}
+
+		return tx.Create(&cancellation).Error
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
 	return &order, nil
 }
 
+func (o *orderStore) GetWaste(ctx context.Context, from time.Time, to time.Time) (_ []domain.WasteRecord, err error) {
+	db, span := startSpan(ctx, o.db, "OrderStore.GetWaste")
+	defer func() { endSpan(span, err) }()
+
+	var records []models.WasteRecord
+
+	err = db.Where("created_at >= ? AND created_at < ?", from, to).Order("created_at, id").Find(&records).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.WasteRecord, len(records))
+
+	for i, record := range records {
+		result[i] = domain.WasteRecord{
+			ID:         record.ID,
+			OrderID:    record.OrderID,
+			Dish:       record.Dish,
+			Reason:     record.Reason,
+			RecordedAt: record.CreatedAt,
+		}
+	}
+
+	return result, nil
+}
+
+func (o *orderStore) ChangeDish(ctx context.Context, orderID uint, change domain.DishChange) (_ *domain.DishChange, err error) {
+	db, span := startSpan(ctx, o.db, "OrderStore.ChangeDish", orderIDAttribute(orderID))
+	defer func() { endSpan(span, err) }()
+
+	record := dishChangeToDB(orderID, change)
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		// The change was allowed for the statuses the order and the dish had when they were read
+		result := tx.
+			Model(&models.Order{}).
+			Where("id = ? AND status = ?", orderID, change.OrderStatus).
+			Update("updated_at", change.ChangedAt)
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return domain.ErrDishChangeNotAllowed
+		}
+
+		if change.Action == domain.DishActionAdded {
+			dish := models.OrderDish{OrderID: orderID, Name: change.Dish, Status: change.Action.Result()}
+			if err2 := tx.Create(&dish).Error; err2 != nil {
+				return err2
+			}
+
+			record.DishID = dish.ID
+			return tx.Create(&record).Error
+		}
+
+		result = tx.
+			Model(&models.OrderDish{}).
+			Where("id = ? AND order_id = ? AND status = ?", change.DishID, orderID, change.PreviousStatus).
+			Update("status", change.Action.Result())
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return domain.ErrDishChangeNotAllowed
+		}
+
+		if change.Action == domain.DishActionVoided {
+			var active int64
+
+			err2 := tx.Model(&models.OrderDish{}).
+				Where("order_id = ? AND status <> ?", orderID, domain.DishStatusVoided).
+				Count(&active).Error
+
+			if err2 != nil {
+				return err2
+			}
+
+			if active == 0 {
+				return domain.ErrLastDishVoided
+			}
+		}
+
+		return tx.Create(&record).Error
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := dishChangeFromDB(record)
+	return &result, nil
+}
+
+func (o *orderStore) GetDishHistory(ctx context.Context, orderID uint) (_ []domain.DishChange, err error) {
+	db, span := startSpan(ctx, o.db, "OrderStore.GetDishHistory", orderIDAttribute(orderID))
+	defer func() { endSpan(span, err) }()
+
+	var records []models.OrderDishChange
+
+	err = db.Where("order_id = ?", orderID).Order("created_at, id").Find(&records).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.DishChange, len(records))
+
+	for i, record := range records {
+		result[i] = dishChangeFromDB(record)
+	}
+
+	return result, nil
+}
+
+// Deliveries are updated in place, so an order always has a single delivery row
+func saveDelivery(tx *gorm.DB, orderID uint, delivery *models.OrderDelivery) error {
+	var existingID uint
+
+	err := tx.Model(&models.OrderDelivery{}).Select("id").Where("order_id = ?", orderID).Scan(&existingID).Error
+	if err != nil {
+		return err
+	}
+
+	delivery.OrderID = orderID
+
+	if existingID == 0 {
+		return tx.Create(delivery).Error
+	}
+
+	delivery.ID = existingID
+	return tx.Omit("created_at").Save(delivery).Error
+}
+
 func OrderFromDB(order models.Order) domain.Order {
 	dishes := make([]domain.Dish, len(order.Dishes))
 
 	for i, dish := range order.Dishes {
 		dishes[i] = domain.Dish{
-			Name: dish.Name,
+			ID:     dish.ID,
+			Name:   dish.Name,
+			Status: dish.Status,
 		}
 	}
 
-	return domain.Order{
+	result := domain.Order{
 		ID:     order.ID,
 		Status: order.Status,
 		NewOrder: domain.NewOrder{
-			Dishes: dishes,
-			Source: order.Source,
-			Time:   order.Time,
+			Dishes:     dishes,
+			Source:     order.Source,
+			Time:       order.Time,
+			CustomerID: order.CustomerID,
+			ReadyAt:    order.ReadyAt,
+			Notes:      order.Notes,
+			Allergies:  order.Allergies,
+			Dietary:    order.Dietary,
+			TableID:    order.TableID,
 		},
+		ReleaseAt:      order.ReleaseAt,
+		CreatedAt:      &order.CreatedAt,
+		TableSessionID: order.TableSessionID,
 	}
+
+	result.Alerts = result.KitchenAlerts()
+
+	if order.ExternalProvider != nil && order.ExternalID != nil {
+		result.External = &domain.ExternalReference{
+			Provider: *order.ExternalProvider,
+			ID:       *order.ExternalID,
+		}
+	}
+
+	if order.LateAt != nil && order.LateStatus == order.Status {
+		result.Late = true
+		result.LateAt = order.LateAt
+	}
+
+	if order.Cancellation != nil {
+		result.Cancellation = cancellationFromDB(*order.Cancellation)
+	}
+
+	if order.Delivery != nil {
+		result.Delivery = &domain.DeliveryDetails{
+			Address:      order.Delivery.Address,
+			ContactName:  order.Delivery.ContactName,
+			ContactPhone: order.Delivery.ContactPhone,
+			FeeCents:     order.Delivery.FeeCents,
+		}
+
+		if order.Delivery.CourierName != "" {
+			result.Courier = &domain.Courier{
+				Name:       order.Delivery.CourierName,
+				Phone:      order.Delivery.CourierPhone,
+				AssignedAt: order.Delivery.CourierAssignedAt,
+			}
+		}
+	}
+
+	return result
 }
 
 func OrderToDB(order domain.Order) models.Order {
@@ -119,15 +558,45 @@ func OrderToDB(order domain.Order) models.Order {
 
 	for i, dish := range order.Dishes {
 		dishes[i] = models.OrderDish{
-			Name: dish.Name,
+			Model:  gorm.Model{ID: dish.ID},
+			Name:   dish.Name,
+			Status: dish.Status,
 		}
 	}
 
 	dbOrder := models.Order{
-		Dishes: dishes,
-		Source: order.Source,
-		Status: order.Status,
-		Time:   order.Time,
+		Dishes:         dishes,
+		Source:         order.Source,
+		Status:         order.Status,
+		Time:           order.Time,
+		CustomerID:     order.CustomerID,
+		ReadyAt:        order.ReadyAt,
+		ReleaseAt:      order.ReleaseAt,
+		Notes:          order.Notes,
+		Allergies:      order.Allergies,
+		Dietary:        order.Dietary,
+		TableID:        order.TableID,
+		TableSessionID: order.TableSessionID,
+	}
+
+	if order.External != nil {
+		dbOrder.ExternalProvider = &order.External.Provider
+		dbOrder.ExternalID = &order.External.ID
+	}
+
+	if order.Delivery != nil {
+		dbOrder.Delivery = &models.OrderDelivery{
+			Address:      order.Delivery.Address,
+			ContactName:  order.Delivery.ContactName,
+			ContactPhone: order.Delivery.ContactPhone,
+			FeeCents:     order.Delivery.FeeCents,
+		}
+
+		if order.Courier != nil {
+			dbOrder.Delivery.CourierName = order.Courier.Name
+			dbOrder.Delivery.CourierPhone = order.Courier.Phone
+			dbOrder.Delivery.CourierAssignedAt = order.Courier.AssignedAt
+		}
 	}
 
 	if order.ID > 0 {
@@ -138,3 +607,87 @@ func OrderToDB(order domain.Order) models.Order {
 
 	return dbOrder
 }
+
+func cancellationFromDB(cancellation models.OrderCancellation) *domain.Cancellation {
+	waste := make([]domain.Dish, len(cancellation.Waste))
+
+	for i, record := range cancellation.Waste {
+		waste[i] = domain.Dish{Name: record.Dish}
+	}
+
+	return &domain.Cancellation{
+		CancelOrder: domain.CancelOrder{
+			Reason: cancellation.Reason,
+			Note:   cancellation.Note,
+		},
+		CancelledBy:    cancellation.CancelledBy,
+		PreviousStatus: cancellation.PreviousStatus,
+		CancelledAt:    cancellation.CreatedAt,
+		Waste:          waste,
+		Refund: domain.Refund{
+			Type:             cancellation.RefundType,
+			DeliveryFeeCents: cancellation.RefundDeliveryFeeCents,
+		},
+	}
+}
+
+func cancellationToDB(orderID uint, cancellation domain.Cancellation) models.OrderCancellation {
+	waste := make([]models.WasteRecord, len(cancellation.Waste))
+
+	for i, dish := range cancellation.Waste {
+		waste[i] = models.WasteRecord{
+			Model:   gorm.Model{CreatedAt: cancellation.CancelledAt},
+			OrderID: orderID,
+			Dish:    dish.Name,
+			Reason:  cancellation.Reason,
+		}
+	}
+
+	return models.OrderCancellation{
+		Model:                  gorm.Model{CreatedAt: cancellation.CancelledAt},
+		OrderID:                orderID,
+		Reason:                 cancellation.Reason,
+		Note:                   cancellation.Note,
+		CancelledBy:            cancellation.CancelledBy,
+		PreviousStatus:         cancellation.PreviousStatus,
+		RefundType:             cancellation.Refund.Type,
+		RefundDeliveryFeeCents: cancellation.Refund.DeliveryFeeCents,
+		Waste:                  waste,
+	}
+}
+
+func dishChangeFromDB(change models.OrderDishChange) domain.DishChange {
+	return domain.DishChange{
+		ID:             change.ID,
+		DishID:         change.DishID,
+		Dish:           change.Dish,
+		Action:         change.Action,
+		PreviousStatus: change.PreviousStatus,
+		OrderStatus:    change.OrderStatus,
+		Reason:         change.Reason,
+		Note:           change.Note,
+		ChangedAt:      change.CreatedAt,
+	}
+}
+
+func dishChangeToDB(orderID uint, change domain.DishChange) models.OrderDishChange {
+	return models.OrderDishChange{
+		Model:          gorm.Model{CreatedAt: change.ChangedAt},
+		OrderID:        orderID,
+		DishID:         change.DishID,
+		Dish:           change.Dish,
+		Action:         change.Action,
+		PreviousStatus: change.PreviousStatus,
+		OrderStatus:    change.OrderStatus,
+		Reason:         change.Reason,
+		Note:           change.Note,
+	}
+}
+
+func isDuplicatedKey(db *gorm.DB, err error) bool {
+	if translator, ok := db.Dialector.(gorm.ErrorTranslator); ok {
+		err = translator.Translate(err)
+	}
+
+	return errors.Is(err, gorm.ErrDuplicatedKey)
+}
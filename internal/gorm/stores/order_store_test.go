@@ -1,10 +1,13 @@
 package stores_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/fakeclock"
 	"github.com/danbrato999/yuno-gveloz/domain/services"
 	"github.com/danbrato999/yuno-gveloz/internal/gorm/models"
 	"github.com/danbrato999/yuno-gveloz/internal/gorm/stores"
@@ -19,6 +22,7 @@ var _ = Describe("OrderStore", func() {
 		testDB          *gorm.DB
 		existingOrderID uint
 		store           services.OrderStore
+		clock           *fakeclock.Clock
 	)
 
 	BeforeEach(func() {
@@ -27,10 +31,19 @@ var _ = Describe("OrderStore", func() {
 		Expect(testDB).NotTo(BeNil())
 		Expect(err).NotTo(HaveOccurred())
 
-		err = testDB.AutoMigrate(&models.Order{}, &models.OrderDish{}, &models.OrderPosition{})
+		err = testDB.AutoMigrate(
+			&models.Order{},
+			&models.OrderDish{},
+			&models.OrderDelivery{},
+			&models.OrderPosition{},
+			&models.OrderCancellation{},
+			&models.WasteRecord{},
+			&models.OrderDishChange{},
+		)
 		Expect(err).NotTo(HaveOccurred())
 
-		store = stores.NewOrderStore(testDB)
+		clock = fakeclock.New(time.Date(2025, 2, 10, 12, 0, 0, 0, time.UTC))
+		store = stores.NewOrderStore(testDB, clock)
 
 		testOrder := models.Order{
 			Dishes: []models.OrderDish{
@@ -51,7 +64,7 @@ var _ = Describe("OrderStore", func() {
 	Describe("FindByID", func() {
 		When("the order exists", func() {
 			It("returns the order", func() {
-				order, err := store.FindByID(existingOrderID)
+				order, err := store.FindByID(context.Background(), existingOrderID)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(order).NotTo(BeNil())
 				Expect(order.ID).To(Equal(existingOrderID))
@@ -65,7 +78,7 @@ var _ = Describe("OrderStore", func() {
 
 		When("the order does not exist", func() {
 			It("returns nil and no error", func() {
-				order, err := store.FindByID(999)
+				order, err := store.FindByID(context.Background(), 999)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(order).To(BeNil())
 			})
@@ -75,7 +88,7 @@ var _ = Describe("OrderStore", func() {
 	Describe("GetAll", func() {
 		When("there are orders", func() {
 			It("returns all orders", func() {
-				orders, err := store.GetAll(nil)
+				orders, err := store.GetAll(context.Background(), nil)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(orders).NotTo(BeEmpty())
 				Expect(orders).To(HaveLen(1))
@@ -100,7 +113,7 @@ var _ = Describe("OrderStore", func() {
 			})
 
 			It("returns all orders", func() {
-				orders, err := store.GetAll(nil)
+				orders, err := store.GetAll(context.Background(), nil)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(orders).NotTo(BeEmpty())
 				Expect(len(orders)).To(Equal(count + 1))
@@ -110,13 +123,27 @@ var _ = Describe("OrderStore", func() {
 		When("filtering by status", func() {
 			It("returns only matching orders", func() {
 				filters := &domain.OrderFilters{AnyStatus: []domain.OrderStatus{domain.OrderStatusPending}}
-				orders, err := store.GetAll(filters)
+				orders, err := store.GetAll(context.Background(), filters)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(orders).To(HaveLen(1))
 				Expect(orders[0].Dishes).NotTo(BeEmpty())
 			})
 		})
 
+		When("filtering by source", func() {
+			It("returns only matching orders", func() {
+				source := domain.OrderSourcePhone
+				orders, err := store.GetAll(context.Background(), &domain.OrderFilters{Source: &source})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(orders).To(HaveLen(1))
+
+				source = domain.OrderSourceDelivery
+				orders, err = store.GetAll(context.Background(), &domain.OrderFilters{Source: &source})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(orders).To(BeEmpty())
+			})
+		})
+
 		When("ordering by priority", func() {
 			var positions []models.OrderPosition
 
@@ -148,7 +175,7 @@ var _ = Describe("OrderStore", func() {
 			})
 
 			It("should return orders in the right order", func() {
-				result, err := store.GetAll(&domain.OrderFilters{PrioritySort: true})
+				result, err := store.GetAll(context.Background(), &domain.OrderFilters{PrioritySort: true})
 				Expect(err).ToNot(HaveOccurred())
 				Expect(result).To(HaveLen(4))
 
@@ -156,18 +183,395 @@ var _ = Describe("OrderStore", func() {
 					Expect(result[i].ID).To(Equal(pos.OrderID))
 				}
 			})
+
+			It("should iterate every order once, in batches by id", func() {
+				var ids []uint
+
+				err := store.Iterate(context.Background(), &domain.OrderFilters{PrioritySort: true}, 1, func(batch []domain.Order) error {
+					ids = append(ids, batch[0].ID)
+					return nil
+				})
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ids).To(Equal([]uint{existingOrderID, positions[3].OrderID, positions[0].OrderID, positions[1].OrderID}))
+			})
+
+			It("should return a page of the sorted orders", func() {
+				result, err := store.GetAll(context.Background(), &domain.OrderFilters{PrioritySort: true, Limit: 2, Offset: 1})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(HaveLen(2))
+				Expect(result[0].ID).To(Equal(positions[1].OrderID))
+				Expect(result[1].ID).To(Equal(positions[2].OrderID))
+			})
+
+			It("should count every matching order, whatever the page", func() {
+				count, err := store.Count(context.Background(), &domain.OrderFilters{PrioritySort: true, Limit: 2, Offset: 1})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(count).To(Equal(4))
+			})
+		})
+
+		When("filtering by customer", func() {
+			var customerID uint = 7
+
+			BeforeEach(func() {
+				order := models.Order{
+					Dishes:     []models.OrderDish{{Name: "Soup"}},
+					Source:     domain.OrderSourcePhone,
+					Status:     domain.OrderStatusDone,
+					Time:       time.Now(),
+					CustomerID: &customerID,
+				}
+
+				Expect(testDB.Save(&order).Error).ToNot(HaveOccurred())
+			})
+
+			It("returns only the customer's orders", func() {
+				orders, err := store.GetAll(context.Background(), &domain.OrderFilters{CustomerID: &customerID})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(orders).To(HaveLen(1))
+				Expect(*orders[0].CustomerID).To(Equal(customerID))
+				Expect(orders[0].Dishes[0].Name).To(Equal("Soup"))
+			})
+		})
+
+		When("filtering by release time", func() {
+			var now time.Time
+
+			BeforeEach(func() {
+				now = time.Now()
+
+				for _, minutes := range []int{30, -5, -10} {
+					releaseAt := now.Add(time.Duration(minutes) * time.Minute)
+					order := models.Order{
+						Dishes:    []models.OrderDish{{Name: "Cake"}},
+						Source:    domain.OrderSourcePhone,
+						Status:    domain.OrderStatusScheduled,
+						Time:      now,
+						ReleaseAt: &releaseAt,
+					}
+
+					Expect(testDB.Save(&order).Error).ToNot(HaveOccurred())
+				}
+			})
+
+			It("returns the due scheduled orders sorted by release time", func() {
+				filters := &domain.OrderFilters{}
+				domain.FilterReleasedBy(now)(filters)
+
+				orders, err := store.GetAll(context.Background(), filters)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(orders).To(HaveLen(2))
+				Expect(*orders[0].ReleaseAt).To(BeTemporally("~", now.Add(-10*time.Minute)))
+				Expect(*orders[1].ReleaseAt).To(BeTemporally("~", now.Add(-5*time.Minute)))
+			})
+		})
+
+		When("filtering by lateness", func() {
+			var lateOrderID uint
+
+			BeforeEach(func() {
+				order := models.Order{
+					Dishes: []models.OrderDish{{Name: "Stew"}},
+					Source: domain.OrderSourceInPerson,
+					Status: domain.OrderStatusPending,
+					Time:   time.Now(),
+				}
+
+				Expect(testDB.Save(&order).Error).ToNot(HaveOccurred())
+				lateOrderID = order.ID
+
+				marked, err := store.MarkLate(context.Background(), lateOrderID, domain.OrderStatusPending, clock.Now())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(marked).To(BeTrue())
+			})
+
+			It("returns only the late orders", func() {
+				late := true
+				orders, err := store.GetAll(context.Background(), &domain.OrderFilters{Late: &late})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(orders).To(HaveLen(1))
+				Expect(orders[0].ID).To(Equal(lateOrderID))
+				Expect(orders[0].Late).To(BeTrue())
+				Expect(*orders[0].LateAt).To(BeTemporally("==", clock.Now()))
+			})
+
+			It("stops considering orders late once their status changes", func() {
+				order, err := store.FindByID(context.Background(), lateOrderID)
+				Expect(err).NotTo(HaveOccurred())
+
+				order.Status = domain.OrderStatusPreparing
+				_, err = store.Save(context.Background(), *order)
+				Expect(err).NotTo(HaveOccurred())
+
+				late, onTime := true, false
+				orders, err := store.GetAll(context.Background(), &domain.OrderFilters{Late: &late})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(orders).To(BeEmpty())
+
+				orders, err = store.GetAll(context.Background(), &domain.OrderFilters{Late: &onTime})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(orders).To(HaveLen(2))
+			})
 		})
 
 		When("no orders match the filter", func() {
 			It("returns an empty list", func() {
 				filters := &domain.OrderFilters{AnyStatus: []domain.OrderStatus{domain.OrderStatusDone}}
-				orders, err := store.GetAll(filters)
+				orders, err := store.GetAll(context.Background(), filters)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(orders).To(BeEmpty())
 			})
 		})
 	})
 
+	Describe("Iterate", func() {
+		It("hands the matching orders in batches", func() {
+			for i := 0; i < 4; i++ {
+				order := models.Order{
+					Status: domain.OrderStatusDone,
+					Source: domain.OrderSourcePhone,
+					Dishes: []models.OrderDish{{Name: fmt.Sprintf("dish-%d", i)}},
+				}
+
+				Expect(testDB.Save(&order).Error).To(Succeed())
+			}
+
+			var sizes []int
+
+			filters := &domain.OrderFilters{AnyStatus: []domain.OrderStatus{domain.OrderStatusDone}}
+			err := store.Iterate(context.Background(), filters, 3, func(batch []domain.Order) error {
+				sizes = append(sizes, len(batch))
+				Expect(batch[0].Dishes).To(HaveLen(1))
+				return nil
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sizes).To(Equal([]int{3, 1}))
+		})
+
+		It("stops on the first error", func() {
+			testErr := errors.New("write failed")
+
+			err := store.Iterate(context.Background(), nil, 1, func(batch []domain.Order) error {
+				return testErr
+			})
+
+			Expect(err).To(MatchError(testErr))
+		})
+
+		It("stops once the context is cancelled", func() {
+			for i := 0; i < 3; i++ {
+				Expect(testDB.Save(&models.Order{Status: domain.OrderStatusDone, Source: domain.OrderSourcePhone}).Error).To(Succeed())
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			batches := 0
+			err := store.Iterate(ctx, nil, 1, func(batch []domain.Order) error {
+				batches++
+				cancel()
+				return nil
+			})
+
+			Expect(err).To(MatchError(context.Canceled))
+			Expect(batches).To(Equal(1))
+		})
+	})
+
+	Describe("FindByExternalID", func() {
+		var external *domain.ExternalReference
+
+		BeforeEach(func() {
+			external = &domain.ExternalReference{Provider: "fooddash", ID: "FD-1"}
+
+			_, err := store.Save(context.Background(), domain.Order{
+				Status: domain.OrderStatusPending,
+				NewOrder: domain.NewOrder{
+					Dishes:   []domain.Dish{{Name: "Pizza"}},
+					Source:   domain.OrderSourceDelivery,
+					Time:     time.Now(),
+					External: external,
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns the order received from the provider", func() {
+			order, err := store.FindByExternalID(context.Background(), "fooddash", "FD-1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(order).NotTo(BeNil())
+			Expect(order.External).To(Equal(external))
+		})
+
+		It("returns nil for other providers", func() {
+			order, err := store.FindByExternalID(context.Background(), "other", "FD-1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(order).To(BeNil())
+		})
+
+		It("rejects orders received twice", func() {
+			_, err := store.Save(context.Background(), domain.Order{
+				Status: domain.OrderStatusPending,
+				NewOrder: domain.NewOrder{
+					Dishes:   []domain.Dish{{Name: "Pizza"}},
+					Time:     time.Now(),
+					External: external,
+				},
+			})
+			Expect(err).To(Equal(domain.ErrDuplicateExternalOrder))
+		})
+	})
+
+	Describe("MarkLate", func() {
+		It("does not flag orders that changed their status", func() {
+			marked, err := store.MarkLate(context.Background(), existingOrderID, domain.OrderStatusPreparing, clock.Now())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(marked).To(BeFalse())
+
+			order, err := store.FindByID(context.Background(), existingOrderID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(order.Late).To(BeFalse())
+		})
+	})
+
+	Describe("Cancel", func() {
+		var cancellation domain.Cancellation
+
+		BeforeEach(func() {
+			cancellation = domain.Cancellation{
+				CancelOrder:    domain.CancelOrder{Reason: domain.CancellationReasonOther, Note: "Wrong table"},
+				CancelledBy:    domain.StaffRoleManager,
+				PreviousStatus: domain.OrderStatusPending,
+				CancelledAt:    clock.Now(),
+				Waste:          []domain.Dish{{Name: "Pizza"}, {Name: "Pasta"}},
+				Refund:         domain.Refund{Type: domain.RefundPartial},
+			}
+		})
+
+		cancel := func() (*domain.Order, error) {
+			order, err := store.FindByID(context.Background(), existingOrderID)
+			Expect(err).NotTo(HaveOccurred())
+
+			order.Status = domain.OrderStatusCancelled
+			order.Cancellation = &cancellation
+
+			return store.Cancel(context.Background(), *order)
+		}
+
+		It("stores the cancellation along with its waste", func() {
+			_, err := cancel()
+			Expect(err).NotTo(HaveOccurred())
+
+			fetchedOrder, err := store.FindByID(context.Background(), existingOrderID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fetchedOrder.Status).To(Equal(domain.OrderStatusCancelled))
+			Expect(fetchedOrder.Cancellation).NotTo(BeNil())
+			Expect(fetchedOrder.Cancellation.CancelledAt).To(BeTemporally("==", cancellation.CancelledAt))
+
+			fetchedOrder.Cancellation.CancelledAt = cancellation.CancelledAt
+			Expect(*fetchedOrder.Cancellation).To(Equal(cancellation))
+
+			waste, err := store.GetWaste(context.Background(), clock.Now().Add(-time.Minute), clock.Now().Add(time.Minute))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(waste).To(HaveLen(2))
+			Expect(waste[0].OrderID).To(Equal(existingOrderID))
+			Expect(waste[0].Dish).To(Equal("Pizza"))
+			Expect(waste[0].Reason).To(Equal(domain.CancellationReasonOther))
+
+			waste, err = store.GetWaste(context.Background(), clock.Now().Add(time.Minute), clock.Now().Add(time.Hour))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(waste).To(BeEmpty())
+		})
+
+		It("does not cancel orders whose status changed since they were read", func() {
+			cancellation.PreviousStatus = domain.OrderStatusPreparing
+
+			_, err := cancel()
+			Expect(err).To(MatchError(domain.ErrInvalidOrderUpdate))
+
+			var count int64
+			Expect(testDB.Model(&models.WasteRecord{}).Count(&count).Error).To(Succeed())
+			Expect(count).To(BeZero())
+		})
+	})
+
+	Describe("ChangeDish", func() {
+		var order *domain.Order
+
+		BeforeEach(func() {
+			var err error
+			order, err = store.FindByID(context.Background(), existingOrderID)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		change := func(dishID uint, action domain.DishAction) (*domain.DishChange, error) {
+			dishChange, err := order.ChangeDish(dishID, "Salad", action, clock.Now())
+			Expect(err).NotTo(HaveOccurred())
+
+			return store.ChangeDish(context.Background(), existingOrderID, dishChange)
+		}
+
+		It("adds dishes and records the change", func() {
+			added, err := change(0, domain.DishActionAdded)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(added.DishID).NotTo(BeZero())
+
+			fetchedOrder, err := store.FindByID(context.Background(), existingOrderID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fetchedOrder.Dishes).To(HaveLen(3))
+			Expect(fetchedOrder.Dishes[2]).To(Equal(domain.Dish{ID: added.DishID, Name: "Salad", Status: domain.DishStatusPending}))
+
+			history, err := store.GetDishHistory(context.Background(), existingOrderID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(history).To(HaveLen(1))
+			Expect(history[0].ChangedAt).To(BeTemporally("==", clock.Now()))
+
+			history[0].ChangedAt = added.ChangedAt
+			Expect(history[0]).To(Equal(*added))
+		})
+
+		It("changes the status of the dish, keeping its id", func() {
+			dishID := order.Dishes[0].ID
+
+			_, err := change(dishID, domain.DishActionVoided)
+			Expect(err).NotTo(HaveOccurred())
+
+			fetchedOrder, err := store.FindByID(context.Background(), existingOrderID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fetchedOrder.Dishes[0].ID).To(Equal(dishID))
+			Expect(fetchedOrder.Dishes[0].Status).To(Equal(domain.DishStatusVoided))
+		})
+
+		It("does not change dishes whose status changed since they were read", func() {
+			_, err := change(order.Dishes[0].ID, domain.DishActionVoided)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = change(order.Dishes[0].ID, domain.DishActionVoided)
+			Expect(err).To(MatchError(domain.ErrDishChangeNotAllowed))
+
+			history, err := store.GetDishHistory(context.Background(), existingOrderID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(history).To(HaveLen(1))
+		})
+
+		It("does not void the last dish of the order", func() {
+			_, err := change(order.Dishes[0].ID, domain.DishActionVoided)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = change(order.Dishes[1].ID, domain.DishActionVoided)
+			Expect(err).To(MatchError(domain.ErrLastDishVoided))
+		})
+
+		It("does not change dishes of orders whose status changed since they were read", func() {
+			Expect(testDB.Model(&models.Order{}).Where("id = ?", existingOrderID).Update("status", domain.OrderStatusReady).Error).To(Succeed())
+
+			_, err := change(0, domain.DishActionAdded)
+			Expect(err).To(MatchError(domain.ErrDishChangeNotAllowed))
+		})
+	})
+
 	Describe("Save", func() {
 		When("saving a new order", func() {
 			It("persists the order", func() {
@@ -180,49 +584,181 @@ var _ = Describe("OrderStore", func() {
 					Status: domain.OrderStatusPending,
 				}
 
-				savedOrder, err := store.Save(newOrder)
+				savedOrder, err := store.Save(context.Background(), newOrder)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(savedOrder.ID).NotTo(BeZero())
+				Expect(*savedOrder.CreatedAt).To(Equal(clock.Now()))
+				Expect(savedOrder.Dishes[0].ID).NotTo(BeZero())
+				Expect(savedOrder.Dishes[0].Status).To(Equal(domain.DishStatusPending))
 
-				fetchedOrder, err := store.FindByID(savedOrder.ID)
+				fetchedOrder, err := store.FindByID(context.Background(), savedOrder.ID)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(fetchedOrder).NotTo(BeNil())
 				Expect(fetchedOrder.Dishes).To(HaveLen(1))
+				Expect(*fetchedOrder.CreatedAt).To(BeTemporally("==", clock.Now()))
+			})
+		})
+
+		When("saving an imported order", func() {
+			It("keeps its creation time", func() {
+				createdAt := clock.Now().Add(-24 * time.Hour)
+				newOrder := domain.Order{
+					NewOrder: domain.NewOrder{
+						Dishes: []domain.Dish{{Name: "Burger"}},
+						Source: domain.OrderSourcePhone,
+						Time:   createdAt,
+					},
+					Status:    domain.OrderStatusDone,
+					CreatedAt: &createdAt,
+				}
+
+				savedOrder, err := store.Save(context.Background(), newOrder)
+				Expect(err).NotTo(HaveOccurred())
+
+				fetchedOrder, err := store.FindByID(context.Background(), savedOrder.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(*fetchedOrder.CreatedAt).To(BeTemporally("==", createdAt))
+			})
+		})
+
+		When("saving an order with dietary needs", func() {
+			It("persists the notes, allergies and diet and sums them up for the kitchen", func() {
+				newOrder := domain.Order{
+					NewOrder: domain.NewOrder{
+						Dishes:    []domain.Dish{{Name: "Burger"}},
+						Source:    domain.OrderSourcePhone,
+						Time:      time.Now(),
+						Notes:     "No onions",
+						Allergies: []domain.Allergy{{Allergen: domain.AllergenSesame, Severe: true}},
+						Dietary:   []domain.DietaryFlag{domain.DietaryHalal},
+					},
+					Status: domain.OrderStatusPending,
+				}
+
+				savedOrder, err := store.Save(context.Background(), newOrder)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(savedOrder.Alerts).To(Equal([]string{"severe allergy: sesame", "diet: halal", "note: No onions"}))
+
+				fetchedOrder, err := store.FindByID(context.Background(), savedOrder.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fetchedOrder.Notes).To(Equal("No onions"))
+				Expect(fetchedOrder.Allergies).To(Equal(newOrder.Allergies))
+				Expect(fetchedOrder.Dietary).To(Equal(newOrder.Dietary))
+				Expect(fetchedOrder.Alerts).To(Equal(savedOrder.Alerts))
+			})
+		})
+
+		When("saving a delivery order", func() {
+			It("persists the delivery details and courier", func() {
+				assignedAt := time.Now()
+				newOrder := domain.Order{
+					NewOrder: domain.NewOrder{
+						Dishes: []domain.Dish{{Name: "Burger"}},
+						Source: domain.OrderSourceDelivery,
+						Time:   time.Now(),
+						Delivery: &domain.DeliveryDetails{
+							Address:      "Main St 123",
+							ContactName:  "Ana",
+							ContactPhone: "5550000",
+							FeeCents:     350,
+						},
+					},
+					Status: domain.OrderStatusPending,
+				}
+
+				savedOrder, err := store.Save(context.Background(), newOrder)
+				Expect(err).NotTo(HaveOccurred())
+
+				savedOrder.Status = domain.OrderStatusAwaitingCourier
+				savedOrder.Courier = &domain.Courier{Name: "Carla", AssignedAt: &assignedAt}
+				_, err = store.Save(context.Background(), *savedOrder)
+				Expect(err).NotTo(HaveOccurred())
+
+				fetchedOrder, err := store.FindByID(context.Background(), savedOrder.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(*fetchedOrder.Delivery).To(Equal(*newOrder.Delivery))
+				Expect(fetchedOrder.Courier.Name).To(Equal("Carla"))
+
+				var count int64
+				err = testDB.Model(&models.OrderDelivery{}).Where("order_id = ?", savedOrder.ID).Count(&count).Error
+				Expect(err).NotTo(HaveOccurred())
+				Expect(count).To(BeNumerically("==", 1))
 			})
 		})
 
 		When("updating an existing order", func() {
 			It("updates the order main attributes", func() {
-				testOrder, err := store.FindByID(existingOrderID)
+				testOrder, err := store.FindByID(context.Background(), existingOrderID)
 				Expect(err).NotTo(HaveOccurred())
 
 				testOrder.Dishes = nil
 				testOrder.Status = domain.OrderStatusDone
 
-				updatedOrder, err := store.Save(*testOrder)
+				createdAt := *testOrder.CreatedAt
+				clock.Advance(time.Hour)
+
+				updatedOrder, err := store.Save(context.Background(), *testOrder)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(updatedOrder.Status).To(Equal(domain.OrderStatusDone))
 
+				fetchedOrder, err := store.FindByID(context.Background(), existingOrderID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(*fetchedOrder.CreatedAt).To(BeTemporally("==", createdAt))
+
 				var count int64
 				err = testDB.Model(&models.OrderDish{}).Where("order_id = ?", existingOrderID).Count(&count).Error
 				Expect(err).NotTo(HaveOccurred())
 				Expect(count).To(BeNumerically("==", 2))
 			})
 
-			It("updates the order dishes", func() {
-				testOrder, err := store.FindByID(existingOrderID)
+			It("keeps the stored dishes and their ids", func() {
+				testOrder, err := store.FindByID(context.Background(), existingOrderID)
 				Expect(err).NotTo(HaveOccurred())
 
-				testOrder.Dishes = testOrder.Dishes[1:]
-				updatedOrder, err := store.Save(*testOrder)
+				storedDishes := testOrder.Dishes
+				testOrder.Status = domain.OrderStatusPreparing
+				testOrder.Dishes = []domain.Dish{{Name: "Lasagna", Status: domain.DishStatusPending}}
+
+				_, err = store.Save(context.Background(), *testOrder)
 				Expect(err).NotTo(HaveOccurred())
-				Expect(updatedOrder.Status).To(Equal(domain.OrderStatusPending))
 
-				var count int64
-				err = testDB.Model(&models.OrderDish{}).Where("order_id = ?", existingOrderID).Count(&count).Error
+				fetchedOrder, err := store.FindByID(context.Background(), existingOrderID)
 				Expect(err).NotTo(HaveOccurred())
-				Expect(count).To(BeNumerically("==", 1))
+				Expect(fetchedOrder.Dishes).To(Equal(storedDishes))
 			})
 		})
 	})
+
+	Describe("ReplaceDishes", func() {
+		It("replaces the order dishes with new ones", func() {
+			testOrder, err := store.FindByID(context.Background(), existingOrderID)
+			Expect(err).NotTo(HaveOccurred())
+
+			testOrder.Dishes = []domain.Dish{{Name: "Lasagna", Status: domain.DishStatusPending}}
+
+			updatedOrder, err := store.ReplaceDishes(context.Background(), *testOrder)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedOrder.Dishes).To(HaveLen(1))
+			Expect(updatedOrder.Dishes[0].ID).NotTo(BeZero())
+
+			fetchedOrder, err := store.FindByID(context.Background(), existingOrderID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fetchedOrder.Dishes).To(Equal(updatedOrder.Dishes))
+		})
+
+		It("fails once the order left the status it was read in", func() {
+			testOrder, err := store.FindByID(context.Background(), existingOrderID)
+			Expect(err).NotTo(HaveOccurred())
+
+			testOrder.Status = domain.OrderStatusScheduled
+			testOrder.Dishes = []domain.Dish{{Name: "Lasagna", Status: domain.DishStatusPending}}
+
+			_, err = store.ReplaceDishes(context.Background(), *testOrder)
+			Expect(err).To(Equal(domain.ErrInvalidOrderUpdate))
+
+			fetchedOrder, err := store.FindByID(context.Background(), existingOrderID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fetchedOrder.Dishes).To(HaveLen(2))
+		})
+	})
 })
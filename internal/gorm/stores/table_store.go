@@ -0,0 +1,216 @@
+package stores
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/danbrato999/yuno-gveloz/internal/gorm/models"
+	"gorm.io/gorm"
+)
+
+type tableStore struct {
+	db *gorm.DB
+}
+
+func NewTableStore(db *gorm.DB) services.TableStore {
+	return &tableStore{
+		db: db,
+	}
+}
+
+func (t *tableStore) Save(ctx context.Context, table domain.Table) (_ *domain.Table, err error) {
+	db, span := startSpan(ctx, t.db, "TableStore.Save", tableIDAttribute(table.ID))
+	defer func() { endSpan(span, err) }()
+
+	dbTable := models.Table{
+		Model: gorm.Model{ID: table.ID},
+		Name:  table.Name,
+		Area:  table.Area,
+		Seats: table.Seats,
+	}
+
+	if err = db.Save(&dbTable).Error; err != nil {
+		return nil, err
+	}
+
+	table.ID = dbTable.ID
+	return &table, nil
+}
+
+func (t *tableStore) FindByID(ctx context.Context, id uint) (_ *domain.Table, err error) {
+	db, span := startSpan(ctx, t.db, "TableStore.FindByID", tableIDAttribute(id))
+	defer func() { endSpan(span, err) }()
+
+	return findTable(db.Where("id = ?", id))
+}
+
+func (t *tableStore) FindByName(ctx context.Context, name string) (_ *domain.Table, err error) {
+	db, span := startSpan(ctx, t.db, "TableStore.FindByName")
+	defer func() { endSpan(span, err) }()
+
+	return findTable(db.Where("name = ?", name))
+}
+
+func findTable(query *gorm.DB) (*domain.Table, error) {
+	var table models.Table
+
+	if err := query.First(&table).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	result := tableFromDB(table)
+	return &result, nil
+}
+
+func (t *tableStore) GetAll(ctx context.Context) (_ []domain.Table, err error) {
+	db, span := startSpan(ctx, t.db, "TableStore.GetAll")
+	defer func() { endSpan(span, err) }()
+
+	var tables []models.Table
+
+	if err = db.Order("area, name").Find(&tables).Error; err != nil {
+		return nil, err
+	}
+
+	results := make([]domain.Table, len(tables))
+
+	for i, table := range tables {
+		results[i] = tableFromDB(table)
+	}
+
+	return results, nil
+}
+
+func (t *tableStore) OpenSession(ctx context.Context, session domain.TableSession) (_ *domain.TableSession, err error) {
+	db, span := startSpan(ctx, t.db, "TableStore.OpenSession", tableIDAttribute(session.TableID))
+	defer func() { endSpan(span, err) }()
+
+	dbSession := models.TableSession{
+		TableID:  session.TableID,
+		Covers:   session.Covers,
+		Status:   domain.TableSessionStatusOpen,
+		OpenedAt: session.OpenedAt,
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		var open int64
+
+		err := tx.Model(&models.TableSession{}).
+			Where("table_id = ? AND status = ?", session.TableID, domain.TableSessionStatusOpen).
+			Count(&open).Error
+
+		if err != nil {
+			return err
+		}
+
+		if open > 0 {
+			return domain.ErrTableOccupied
+		}
+
+		return tx.Create(&dbSession).Error
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := tableSessionFromDB(dbSession)
+	return &result, nil
+}
+
+func (t *tableStore) FindSession(ctx context.Context, id uint) (_ *domain.TableSession, err error) {
+	db, span := startSpan(ctx, t.db, "TableStore.FindSession", tableSessionIDAttribute(id))
+	defer func() { endSpan(span, err) }()
+
+	return findTableSession(db.Where("id = ?", id))
+}
+
+func (t *tableStore) FindOpenSession(ctx context.Context, tableID uint) (_ *domain.TableSession, err error) {
+	db, span := startSpan(ctx, t.db, "TableStore.FindOpenSession", tableIDAttribute(tableID))
+	defer func() { endSpan(span, err) }()
+
+	return findTableSession(db.Where("table_id = ? AND status = ?", tableID, domain.TableSessionStatusOpen))
+}
+
+func findTableSession(query *gorm.DB) (*domain.TableSession, error) {
+	var session models.TableSession
+
+	if err := query.First(&session).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	result := tableSessionFromDB(session)
+	return &result, nil
+}
+
+func (t *tableStore) GetOpenSessions(ctx context.Context) (_ []domain.TableSession, err error) {
+	db, span := startSpan(ctx, t.db, "TableStore.GetOpenSessions")
+	defer func() { endSpan(span, err) }()
+
+	var sessions []models.TableSession
+
+	if err = db.Where("status = ?", domain.TableSessionStatusOpen).Order("id").Find(&sessions).Error; err != nil {
+		return nil, err
+	}
+
+	results := make([]domain.TableSession, len(sessions))
+
+	for i, session := range sessions {
+		results[i] = tableSessionFromDB(session)
+	}
+
+	return results, nil
+}
+
+func (t *tableStore) CloseSession(ctx context.Context, id uint, closedAt time.Time) (_ *domain.TableSession, err error) {
+	db, span := startSpan(ctx, t.db, "TableStore.CloseSession", tableSessionIDAttribute(id))
+	defer func() { endSpan(span, err) }()
+
+	result := db.
+		Model(&models.TableSession{}).
+		Where("id = ? AND status = ?", id, domain.TableSessionStatusOpen).
+		Updates(map[string]any{"status": domain.TableSessionStatusClosed, "closed_at": closedAt})
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, domain.ErrTableNotSeated
+	}
+
+	return findTableSession(db.Where("id = ?", id))
+}
+
+func tableFromDB(table models.Table) domain.Table {
+	return domain.Table{
+		ID: table.ID,
+		NewTable: domain.NewTable{
+			Name:  table.Name,
+			Area:  table.Area,
+			Seats: table.Seats,
+		},
+	}
+}
+
+func tableSessionFromDB(session models.TableSession) domain.TableSession {
+	return domain.TableSession{
+		ID:       session.ID,
+		TableID:  session.TableID,
+		Covers:   session.Covers,
+		Status:   session.Status,
+		OpenedAt: session.OpenedAt,
+		ClosedAt: session.ClosedAt,
+	}
+}
@@ -0,0 +1,151 @@
+package stores_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/danbrato999/yuno-gveloz/internal/gorm/models"
+	"github.com/danbrato999/yuno-gveloz/internal/gorm/stores"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("TableStore", func() {
+	var (
+		testDB   *gorm.DB
+		store    services.TableStore
+		table    *domain.Table
+		openedAt time.Time
+	)
+
+	ctx := context.Background()
+
+	BeforeEach(func() {
+		var err error
+		testDB, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(testDB.AutoMigrate(
+			&models.Table{},
+			&models.TableSession{},
+			&models.Order{},
+			&models.OrderDish{},
+			&models.OrderDelivery{},
+			&models.OrderCancellation{},
+			&models.WasteRecord{},
+		)).To(Succeed())
+
+		store = stores.NewTableStore(testDB)
+		openedAt = time.Date(2025, 2, 10, 20, 0, 0, 0, time.UTC)
+
+		table, err = store.Save(ctx, domain.Table{NewTable: domain.NewTable{Name: "T1", Area: "terrace", Seats: 4}})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("tables", func() {
+		It("finds the tables by id and name", func() {
+			byID, err := store.FindByID(ctx, table.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(byID).To(Equal(table))
+
+			byName, err := store.FindByName(ctx, "T1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(byName).To(Equal(table))
+
+			missing, err := store.FindByID(ctx, 999)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(missing).To(BeNil())
+		})
+
+		It("returns the tables sorted by area and name", func() {
+			for _, newTable := range []domain.NewTable{{Name: "B2", Area: "bar", Seats: 2}, {Name: "B1", Area: "bar", Seats: 2}} {
+				_, err := store.Save(ctx, domain.Table{NewTable: newTable})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			tables, err := store.GetAll(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tables).To(HaveLen(3))
+			Expect([]string{tables[0].Name, tables[1].Name, tables[2].Name}).To(Equal([]string{"B1", "B2", "T1"}))
+		})
+	})
+
+	Describe("sessions", func() {
+		var session *domain.TableSession
+
+		BeforeEach(func() {
+			var err error
+			session, err = store.OpenSession(ctx, domain.TableSession{TableID: table.ID, Covers: 3, OpenedAt: openedAt})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("opens a single session per table", func() {
+			Expect(session.ID).NotTo(BeZero())
+			Expect(session.Status).To(Equal(domain.TableSessionStatusOpen))
+
+			_, err := store.OpenSession(ctx, domain.TableSession{TableID: table.ID, Covers: 2, OpenedAt: openedAt})
+			Expect(err).To(Equal(domain.ErrTableOccupied))
+
+			open, err := store.FindOpenSession(ctx, table.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(open.ID).To(Equal(session.ID))
+			Expect(open.Covers).To(Equal(uint(3)))
+			Expect(open.OpenedAt).To(BeTemporally("==", openedAt))
+
+			sessions, err := store.GetOpenSessions(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sessions).To(HaveLen(1))
+		})
+
+		It("closes the session, freeing the table", func() {
+			closedAt := openedAt.Add(time.Hour)
+
+			closed, err := store.CloseSession(ctx, session.ID, closedAt)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(closed.Status).To(Equal(domain.TableSessionStatusClosed))
+			Expect(*closed.ClosedAt).To(BeTemporally("==", closedAt))
+
+			_, err = store.CloseSession(ctx, session.ID, closedAt)
+			Expect(err).To(Equal(domain.ErrTableNotSeated))
+
+			open, err := store.FindOpenSession(ctx, table.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(open).To(BeNil())
+
+			found, err := store.FindSession(ctx, session.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found.Status).To(Equal(domain.TableSessionStatusClosed))
+
+			_, err = store.OpenSession(ctx, domain.TableSession{TableID: table.ID, Covers: 2, OpenedAt: closedAt})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("lets the order store filter the orders of the session", func() {
+			orderStore := stores.NewOrderStore(testDB, domain.SystemClock)
+
+			for _, sessionID := range []*uint{&session.ID, nil} {
+				_, err := orderStore.Save(ctx, domain.Order{
+					Status: domain.OrderStatusPending,
+					NewOrder: domain.NewOrder{
+						Source:  domain.OrderSourceInPerson,
+						Dishes:  []domain.Dish{{Name: "Wine"}},
+						Time:    openedAt,
+						TableID: &table.ID,
+					},
+					TableSessionID: sessionID,
+				})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			orders, err := orderStore.GetAll(ctx, &domain.OrderFilters{TableSessionIDs: []uint{session.ID}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(orders).To(HaveLen(1))
+			Expect(*orders[0].TableID).To(Equal(table.ID))
+			Expect(*orders[0].TableSessionID).To(Equal(session.ID))
+		})
+	})
+})
@@ -0,0 +1,56 @@
+package stores
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+var tracer = otel.Tracer("github.com/danbrato999/yuno-gveloz/internal/gorm/stores")
+
+// startSpan returns the db bound to the span context, so the queries run under the span
+func startSpan(ctx context.Context, db *gorm.DB, name string, attributes ...attribute.KeyValue) (*gorm.DB, trace.Span) {
+	ctx, span := tracer.Start(
+		ctx,
+		name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBSystemSqlite),
+		trace.WithAttributes(attributes...),
+	)
+
+	return db.WithContext(ctx), span
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+func orderIDAttribute(id uint) attribute.KeyValue {
+	return attribute.Int64("order.id", int64(id))
+}
+
+func tableIDAttribute(id uint) attribute.KeyValue {
+	return attribute.Int64("table.id", int64(id))
+}
+
+func tableSessionIDAttribute(id uint) attribute.KeyValue {
+	return attribute.Int64("table_session.id", int64(id))
+}
+
+func customerIDAttribute(id uint) attribute.KeyValue {
+	return attribute.Int64("customer.id", int64(id))
+}
+
+func webhookIDAttribute(id uint) attribute.KeyValue {
+	return attribute.Int64("webhook.id", int64(id))
+}
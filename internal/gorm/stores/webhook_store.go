@@ -0,0 +1,180 @@
+package stores
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/danbrato999/yuno-gveloz/internal/gorm/models"
+	"gorm.io/gorm"
+)
+
+type webhookStore struct {
+	db *gorm.DB
+}
+
+func NewWebhookStore(db *gorm.DB) services.WebhookStore {
+	return &webhookStore{
+		db: db,
+	}
+}
+
+func (w *webhookStore) Save(ctx context.Context, webhook domain.Webhook) (_ *domain.Webhook, err error) {
+	db, span := startSpan(ctx, w.db, "WebhookStore.Save", webhookIDAttribute(webhook.ID))
+	defer func() { endSpan(span, err) }()
+
+	dbWebhook := WebhookToDB(webhook)
+	query := db
+
+	if dbWebhook.ID > 0 {
+		query = query.Omit("created_at")
+	}
+
+	if err = query.Save(&dbWebhook).Error; err != nil {
+		return nil, err
+	}
+
+	result := WebhookFromDB(dbWebhook)
+	return &result, nil
+}
+
+func (w *webhookStore) FindByID(ctx context.Context, id uint) (_ *domain.Webhook, err error) {
+	db, span := startSpan(ctx, w.db, "WebhookStore.FindByID", webhookIDAttribute(id))
+	defer func() { endSpan(span, err) }()
+
+	var webhook models.Webhook
+
+	err = db.First(&webhook, id).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	result := WebhookFromDB(webhook)
+	return &result, nil
+}
+
+func (w *webhookStore) GetAll(ctx context.Context) (_ []domain.Webhook, err error) {
+	db, span := startSpan(ctx, w.db, "WebhookStore.GetAll")
+	defer func() { endSpan(span, err) }()
+
+	var webhooks []models.Webhook
+
+	if err = db.Order("id").Find(&webhooks).Error; err != nil {
+		return nil, err
+	}
+
+	results := make([]domain.Webhook, len(webhooks))
+
+	for i, webhook := range webhooks {
+		results[i] = WebhookFromDB(webhook)
+	}
+
+	return results, nil
+}
+
+func (w *webhookStore) Delete(ctx context.Context, id uint) (err error) {
+	db, span := startSpan(ctx, w.db, "WebhookStore.Delete", webhookIDAttribute(id))
+	defer func() { endSpan(span, err) }()
+
+	return db.Delete(&models.Webhook{}, id).Error
+}
+
+func (w *webhookStore) SaveDeadLetter(
+	ctx context.Context,
+	deadLetter domain.WebhookDeadLetter,
+) (_ *domain.WebhookDeadLetter, err error) {
+	db, span := startSpan(ctx, w.db, "WebhookStore.SaveDeadLetter", webhookIDAttribute(deadLetter.WebhookID))
+	defer func() { endSpan(span, err) }()
+
+	dbDeadLetter := models.WebhookDeadLetter{
+		WebhookID: deadLetter.WebhookID,
+		EventType: deadLetter.EventType,
+		Payload:   deadLetter.Payload,
+		Attempts:  deadLetter.Attempts,
+		LastError: deadLetter.LastError,
+		FailedAt:  deadLetter.FailedAt,
+	}
+
+	if err = db.Create(&dbDeadLetter).Error; err != nil {
+		return nil, err
+	}
+
+	deadLetter.ID = dbDeadLetter.ID
+	return &deadLetter, nil
+}
+
+func (w *webhookStore) GetDeadLetters(ctx context.Context) (_ []domain.WebhookDeadLetter, err error) {
+	db, span := startSpan(ctx, w.db, "WebhookStore.GetDeadLetters")
+	defer func() { endSpan(span, err) }()
+
+	var deadLetters []models.WebhookDeadLetter
+
+	if err = db.Order("failed_at desc, id desc").Find(&deadLetters).Error; err != nil {
+		return nil, err
+	}
+
+	results := make([]domain.WebhookDeadLetter, len(deadLetters))
+
+	for i, deadLetter := range deadLetters {
+		results[i] = domain.WebhookDeadLetter{
+			ID:        deadLetter.ID,
+			WebhookID: deadLetter.WebhookID,
+			EventType: deadLetter.EventType,
+			Payload:   deadLetter.Payload,
+			Attempts:  deadLetter.Attempts,
+			LastError: deadLetter.LastError,
+			FailedAt:  deadLetter.FailedAt,
+		}
+	}
+
+	return results, nil
+}
+
+func WebhookFromDB(webhook models.Webhook) domain.Webhook {
+	var eventTypes []domain.OrderEventType
+
+	for _, eventType := range strings.Split(webhook.EventTypes, ",") {
+		if eventType != "" {
+			eventTypes = append(eventTypes, domain.OrderEventType(eventType))
+		}
+	}
+
+	return domain.Webhook{
+		ID: webhook.ID,
+		NewWebhook: domain.NewWebhook{
+			URL:        webhook.URL,
+			Secret:     webhook.Secret,
+			EventTypes: eventTypes,
+		},
+		CreatedAt: &webhook.CreatedAt,
+	}
+}
+
+func WebhookToDB(webhook domain.Webhook) models.Webhook {
+	eventTypes := make([]string, len(webhook.EventTypes))
+
+	for i, eventType := range webhook.EventTypes {
+		eventTypes[i] = string(eventType)
+	}
+
+	dbWebhook := models.Webhook{
+		URL:        webhook.URL,
+		Secret:     webhook.Secret,
+		EventTypes: strings.Join(eventTypes, ","),
+	}
+
+	if webhook.ID > 0 {
+		dbWebhook.Model = gorm.Model{
+			ID: webhook.ID,
+		}
+	}
+
+	return dbWebhook
+}
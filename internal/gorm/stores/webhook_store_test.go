@@ -0,0 +1,116 @@
+package stores_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/danbrato999/yuno-gveloz/internal/gorm/models"
+	"github.com/danbrato999/yuno-gveloz/internal/gorm/stores"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("WebhookStore", func() {
+	var (
+		testDB            *gorm.DB
+		existingWebhookID uint
+		store             services.WebhookStore
+	)
+
+	BeforeEach(func() {
+		var err error
+		testDB, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(testDB.AutoMigrate(&models.Webhook{}, &models.WebhookDeadLetter{})).To(Succeed())
+
+		store = stores.NewWebhookStore(testDB)
+
+		existing := models.Webhook{
+			URL:        "https://partner.example.com/hooks",
+			Secret:     "s3cr3t",
+			EventTypes: "order.created,order.cancelled",
+		}
+		Expect(testDB.Save(&existing).Error).NotTo(HaveOccurred())
+
+		existingWebhookID = existing.ID
+	})
+
+	Describe("FindByID", func() {
+		It("returns the webhook with its event types", func() {
+			webhook, err := store.FindByID(context.Background(), existingWebhookID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(webhook).NotTo(BeNil())
+			Expect(webhook.Secret).To(Equal("s3cr3t"))
+			Expect(webhook.EventTypes).To(Equal([]domain.OrderEventType{
+				domain.OrderEventCreated,
+				domain.OrderEventCancelled,
+			}))
+		})
+
+		It("returns nil when the webhook does not exist", func() {
+			webhook, err := store.FindByID(context.Background(), 999)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(webhook).To(BeNil())
+		})
+	})
+
+	Describe("Save", func() {
+		It("creates new webhooks", func() {
+			webhook, err := store.Save(context.Background(), domain.Webhook{
+				NewWebhook: domain.NewWebhook{
+					URL:        "https://loyalty.example.com/orders",
+					Secret:     "other",
+					EventTypes: []domain.OrderEventType{domain.OrderEventStatusChanged},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(webhook.ID).NotTo(BeZero())
+			Expect(webhook.CreatedAt).NotTo(BeNil())
+
+			webhooks, err := store.GetAll(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(webhooks).To(HaveLen(2))
+			Expect(webhooks[1].EventTypes).To(Equal([]domain.OrderEventType{domain.OrderEventStatusChanged}))
+		})
+	})
+
+	Describe("Delete", func() {
+		It("removes the webhook", func() {
+			Expect(store.Delete(context.Background(), existingWebhookID)).To(Succeed())
+
+			webhooks, err := store.GetAll(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(webhooks).To(BeEmpty())
+		})
+	})
+
+	Describe("Dead letters", func() {
+		It("returns the most recent failures first", func() {
+			failedAt := time.Date(2025, 2, 10, 12, 0, 0, 0, time.UTC)
+
+			for i := range 2 {
+				_, err := store.SaveDeadLetter(context.Background(), domain.WebhookDeadLetter{
+					WebhookID: existingWebhookID,
+					EventType: domain.OrderEventCreated,
+					Payload:   `{"type":"order.created"}`,
+					Attempts:  5,
+					LastError: "unexpected response status 503",
+					FailedAt:  failedAt.Add(time.Duration(i) * time.Minute),
+				})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			deadLetters, err := store.GetDeadLetters(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deadLetters).To(HaveLen(2))
+			Expect(deadLetters[0].FailedAt).To(BeTemporally("==", failedAt.Add(time.Minute)))
+			Expect(deadLetters[0].WebhookID).To(Equal(existingWebhookID))
+			Expect(deadLetters[0].Attempts).To(Equal(5))
+		})
+	})
+})
@@ -0,0 +1,53 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+)
+
+type resolverError struct {
+	message string
+	code    string
+}
+
+func (e resolverError) Error() string {
+	return e.message
+}
+
+func (e resolverError) Extensions() map[string]interface{} {
+	return map[string]interface{}{"code": e.code}
+}
+
+func invalidInput(message string) error {
+	return resolverError{message: message, code: "BAD_REQUEST"}
+}
+
+func (r *Resolver) orderError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrOrderNotFound):
+		return resolverError{message: err.Error(), code: "NOT_FOUND"}
+	case errors.Is(err, domain.ErrInvalidOrderUpdate),
+		errors.Is(err, domain.ErrCompleteOrderUpdate),
+		errors.Is(err, domain.ErrUnknownOrderCustomer),
+		errors.Is(err, domain.ErrNotDeliveryOrder),
+		errors.Is(err, domain.ErrNotDineInOrder),
+		errors.Is(err, domain.ErrUnknownOrderTable),
+		errors.Is(err, domain.ErrTableNotSeated),
+		errors.Is(err, domain.ErrIncorrectOrderQueueing),
+		errors.Is(err, domain.ErrInvalidCancellation),
+		errors.Is(err, domain.ErrCancellationWithoutReason),
+		errors.Is(err, domain.ErrInvalidOrderDiet),
+		errors.Is(err, domain.ErrTooManyDishes):
+		return invalidInput(err.Error())
+	case errors.Is(err, domain.ErrAllergenConflict):
+		return resolverError{message: err.Error(), code: "ALLERGEN_CONFLICT"}
+	case errors.Is(err, domain.ErrCancellationNotAllowed):
+		return resolverError{message: err.Error(), code: "FORBIDDEN"}
+	default:
+		r.logger.ErrorContext(ctx, "graphql resolver failed", slog.Any("error", err))
+		return resolverError{message: "internal error", code: "INTERNAL"}
+	}
+}
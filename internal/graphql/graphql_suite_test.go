@@ -0,0 +1,17 @@
+package graphql_test
+
+import (
+	"log/slog"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// Log lines are only printed for the failed tests
+var testLogger = slog.New(slog.NewTextHandler(GinkgoWriter, nil))
+
+func TestGraphql(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Graphql Suite")
+}
@@ -0,0 +1,182 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/graph-gophers/graphql-go"
+)
+
+type orderResolver struct {
+	root  *Resolver
+	order domain.Order
+}
+
+func (r *orderResolver) ID() graphql.ID {
+	return toID(r.order.ID)
+}
+
+func (r *orderResolver) Status() string {
+	return toEnum(string(r.order.Status))
+}
+
+func (r *orderResolver) Source() string {
+	return toEnum(string(r.order.Source))
+}
+
+func (r *orderResolver) Time() graphql.Time {
+	return graphql.Time{Time: r.order.Time}
+}
+
+func (r *orderResolver) Dishes() []*dishResolver {
+	dishes := make([]*dishResolver, len(r.order.Dishes))
+
+	for i, dish := range r.order.Dishes {
+		dishes[i] = &dishResolver{dish: dish}
+	}
+
+	return dishes
+}
+
+func (r *orderResolver) CustomerID() *graphql.ID {
+	if r.order.CustomerID == nil {
+		return nil
+	}
+
+	id := toID(*r.order.CustomerID)
+	return &id
+}
+
+func (r *orderResolver) TableID() *graphql.ID {
+	if r.order.TableID == nil {
+		return nil
+	}
+
+	id := toID(*r.order.TableID)
+	return &id
+}
+
+func (r *orderResolver) TableSessionID() *graphql.ID {
+	if r.order.TableSessionID == nil {
+		return nil
+	}
+
+	id := toID(*r.order.TableSessionID)
+	return &id
+}
+
+func (r *orderResolver) Delivery() *deliveryResolver {
+	if r.order.Delivery == nil {
+		return nil
+	}
+
+	return &deliveryResolver{delivery: *r.order.Delivery}
+}
+
+func (r *orderResolver) Courier() *courierResolver {
+	if r.order.Courier == nil {
+		return nil
+	}
+
+	return &courierResolver{courier: *r.order.Courier}
+}
+
+func (r *orderResolver) ReadyAt() *graphql.Time {
+	return toTime(r.order.ReadyAt)
+}
+
+func (r *orderResolver) ReleaseAt() *graphql.Time {
+	return toTime(r.order.ReleaseAt)
+}
+
+func (r *orderResolver) CreatedAt() *graphql.Time {
+	return toTime(r.order.CreatedAt)
+}
+
+func (r *orderResolver) Late() bool {
+	return r.order.Late
+}
+
+func (r *orderResolver) Notes() *string {
+	if r.order.Notes == "" {
+		return nil
+	}
+
+	return &r.order.Notes
+}
+
+func (r *orderResolver) Allergies() []*allergyResolver {
+	allergies := make([]*allergyResolver, len(r.order.Allergies))
+
+	for i, allergy := range r.order.Allergies {
+		allergies[i] = &allergyResolver{allergy: allergy}
+	}
+
+	return allergies
+}
+
+func (r *orderResolver) Dietary() []string {
+	flags := make([]string, len(r.order.Dietary))
+
+	for i, flag := range r.order.Dietary {
+		flags[i] = toEnum(string(flag))
+	}
+
+	return flags
+}
+
+func (r *orderResolver) Alerts() []string {
+	if r.order.Alerts == nil {
+		return []string{}
+	}
+
+	return r.order.Alerts
+}
+
+func (r *orderResolver) Warnings() []*dietaryWarningResolver {
+	warnings := make([]*dietaryWarningResolver, len(r.order.Warnings))
+
+	for i, warning := range r.order.Warnings {
+		warnings[i] = &dietaryWarningResolver{warning: warning}
+	}
+
+	return warnings
+}
+
+func (r *orderResolver) Cancellation() *cancellationResolver {
+	if r.order.Cancellation == nil {
+		return nil
+	}
+
+	return &cancellationResolver{cancellation: *r.order.Cancellation}
+}
+
+// The history and queue position are only loaded when requested, so listing orders stays a single query
+func (r *orderResolver) StatusHistory(ctx context.Context) ([]*statusChangeResolver, error) {
+	order, err := r.root.orderService.FindByID(ctx, r.order.ID)
+	if err != nil {
+		return nil, r.root.orderError(ctx, err)
+	}
+
+	history := make([]*statusChangeResolver, len(order.StatusHistory))
+
+	for i, change := range order.StatusHistory {
+		history[i] = &statusChangeResolver{change: change}
+	}
+
+	return history, nil
+}
+
+func (r *orderResolver) QueuePosition(ctx context.Context) (*queuePositionResolver, error) {
+	queued, err := r.root.queueService.GetOrderPosition(ctx, r.order.ID)
+	if errors.Is(err, domain.ErrOrderNotQueued) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, r.root.orderError(ctx, err)
+	}
+
+	return &queuePositionResolver{queued: *queued}, nil
+}
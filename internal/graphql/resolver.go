@@ -0,0 +1,473 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/graph-gophers/graphql-go"
+)
+
+const subscriptionEventsBuffer = 100
+
+type orderFilterInput struct {
+	Active     *bool
+	Scheduled  *bool
+	Late       *bool
+	Status     *[]string
+	Source     *string
+	CustomerID *graphql.ID
+}
+
+type dishInput struct {
+	Name string
+}
+
+type deliveryInput struct {
+	Address      string
+	ContactName  string
+	ContactPhone string
+	FeeCents     *int32
+}
+
+type courierInput struct {
+	Name  string
+	Phone *string
+}
+
+type cancelOrderInput struct {
+	Reason string
+	Note   *string
+}
+
+type createOrderInput struct {
+	Time          graphql.Time
+	Dishes        []dishInput
+	Source        string
+	CustomerID    *graphql.ID
+	CustomerPhone *string
+	Delivery      *deliveryInput
+	ReadyAt       *graphql.Time
+	Notes         *string
+	Allergies     *[]allergyInput
+	Dietary       *[]string
+	TableID       *graphql.ID
+}
+
+type allergyInput struct {
+	Allergen string
+	Severe   *bool
+}
+
+func (r *Resolver) Order(ctx context.Context, args struct{ ID graphql.ID }) (*orderResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := r.orderService.FindByID(ctx, id)
+	if err != nil {
+		return nil, r.orderError(ctx, err)
+	}
+
+	if order == nil {
+		return nil, nil
+	}
+
+	return &orderResolver{root: r, order: order.Order}, nil
+}
+
+func (r *Resolver) Orders(ctx context.Context, args struct {
+	Filter *orderFilterInput
+	Limit  int32
+	Offset int32
+}) (*orderPageResolver, error) {
+	if args.Limit < 0 || args.Offset < 0 {
+		return nil, invalidInput("limit and offset cannot be negative")
+	}
+
+	filters, err := orderFilters(args.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := r.orderService.CountMany(ctx, filters...)
+	if err != nil {
+		return nil, r.orderError(ctx, err)
+	}
+
+	start := min(int(args.Offset), total)
+	end := min(start+int(args.Limit), total)
+
+	page := &orderPageResolver{
+		items:      make([]*orderResolver, 0, end-start),
+		totalCount: total,
+		hasMore:    end < total,
+	}
+
+	// The store treats a zero limit as no limit at all
+	if start == end {
+		return page, nil
+	}
+
+	orders, err := r.orderService.FindMany(ctx, append(filters, domain.FilterPage(end-start, start))...)
+	if err != nil {
+		return nil, r.orderError(ctx, err)
+	}
+
+	for _, order := range orders {
+		page.items = append(page.items, &orderResolver{root: r, order: order})
+	}
+
+	return page, nil
+}
+
+func (r *Resolver) Queue(ctx context.Context) ([]*queuedOrderResolver, error) {
+	queue, err := r.queueService.GetQueue(ctx)
+	if err != nil {
+		return nil, r.orderError(ctx, err)
+	}
+
+	result := make([]*queuedOrderResolver, len(queue))
+
+	for i, queued := range queue {
+		result[i] = &queuedOrderResolver{root: r, queued: queued}
+	}
+
+	return result, nil
+}
+
+func (r *Resolver) CreateOrder(ctx context.Context, args struct{ Input createOrderInput }) (*orderResolver, error) {
+	newOrder, err := newOrderFromInput(args.Input)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := r.orderService.CreateOrder(ctx, newOrder)
+	if err != nil {
+		return nil, r.orderError(ctx, err)
+	}
+
+	return &orderResolver{root: r, order: *order}, nil
+}
+
+func (r *Resolver) UpdateOrderStatus(ctx context.Context, args struct {
+	ID     graphql.ID
+	Status string
+}) (*orderResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := r.orderService.UpdateStatus(ctx, id, domain.OrderStatus(fromEnum(args.Status)))
+	if err != nil {
+		return nil, r.orderError(ctx, err)
+	}
+
+	return &orderResolver{root: r, order: *order}, nil
+}
+
+func (r *Resolver) CancelOrder(ctx context.Context, args struct {
+	ID    graphql.ID
+	Input cancelOrderInput
+}) (*orderResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	request := domain.CancelOrder{Reason: domain.CancellationReason(fromEnum(args.Input.Reason))}
+	if args.Input.Note != nil {
+		request.Note = *args.Input.Note
+	}
+
+	order, err := r.orderService.Cancel(ctx, id, request, services.StaffRoleFrom(ctx))
+	if err != nil {
+		return nil, r.orderError(ctx, err)
+	}
+
+	return &orderResolver{root: r, order: *order}, nil
+}
+
+func (r *Resolver) UpdateOrderDishes(ctx context.Context, args struct {
+	ID     graphql.ID
+	Dishes []dishInput
+}) (*orderResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	dishes, err := dishesFromInput(args.Dishes)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := r.orderService.UpdateDishes(ctx, id, dishes)
+	if err != nil {
+		return nil, r.orderError(ctx, err)
+	}
+
+	return &orderResolver{root: r, order: *order}, nil
+}
+
+func (r *Resolver) PrioritizeOrder(ctx context.Context, args struct {
+	ID      graphql.ID
+	AfterID graphql.ID
+}) (bool, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return false, err
+	}
+
+	afterID, err := parseID(args.AfterID)
+	if err != nil {
+		return false, err
+	}
+
+	if err = r.orderService.Prioritize(ctx, id, afterID); err != nil {
+		return false, r.orderError(ctx, err)
+	}
+
+	return true, nil
+}
+
+func (r *Resolver) AssignCourier(ctx context.Context, args struct {
+	ID      graphql.ID
+	Courier courierInput
+}) (*orderResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if args.Courier.Name == "" {
+		return nil, invalidInput("courier name is required")
+	}
+
+	courier := domain.Courier{Name: args.Courier.Name}
+	if args.Courier.Phone != nil {
+		courier.Phone = *args.Courier.Phone
+	}
+
+	order, err := r.orderService.AssignCourier(ctx, id, courier)
+	if err != nil {
+		return nil, r.orderError(ctx, err)
+	}
+
+	return &orderResolver{root: r, order: *order}, nil
+}
+
+func (r *Resolver) RescheduleOrder(ctx context.Context, args struct {
+	ID      graphql.ID
+	ReadyAt graphql.Time
+}) (*orderResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := r.orderService.Reschedule(ctx, id, args.ReadyAt.Time)
+	if err != nil {
+		return nil, r.orderError(ctx, err)
+	}
+
+	return &orderResolver{root: r, order: *order}, nil
+}
+
+func (r *Resolver) OrderChanged(ctx context.Context, args struct{ Types *[]string }) (<-chan *orderEventResolver, error) {
+	types := make(map[domain.OrderEventType]bool)
+
+	if args.Types != nil {
+		for _, value := range *args.Types {
+			eventType := domain.OrderEventType(value)
+			if !eventType.IsValid() {
+				return nil, invalidInput("unknown event type " + value)
+			}
+
+			types[eventType] = true
+		}
+	}
+
+	events, unsubscribe := r.subscriber.Subscribe(subscriptionEventsBuffer)
+	result := make(chan *orderEventResolver)
+
+	go func() {
+		defer unsubscribe()
+		defer close(result)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+
+				if len(types) > 0 && !types[event.Type] {
+					continue
+				}
+
+				select {
+				case result <- &orderEventResolver{root: r, event: event}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return result, nil
+}
+
+func orderFilters(input *orderFilterInput) ([]domain.OrderFilterFn, error) {
+	var filters []domain.OrderFilterFn
+
+	if input == nil {
+		return filters, nil
+	}
+
+	if input.Active != nil && *input.Active {
+		filters = append(filters, domain.FilterActive)
+	}
+
+	if input.Scheduled != nil && *input.Scheduled {
+		filters = append(filters, domain.FilterScheduled)
+	}
+
+	if input.Late != nil {
+		late := *input.Late
+		filters = append(filters, func(filter *domain.OrderFilters) {
+			filter.Late = &late
+		})
+	}
+
+	if input.Status != nil && len(*input.Status) > 0 {
+		statuses := make([]domain.OrderStatus, len(*input.Status))
+		for i, value := range *input.Status {
+			statuses[i] = domain.OrderStatus(fromEnum(value))
+		}
+
+		filters = append(filters, domain.FilterByStatus(statuses...))
+	}
+
+	if input.Source != nil {
+		filters = append(filters, domain.FilterBySource(domain.OrderSource(fromEnum(*input.Source))))
+	}
+
+	if input.CustomerID != nil {
+		customerID, err := parseID(*input.CustomerID)
+		if err != nil {
+			return nil, err
+		}
+
+		filters = append(filters, domain.FilterByCustomer(customerID))
+	}
+
+	return filters, nil
+}
+
+func dishesFromInput(input []dishInput) ([]domain.Dish, error) {
+	if len(input) == 0 {
+		return nil, invalidInput("at least one dish is required")
+	}
+
+	dishes := make([]domain.Dish, len(input))
+
+	for i, dish := range input {
+		if dish.Name == "" {
+			return nil, invalidInput("dish name is required")
+		}
+
+		dishes[i] = domain.Dish{Name: dish.Name}
+	}
+
+	return dishes, nil
+}
+
+// newOrderFromInput applies the same validations the REST API gets from its request bindings
+func newOrderFromInput(input createOrderInput) (domain.NewOrder, error) {
+	dishes, err := dishesFromInput(input.Dishes)
+	if err != nil {
+		return domain.NewOrder{}, err
+	}
+
+	order := domain.NewOrder{
+		Time:   input.Time.Time,
+		Dishes: dishes,
+		Source: domain.OrderSource(fromEnum(input.Source)),
+	}
+
+	if input.CustomerID != nil {
+		customerID, err := parseID(*input.CustomerID)
+		if err != nil {
+			return domain.NewOrder{}, err
+		}
+
+		order.CustomerID = &customerID
+	}
+
+	if input.CustomerPhone != nil {
+		order.CustomerPhone = *input.CustomerPhone
+	}
+
+	if input.TableID != nil {
+		tableID, err := parseID(*input.TableID)
+		if err != nil {
+			return domain.NewOrder{}, err
+		}
+
+		order.TableID = &tableID
+	}
+
+	if input.ReadyAt != nil {
+		order.ReadyAt = &input.ReadyAt.Time
+	}
+
+	if input.Notes != nil {
+		if len(*input.Notes) > 500 {
+			return domain.NewOrder{}, invalidInput("notes can't be longer than 500 characters")
+		}
+
+		order.Notes = *input.Notes
+	}
+
+	if input.Allergies != nil {
+		for _, allergy := range *input.Allergies {
+			order.Allergies = append(order.Allergies, domain.Allergy{
+				Allergen: domain.Allergen(fromEnum(allergy.Allergen)),
+				Severe:   allergy.Severe != nil && *allergy.Severe,
+			})
+		}
+	}
+
+	if input.Dietary != nil {
+		for _, flag := range *input.Dietary {
+			order.Dietary = append(order.Dietary, domain.DietaryFlag(fromEnum(flag)))
+		}
+	}
+
+	if delivery := input.Delivery; delivery != nil {
+		if delivery.Address == "" || delivery.ContactName == "" || delivery.ContactPhone == "" {
+			return domain.NewOrder{}, invalidInput("delivery address and contact are required")
+		}
+
+		order.Delivery = &domain.DeliveryDetails{
+			Address:      delivery.Address,
+			ContactName:  delivery.ContactName,
+			ContactPhone: delivery.ContactPhone,
+		}
+
+		if delivery.FeeCents != nil {
+			if *delivery.FeeCents < 0 {
+				return domain.NewOrder{}, invalidInput("delivery fee cannot be negative")
+			}
+
+			order.Delivery.FeeCents = uint(*delivery.FeeCents)
+		}
+	}
+
+	return order, nil
+}
@@ -0,0 +1,37 @@
+package graphql
+
+import (
+	_ "embed"
+	"log/slog"
+
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/graph-gophers/graphql-go"
+)
+
+//go:embed schema.graphql
+var schema string
+
+const maxDepth = 10
+
+type Resolver struct {
+	orderService services.OrderService
+	queueService services.QueueService
+	subscriber   services.EventSubscriber
+	logger       *slog.Logger
+}
+
+func NewSchema(
+	orderService services.OrderService,
+	queueService services.QueueService,
+	subscriber services.EventSubscriber,
+	logger *slog.Logger,
+) *graphql.Schema {
+	resolver := &Resolver{
+		orderService: orderService,
+		queueService: queueService,
+		subscriber:   subscriber,
+		logger:       logger,
+	}
+
+	return graphql.MustParseSchema(schema, resolver, graphql.MaxDepth(maxDepth))
+}
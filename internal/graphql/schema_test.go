@@ -0,0 +1,356 @@
+package graphql_test
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
+	internalGraphql "github.com/danbrato999/yuno-gveloz/internal/graphql"
+	"github.com/graph-gophers/graphql-go"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Schema", func() {
+	var (
+		mockOrderService *mocks.MockOrderService
+		mockQueueService *mocks.MockQueueService
+		bus              *services.EventBus
+		schema           *graphql.Schema
+		orderTime        time.Time
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockOrderService = mocks.NewMockOrderService(ctrl)
+		mockQueueService = mocks.NewMockQueueService(ctrl)
+		bus = services.NewEventBus()
+		schema = internalGraphql.NewSchema(mockOrderService, mockQueueService, bus, testLogger)
+		orderTime = time.Date(2025, 2, 10, 12, 0, 0, 0, time.UTC)
+	})
+
+	exec := func(query string, variables map[string]interface{}) (map[string]interface{}, []map[string]interface{}) {
+		response := schema.Exec(context.Background(), query, "", variables)
+
+		var data map[string]interface{}
+		if response.Data != nil {
+			Expect(json.Unmarshal(response.Data, &data)).To(Succeed())
+		}
+
+		var errs []map[string]interface{}
+		raw, err := json.Marshal(response.Errors)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(json.Unmarshal(raw, &errs)).To(Succeed())
+
+		return data, errs
+	}
+
+	Describe("order", func() {
+		It("should return the order with its history and queue position", func() {
+			order := domain.Order{
+				ID:     1,
+				Status: domain.OrderStatusPreparing,
+				NewOrder: domain.NewOrder{
+					Time:   orderTime,
+					Dishes: []domain.Dish{{ID: 5, Name: "Pizza", Status: domain.DishStatusReady}},
+					Source: domain.OrderSourceInPerson,
+				},
+			}
+
+			mockOrderService.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&domain.OrderWithStatusHistory{
+				Order: order,
+				StatusHistory: []domain.OrderStatusHistory{
+					{Status: domain.OrderStatusPending, Timestamp: &orderTime},
+					{Status: domain.OrderStatusPreparing, Timestamp: &orderTime},
+				},
+			}, nil).Times(2)
+			mockQueueService.EXPECT().GetOrderPosition(gomock.Any(), uint(1)).Return(&domain.QueuedOrder{
+				Order:          order,
+				Position:       3,
+				WaitingSeconds: 60,
+			}, nil)
+
+			data, errs := exec(`{
+				order(id: "1") {
+					id status source dishes { id name status }
+					statusHistory { status }
+					queuePosition { position waitingSeconds }
+				}
+			}`, nil)
+
+			Expect(errs).To(BeEmpty())
+			Expect(data["order"]).To(Equal(map[string]interface{}{
+				"id":            "1",
+				"status":        "PREPARING",
+				"source":        "IN_PERSON",
+				"dishes":        []interface{}{map[string]interface{}{"id": "5", "name": "Pizza", "status": "READY"}},
+				"statusHistory": []interface{}{map[string]interface{}{"status": "PENDING"}, map[string]interface{}{"status": "PREPARING"}},
+				"queuePosition": map[string]interface{}{"position": float64(3), "waitingSeconds": float64(60)},
+			}))
+		})
+
+		It("should return a null queue position when the order is not queued", func() {
+			mockOrderService.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&domain.OrderWithStatusHistory{
+				Order: domain.Order{ID: 1, Status: domain.OrderStatusDone},
+			}, nil)
+			mockQueueService.EXPECT().GetOrderPosition(gomock.Any(), uint(1)).Return(nil, domain.ErrOrderNotQueued)
+
+			data, errs := exec(`{ order(id: "1") { id queuePosition { position } } }`, nil)
+
+			Expect(errs).To(BeEmpty())
+			Expect(data["order"]).To(HaveKeyWithValue("queuePosition", BeNil()))
+		})
+
+		It("should report missing orders with a not found code", func() {
+			mockOrderService.EXPECT().FindByID(gomock.Any(), uint(5)).Return(nil, domain.ErrOrderNotFound)
+
+			_, errs := exec(`{ order(id: "5") { id } }`, nil)
+
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0]).To(HaveKeyWithValue("extensions", HaveKeyWithValue("code", "NOT_FOUND")))
+		})
+	})
+
+	Describe("orders", func() {
+		It("should filter and paginate the orders in the store", func() {
+			applyFilters := func(filters []domain.OrderFilterFn) *domain.OrderFilters {
+				applied := &domain.OrderFilters{}
+				for _, filter := range filters {
+					filter(applied)
+				}
+
+				Expect(applied.AnyStatus).To(Equal([]domain.OrderStatus{domain.OrderStatusPending}))
+				Expect(*applied.Source).To(Equal(domain.OrderSourceDelivery))
+				return applied
+			}
+
+			mockOrderService.EXPECT().CountMany(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, filters ...domain.OrderFilterFn) (int, error) {
+				applyFilters(filters)
+				return 3, nil
+			})
+			mockOrderService.EXPECT().FindMany(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, filters ...domain.OrderFilterFn) ([]domain.Order, error) {
+				applied := applyFilters(filters)
+				Expect(applied.Limit).To(Equal(1))
+				Expect(applied.Offset).To(Equal(1))
+				return []domain.Order{{ID: 2}}, nil
+			})
+
+			data, errs := exec(`{
+				orders(filter: {status: [PENDING], source: DELIVERY}, limit: 1, offset: 1) {
+					items { id } totalCount hasMore
+				}
+			}`, nil)
+
+			Expect(errs).To(BeEmpty())
+			Expect(data["orders"]).To(Equal(map[string]interface{}{
+				"items":      []interface{}{map[string]interface{}{"id": "2"}},
+				"totalCount": float64(3),
+				"hasMore":    true,
+			}))
+		})
+
+		It("should clamp the page to the orders found", func() {
+			mockOrderService.EXPECT().CountMany(gomock.Any(), gomock.Any()).Return(3, nil)
+			mockOrderService.EXPECT().FindMany(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, filters ...domain.OrderFilterFn) ([]domain.Order, error) {
+				applied := &domain.OrderFilters{}
+				for _, filter := range filters {
+					filter(applied)
+				}
+
+				Expect(applied.Limit).To(Equal(1))
+				Expect(applied.Offset).To(Equal(2))
+				return []domain.Order{{ID: 3}}, nil
+			})
+
+			data, errs := exec(`{ orders(limit: 10, offset: 2) { items { id } totalCount hasMore } }`, nil)
+
+			Expect(errs).To(BeEmpty())
+			Expect(data["orders"]).To(HaveKeyWithValue("hasMore", false))
+		})
+
+		It("should not load any order past the last page", func() {
+			mockOrderService.EXPECT().CountMany(gomock.Any(), gomock.Any()).Return(3, nil)
+
+			data, errs := exec(`{ orders(offset: 5) { items { id } totalCount hasMore } }`, nil)
+
+			Expect(errs).To(BeEmpty())
+			Expect(data["orders"]).To(Equal(map[string]interface{}{
+				"items":      []interface{}{},
+				"totalCount": float64(3),
+				"hasMore":    false,
+			}))
+		})
+	})
+
+	Describe("createOrder", func() {
+		It("should create the order through the service", func() {
+			mockOrderService.EXPECT().CreateOrder(gomock.Any(), domain.NewOrder{
+				Time:   orderTime,
+				Dishes: []domain.Dish{{Name: "Pizza"}},
+				Source: domain.OrderSourcePhone,
+			}).DoAndReturn(func(_ context.Context, request domain.NewOrder) (*domain.Order, error) {
+				return &domain.Order{ID: 1, Status: domain.OrderStatusPending, NewOrder: request}, nil
+			})
+
+			data, errs := exec(`mutation($input: CreateOrderInput!) { createOrder(input: $input) { id status } }`,
+				map[string]interface{}{
+					"input": map[string]interface{}{
+						"time":   orderTime.Format(time.RFC3339),
+						"dishes": []interface{}{map[string]interface{}{"name": "Pizza"}},
+						"source": "PHONE",
+					},
+				})
+
+			Expect(errs).To(BeEmpty())
+			Expect(data["createOrder"]).To(Equal(map[string]interface{}{"id": "1", "status": "PENDING"}))
+		})
+
+		It("should pass the dietary needs and return the kitchen alerts and warnings", func() {
+			mockOrderService.EXPECT().CreateOrder(gomock.Any(), domain.NewOrder{
+				Time:      orderTime,
+				Dishes:    []domain.Dish{{Name: "Pad Thai"}},
+				Source:    domain.OrderSourcePhone,
+				Notes:     "No cilantro",
+				Allergies: []domain.Allergy{{Allergen: domain.AllergenTreeNuts}},
+				Dietary:   []domain.DietaryFlag{domain.DietaryGlutenFree},
+			}).DoAndReturn(func(_ context.Context, request domain.NewOrder) (*domain.Order, error) {
+				order := &domain.Order{ID: 1, Status: domain.OrderStatusPending, NewOrder: request}
+				order.Alerts = order.KitchenAlerts()
+				order.Warnings = []domain.DietaryWarning{{Dish: "Pad Thai", Dietary: domain.DietaryGlutenFree, Message: "dish is not gluten free"}}
+				return order, nil
+			})
+
+			data, errs := exec(`mutation {
+				createOrder(input: {
+					time: "2025-02-10T12:00:00Z", dishes: [{name: "Pad Thai"}], source: PHONE,
+					notes: "No cilantro", allergies: [{allergen: TREE_NUTS}], dietary: [GLUTEN_FREE]
+				}) { allergies { allergen severe } alerts warnings { dish dietary message } }
+			}`, nil)
+
+			Expect(errs).To(BeEmpty())
+			Expect(data["createOrder"]).To(Equal(map[string]interface{}{
+				"allergies": []interface{}{map[string]interface{}{"allergen": "TREE_NUTS", "severe": false}},
+				"alerts":    []interface{}{"allergy: tree_nuts", "diet: gluten_free", "note: No cilantro"},
+				"warnings": []interface{}{map[string]interface{}{
+					"dish": "Pad Thai", "dietary": "GLUTEN_FREE", "message": "dish is not gluten free",
+				}},
+			}))
+		})
+
+		It("should report allergen conflicts with their own code", func() {
+			mockOrderService.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil, domain.ErrAllergenConflict)
+
+			_, errs := exec(`mutation {
+				createOrder(input: {
+					time: "2025-02-10T12:00:00Z", dishes: [{name: "Pad Thai"}], source: PHONE,
+					allergies: [{allergen: PEANUTS, severe: true}]
+				}) { id }
+			}`, nil)
+
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0]).To(HaveKeyWithValue("extensions", HaveKeyWithValue("code", "ALLERGEN_CONFLICT")))
+		})
+
+		It("should reject the same inputs as the REST API", func() {
+			_, errs := exec(`mutation {
+				createOrder(input: {time: "2025-02-10T12:00:00Z", dishes: [{name: ""}], source: PHONE}) { id }
+			}`, nil)
+
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0]).To(HaveKeyWithValue("extensions", HaveKeyWithValue("code", "BAD_REQUEST")))
+		})
+	})
+
+	Describe("updateOrderStatus", func() {
+		It("should report invalid updates as bad requests", func() {
+			mockOrderService.EXPECT().UpdateStatus(gomock.Any(), uint(1), domain.OrderStatusPending).Return(nil, domain.ErrInvalidOrderUpdate)
+
+			_, errs := exec(`mutation { updateOrderStatus(id: "1", status: PENDING) { id } }`, nil)
+
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0]).To(HaveKeyWithValue("extensions", HaveKeyWithValue("code", "BAD_REQUEST")))
+		})
+	})
+
+	Describe("cancelOrder", func() {
+		It("should cancel the order with the caller's staff role", func() {
+			request := domain.CancelOrder{Reason: domain.CancellationReasonOther, Note: "Wrong table"}
+			mockOrderService.EXPECT().Cancel(gomock.Any(), uint(1), request, domain.StaffRoleManager).Return(&domain.Order{
+				ID:     1,
+				Status: domain.OrderStatusCancelled,
+				Cancellation: &domain.Cancellation{
+					CancelOrder:    request,
+					CancelledBy:    domain.StaffRoleManager,
+					PreviousStatus: domain.OrderStatusPreparing,
+					CancelledAt:    orderTime,
+					Waste:          []domain.Dish{{Name: "Pizza"}},
+					Refund:         domain.Refund{Type: domain.RefundPartial},
+				},
+			}, nil)
+
+			ctx := services.WithStaffRole(context.Background(), domain.StaffRoleManager)
+			response := schema.Exec(ctx, `mutation {
+				cancelOrder(id: "1", input: {reason: OTHER, note: "Wrong table"}) {
+					status cancellation { reason cancelledBy previousStatus waste { name } refund { type } }
+				}
+			}`, "", nil)
+
+			Expect(response.Errors).To(BeEmpty())
+			Expect(string(response.Data)).To(MatchJSON(`{"cancelOrder": {
+				"status": "CANCELLED",
+				"cancellation": {
+					"reason": "OTHER",
+					"cancelledBy": "MANAGER",
+					"previousStatus": "PREPARING",
+					"waste": [{"name": "Pizza"}],
+					"refund": {"type": "PARTIAL"}
+				}
+			}}`))
+		})
+
+		It("should report denied cancellations as forbidden", func() {
+			mockOrderService.EXPECT().Cancel(gomock.Any(), uint(1), gomock.Any(), domain.StaffRole("")).
+				Return(nil, domain.ErrCancellationNotAllowed)
+
+			_, errs := exec(`mutation { cancelOrder(id: "1", input: {reason: DUPLICATE}) { id } }`, nil)
+
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0]).To(HaveKeyWithValue("extensions", HaveKeyWithValue("code", "FORBIDDEN")))
+		})
+	})
+
+	Describe("prioritizeOrder", func() {
+		It("should prioritize the order", func() {
+			mockOrderService.EXPECT().Prioritize(gomock.Any(), uint(2), uint(1)).Return(nil)
+
+			data, errs := exec(`mutation { prioritizeOrder(id: "2", afterId: "1") }`, nil)
+
+			Expect(errs).To(BeEmpty())
+			Expect(data["prioritizeOrder"]).To(BeTrue())
+		})
+	})
+
+	Describe("orderChanged", func() {
+		It("should stream the requested order events", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			responses, err := schema.Subscribe(ctx, `subscription { orderChanged(types: ["order.late"]) { type order { id late } } }`, "", nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			bus.Publish(domain.OrderEvent{Type: domain.OrderEventCreated, Order: domain.Order{ID: 1}})
+			bus.Publish(domain.OrderEvent{Type: domain.OrderEventLate, Order: domain.Order{ID: 2, Late: true}})
+
+			var response interface{}
+			Eventually(responses).Should(Receive(&response))
+
+			raw, err := json.Marshal(response)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(raw)).To(MatchJSON(`{"data":{"orderChanged":{"type":"order.late","order":{"id":"2","late":true}}}}`))
+		})
+	})
+})
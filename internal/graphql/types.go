@@ -0,0 +1,296 @@
+package graphql
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/graph-gophers/graphql-go"
+)
+
+type dishResolver struct {
+	dish domain.Dish
+}
+
+func (r *dishResolver) ID() *graphql.ID {
+	if r.dish.ID == 0 {
+		return nil
+	}
+
+	id := toID(r.dish.ID)
+	return &id
+}
+
+func (r *dishResolver) Name() string {
+	return r.dish.Name
+}
+
+func (r *dishResolver) Status() *string {
+	if r.dish.Status == "" {
+		return nil
+	}
+
+	status := toEnum(string(r.dish.Status))
+	return &status
+}
+
+type allergyResolver struct {
+	allergy domain.Allergy
+}
+
+func (r *allergyResolver) Allergen() string {
+	return toEnum(string(r.allergy.Allergen))
+}
+
+func (r *allergyResolver) Severe() bool {
+	return r.allergy.Severe
+}
+
+type dietaryWarningResolver struct {
+	warning domain.DietaryWarning
+}
+
+func (r *dietaryWarningResolver) Dish() string {
+	return r.warning.Dish
+}
+
+func (r *dietaryWarningResolver) Allergen() *string {
+	if r.warning.Allergen == "" {
+		return nil
+	}
+
+	allergen := toEnum(string(r.warning.Allergen))
+	return &allergen
+}
+
+func (r *dietaryWarningResolver) Dietary() *string {
+	if r.warning.Dietary == "" {
+		return nil
+	}
+
+	flag := toEnum(string(r.warning.Dietary))
+	return &flag
+}
+
+func (r *dietaryWarningResolver) Message() string {
+	return r.warning.Message
+}
+
+type deliveryResolver struct {
+	delivery domain.DeliveryDetails
+}
+
+func (r *deliveryResolver) Address() string {
+	return r.delivery.Address
+}
+
+func (r *deliveryResolver) ContactName() string {
+	return r.delivery.ContactName
+}
+
+func (r *deliveryResolver) ContactPhone() string {
+	return r.delivery.ContactPhone
+}
+
+func (r *deliveryResolver) FeeCents() int32 {
+	return int32(r.delivery.FeeCents)
+}
+
+type courierResolver struct {
+	courier domain.Courier
+}
+
+func (r *courierResolver) Name() string {
+	return r.courier.Name
+}
+
+func (r *courierResolver) Phone() string {
+	return r.courier.Phone
+}
+
+func (r *courierResolver) AssignedAt() *graphql.Time {
+	return toTime(r.courier.AssignedAt)
+}
+
+type refundResolver struct {
+	refund domain.Refund
+}
+
+func (r *refundResolver) Type() string {
+	return toEnum(string(r.refund.Type))
+}
+
+func (r *refundResolver) DeliveryFeeCents() int32 {
+	return int32(r.refund.DeliveryFeeCents)
+}
+
+type cancellationResolver struct {
+	cancellation domain.Cancellation
+}
+
+func (r *cancellationResolver) Reason() string {
+	return toEnum(string(r.cancellation.Reason))
+}
+
+func (r *cancellationResolver) Note() *string {
+	if r.cancellation.Note == "" {
+		return nil
+	}
+
+	return &r.cancellation.Note
+}
+
+func (r *cancellationResolver) CancelledBy() string {
+	return toEnum(string(r.cancellation.CancelledBy))
+}
+
+func (r *cancellationResolver) PreviousStatus() string {
+	return toEnum(string(r.cancellation.PreviousStatus))
+}
+
+func (r *cancellationResolver) CancelledAt() graphql.Time {
+	return graphql.Time{Time: r.cancellation.CancelledAt}
+}
+
+func (r *cancellationResolver) Waste() []*dishResolver {
+	waste := make([]*dishResolver, len(r.cancellation.Waste))
+
+	for i, dish := range r.cancellation.Waste {
+		waste[i] = &dishResolver{dish: dish}
+	}
+
+	return waste
+}
+
+func (r *cancellationResolver) Refund() *refundResolver {
+	return &refundResolver{refund: r.cancellation.Refund}
+}
+
+type statusChangeResolver struct {
+	change domain.OrderStatusHistory
+}
+
+func (r *statusChangeResolver) Status() string {
+	return toEnum(string(r.change.Status))
+}
+
+func (r *statusChangeResolver) Timestamp() *graphql.Time {
+	return toTime(r.change.Timestamp)
+}
+
+type positionChangeResolver struct {
+	change domain.PositionChange
+}
+
+func (r *positionChangeResolver) Position() int32 {
+	return int32(r.change.Position)
+}
+
+func (r *positionChangeResolver) Timestamp() *graphql.Time {
+	return toTime(r.change.Timestamp)
+}
+
+type queuePositionResolver struct {
+	queued domain.QueuedOrder
+}
+
+func (r *queuePositionResolver) Position() int32 {
+	return int32(r.queued.Position)
+}
+
+func (r *queuePositionResolver) QueuedAt() *graphql.Time {
+	return toTime(r.queued.QueuedAt)
+}
+
+func (r *queuePositionResolver) WaitingSeconds() int32 {
+	return int32(r.queued.WaitingSeconds)
+}
+
+func (r *queuePositionResolver) History() []*positionChangeResolver {
+	history := make([]*positionChangeResolver, len(r.queued.PositionHistory))
+
+	for i, change := range r.queued.PositionHistory {
+		history[i] = &positionChangeResolver{change: change}
+	}
+
+	return history
+}
+
+type queuedOrderResolver struct {
+	root   *Resolver
+	queued domain.QueuedOrder
+}
+
+func (r *queuedOrderResolver) Order() *orderResolver {
+	return &orderResolver{root: r.root, order: r.queued.Order}
+}
+
+func (r *queuedOrderResolver) QueuePosition() *queuePositionResolver {
+	return &queuePositionResolver{queued: r.queued}
+}
+
+type orderPageResolver struct {
+	items      []*orderResolver
+	totalCount int
+	hasMore    bool
+}
+
+func (r *orderPageResolver) Items() []*orderResolver {
+	return r.items
+}
+
+func (r *orderPageResolver) TotalCount() int32 {
+	return int32(r.totalCount)
+}
+
+func (r *orderPageResolver) HasMore() bool {
+	return r.hasMore
+}
+
+type orderEventResolver struct {
+	root  *Resolver
+	event domain.OrderEvent
+}
+
+func (r *orderEventResolver) Type() string {
+	return string(r.event.Type)
+}
+
+func (r *orderEventResolver) Order() *orderResolver {
+	return &orderResolver{root: r.root, order: r.event.Order}
+}
+
+func (r *orderEventResolver) Timestamp() graphql.Time {
+	return graphql.Time{Time: r.event.Timestamp}
+}
+
+// GraphQL enums are the upper case version of the domain values, e.g. IN_PERSON for in_person
+func toEnum(value string) string {
+	return strings.ToUpper(value)
+}
+
+func fromEnum(value string) string {
+	return strings.ToLower(value)
+}
+
+func toID(id uint) graphql.ID {
+	return graphql.ID(strconv.FormatUint(uint64(id), 10))
+}
+
+func parseID(id graphql.ID) (uint, error) {
+	value, err := strconv.ParseUint(string(id), 10, 64)
+	if err != nil {
+		return 0, invalidInput("invalid id " + string(id))
+	}
+
+	return uint(value), nil
+}
+
+func toTime(t *time.Time) *graphql.Time {
+	if t == nil {
+		return nil
+	}
+
+	return &graphql.Time{Time: *t}
+}
@@ -0,0 +1,357 @@
+package grpc
+
+import (
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/internal/grpc/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var sources = map[domain.OrderSource]pb.OrderSource{
+	domain.OrderSourceInPerson: pb.OrderSource_ORDER_SOURCE_IN_PERSON,
+	domain.OrderSourceDelivery: pb.OrderSource_ORDER_SOURCE_DELIVERY,
+	domain.OrderSourcePhone:    pb.OrderSource_ORDER_SOURCE_PHONE,
+}
+
+var statuses = map[domain.OrderStatus]pb.OrderStatus{
+	domain.OrderStatusScheduled:       pb.OrderStatus_ORDER_STATUS_SCHEDULED,
+	domain.OrderStatusPending:         pb.OrderStatus_ORDER_STATUS_PENDING,
+	domain.OrderStatusPreparing:       pb.OrderStatus_ORDER_STATUS_PREPARING,
+	domain.OrderStatusReady:           pb.OrderStatus_ORDER_STATUS_READY,
+	domain.OrderStatusDone:            pb.OrderStatus_ORDER_STATUS_DONE,
+	domain.OrderStatusCancelled:       pb.OrderStatus_ORDER_STATUS_CANCELLED,
+	domain.OrderStatusAwaitingCourier: pb.OrderStatus_ORDER_STATUS_AWAITING_COURIER,
+	domain.OrderStatusOutForDelivery:  pb.OrderStatus_ORDER_STATUS_OUT_FOR_DELIVERY,
+	domain.OrderStatusDelivered:       pb.OrderStatus_ORDER_STATUS_DELIVERED,
+	domain.OrderStatusDeliveryFailed:  pb.OrderStatus_ORDER_STATUS_DELIVERY_FAILED,
+}
+
+var cancellationReasons = map[domain.CancellationReason]pb.CancellationReason{
+	domain.CancellationReasonCustomerRequest: pb.CancellationReason_CANCELLATION_REASON_CUSTOMER_REQUEST,
+	domain.CancellationReasonOutOfStock:      pb.CancellationReason_CANCELLATION_REASON_OUT_OF_STOCK,
+	domain.CancellationReasonKitchenError:    pb.CancellationReason_CANCELLATION_REASON_KITCHEN_ERROR,
+	domain.CancellationReasonDuplicate:       pb.CancellationReason_CANCELLATION_REASON_DUPLICATE,
+	domain.CancellationReasonPaymentFailed:   pb.CancellationReason_CANCELLATION_REASON_PAYMENT_FAILED,
+	domain.CancellationReasonOther:           pb.CancellationReason_CANCELLATION_REASON_OTHER,
+}
+
+var staffRoles = map[domain.StaffRole]pb.StaffRole{
+	domain.StaffRoleCook:    pb.StaffRole_STAFF_ROLE_COOK,
+	domain.StaffRoleManager: pb.StaffRole_STAFF_ROLE_MANAGER,
+}
+
+var refundTypes = map[domain.RefundType]pb.RefundType{
+	domain.RefundFull:    pb.RefundType_REFUND_TYPE_FULL,
+	domain.RefundPartial: pb.RefundType_REFUND_TYPE_PARTIAL,
+	domain.RefundNone:    pb.RefundType_REFUND_TYPE_NONE,
+}
+
+var dishStatuses = map[domain.DishStatus]pb.DishStatus{
+	domain.DishStatusPending: pb.DishStatus_DISH_STATUS_PENDING,
+	domain.DishStatusReady:   pb.DishStatus_DISH_STATUS_READY,
+	domain.DishStatusVoided:  pb.DishStatus_DISH_STATUS_VOIDED,
+}
+
+var allergens = map[domain.Allergen]pb.Allergen{
+	domain.AllergenCelery:      pb.Allergen_ALLERGEN_CELERY,
+	domain.AllergenCrustaceans: pb.Allergen_ALLERGEN_CRUSTACEANS,
+	domain.AllergenEggs:        pb.Allergen_ALLERGEN_EGGS,
+	domain.AllergenFish:        pb.Allergen_ALLERGEN_FISH,
+	domain.AllergenGluten:      pb.Allergen_ALLERGEN_GLUTEN,
+	domain.AllergenLupin:       pb.Allergen_ALLERGEN_LUPIN,
+	domain.AllergenMilk:        pb.Allergen_ALLERGEN_MILK,
+	domain.AllergenMolluscs:    pb.Allergen_ALLERGEN_MOLLUSCS,
+	domain.AllergenMustard:     pb.Allergen_ALLERGEN_MUSTARD,
+	domain.AllergenPeanuts:     pb.Allergen_ALLERGEN_PEANUTS,
+	domain.AllergenSesame:      pb.Allergen_ALLERGEN_SESAME,
+	domain.AllergenSoy:         pb.Allergen_ALLERGEN_SOY,
+	domain.AllergenSulphites:   pb.Allergen_ALLERGEN_SULPHITES,
+	domain.AllergenTreeNuts:    pb.Allergen_ALLERGEN_TREE_NUTS,
+}
+
+var dietaryFlags = map[domain.DietaryFlag]pb.DietaryFlag{
+	domain.DietaryVegetarian: pb.DietaryFlag_DIETARY_FLAG_VEGETARIAN,
+	domain.DietaryVegan:      pb.DietaryFlag_DIETARY_FLAG_VEGAN,
+	domain.DietaryGlutenFree: pb.DietaryFlag_DIETARY_FLAG_GLUTEN_FREE,
+	domain.DietaryDairyFree:  pb.DietaryFlag_DIETARY_FLAG_DAIRY_FREE,
+	domain.DietaryHalal:      pb.DietaryFlag_DIETARY_FLAG_HALAL,
+	domain.DietaryKosher:     pb.DietaryFlag_DIETARY_FLAG_KOSHER,
+}
+
+func sourceFromPB(source pb.OrderSource) (domain.OrderSource, bool) {
+	for domainSource, pbSource := range sources {
+		if pbSource == source {
+			return domainSource, true
+		}
+	}
+
+	return "", false
+}
+
+func statusFromPB(status pb.OrderStatus) (domain.OrderStatus, bool) {
+	for domainStatus, pbStatus := range statuses {
+		if pbStatus == status {
+			return domainStatus, true
+		}
+	}
+
+	return "", false
+}
+
+func allergenFromPB(allergen pb.Allergen) (domain.Allergen, bool) {
+	for domainAllergen, pbAllergen := range allergens {
+		if pbAllergen == allergen {
+			return domainAllergen, true
+		}
+	}
+
+	return "", false
+}
+
+func dietaryFlagFromPB(flag pb.DietaryFlag) (domain.DietaryFlag, bool) {
+	for domainFlag, pbFlag := range dietaryFlags {
+		if pbFlag == flag {
+			return domainFlag, true
+		}
+	}
+
+	return "", false
+}
+
+func cancellationReasonFromPB(reason pb.CancellationReason) (domain.CancellationReason, bool) {
+	for domainReason, pbReason := range cancellationReasons {
+		if pbReason == reason {
+			return domainReason, true
+		}
+	}
+
+	return "", false
+}
+
+func dishesFromPB(dishes []*pb.Dish) ([]domain.Dish, bool) {
+	if len(dishes) == 0 {
+		return nil, false
+	}
+
+	result := make([]domain.Dish, len(dishes))
+
+	for i, dish := range dishes {
+		if dish.GetName() == "" {
+			return nil, false
+		}
+
+		result[i] = domain.Dish{Name: dish.GetName()}
+	}
+
+	return result, true
+}
+
+// newOrderFromPB applies the same validations the REST API gets from its request bindings
+func newOrderFromPB(request *pb.CreateOrderRequest) (domain.NewOrder, bool) {
+	source, ok := sourceFromPB(request.GetSource())
+	if !ok || request.GetTime() == nil {
+		return domain.NewOrder{}, false
+	}
+
+	dishes, ok := dishesFromPB(request.GetDishes())
+	if !ok {
+		return domain.NewOrder{}, false
+	}
+
+	order := domain.NewOrder{
+		Time:          request.GetTime().AsTime(),
+		Dishes:        dishes,
+		Source:        source,
+		CustomerPhone: request.GetCustomerPhone(),
+		ReadyAt:       timeFromPB(request.GetReadyAt()),
+		Notes:         request.GetNotes(),
+	}
+
+	if len(order.Notes) > 500 {
+		return domain.NewOrder{}, false
+	}
+
+	for _, allergy := range request.GetAllergies() {
+		allergen, ok := allergenFromPB(allergy.GetAllergen())
+		if !ok {
+			return domain.NewOrder{}, false
+		}
+
+		order.Allergies = append(order.Allergies, domain.Allergy{Allergen: allergen, Severe: allergy.GetSevere()})
+	}
+
+	for _, pbFlag := range request.GetDietary() {
+		flag, ok := dietaryFlagFromPB(pbFlag)
+		if !ok {
+			return domain.NewOrder{}, false
+		}
+
+		order.Dietary = append(order.Dietary, flag)
+	}
+
+	if request.CustomerId != nil {
+		customerID := uint(request.GetCustomerId())
+		order.CustomerID = &customerID
+	}
+
+	if request.TableId != nil {
+		tableID := uint(request.GetTableId())
+		order.TableID = &tableID
+	}
+
+	if delivery := request.GetDelivery(); delivery != nil {
+		if delivery.GetAddress() == "" || delivery.GetContactName() == "" || delivery.GetContactPhone() == "" {
+			return domain.NewOrder{}, false
+		}
+
+		order.Delivery = &domain.DeliveryDetails{
+			Address:      delivery.GetAddress(),
+			ContactName:  delivery.GetContactName(),
+			ContactPhone: delivery.GetContactPhone(),
+			FeeCents:     uint(delivery.GetFeeCents()),
+		}
+	}
+
+	return order, true
+}
+
+func orderToPB(order domain.Order) *pb.Order {
+	result := &pb.Order{
+		Id:        uint64(order.ID),
+		Status:    statuses[order.Status],
+		Time:      timestamppb.New(order.Time),
+		Dishes:    make([]*pb.Dish, len(order.Dishes)),
+		Source:    sources[order.Source],
+		ReadyAt:   timeToPB(order.ReadyAt),
+		ReleaseAt: timeToPB(order.ReleaseAt),
+		CreatedAt: timeToPB(order.CreatedAt),
+		Late:      order.Late,
+		Notes:     order.Notes,
+		Allergies: make([]*pb.Allergy, len(order.Allergies)),
+		Dietary:   make([]pb.DietaryFlag, len(order.Dietary)),
+		Alerts:    order.Alerts,
+		Warnings:  make([]*pb.DietaryWarning, len(order.Warnings)),
+	}
+
+	for i, allergy := range order.Allergies {
+		result.Allergies[i] = &pb.Allergy{Allergen: allergens[allergy.Allergen], Severe: allergy.Severe}
+	}
+
+	for i, flag := range order.Dietary {
+		result.Dietary[i] = dietaryFlags[flag]
+	}
+
+	for i, warning := range order.Warnings {
+		result.Warnings[i] = &pb.DietaryWarning{
+			Dish:     warning.Dish,
+			Allergen: allergens[warning.Allergen],
+			Dietary:  dietaryFlags[warning.Dietary],
+			Message:  warning.Message,
+		}
+	}
+
+	for i, dish := range order.Dishes {
+		result.Dishes[i] = &pb.Dish{Name: dish.Name, Id: uint64(dish.ID), Status: dishStatuses[dish.Status]}
+	}
+
+	if order.CustomerID != nil {
+		customerID := uint64(*order.CustomerID)
+		result.CustomerId = &customerID
+	}
+
+	if order.TableID != nil {
+		tableID := uint64(*order.TableID)
+		result.TableId = &tableID
+	}
+
+	if order.TableSessionID != nil {
+		tableSessionID := uint64(*order.TableSessionID)
+		result.TableSessionId = &tableSessionID
+	}
+
+	if order.Delivery != nil {
+		result.Delivery = &pb.DeliveryDetails{
+			Address:      order.Delivery.Address,
+			ContactName:  order.Delivery.ContactName,
+			ContactPhone: order.Delivery.ContactPhone,
+			FeeCents:     uint32(order.Delivery.FeeCents),
+		}
+	}
+
+	if order.Courier != nil {
+		result.Courier = &pb.Courier{
+			Name:       order.Courier.Name,
+			Phone:      order.Courier.Phone,
+			AssignedAt: timeToPB(order.Courier.AssignedAt),
+		}
+	}
+
+	if order.Cancellation != nil {
+		result.Cancellation = cancellationToPB(*order.Cancellation)
+	}
+
+	return result
+}
+
+func cancellationToPB(cancellation domain.Cancellation) *pb.Cancellation {
+	result := &pb.Cancellation{
+		Reason:         cancellationReasons[cancellation.Reason],
+		Note:           cancellation.Note,
+		CancelledBy:    staffRoles[cancellation.CancelledBy],
+		PreviousStatus: statuses[cancellation.PreviousStatus],
+		CancelledAt:    timestamppb.New(cancellation.CancelledAt),
+		Waste:          make([]*pb.Dish, len(cancellation.Waste)),
+		Refund: &pb.Refund{
+			Type:             refundTypes[cancellation.Refund.Type],
+			DeliveryFeeCents: uint32(cancellation.Refund.DeliveryFeeCents),
+		},
+	}
+
+	for i, dish := range cancellation.Waste {
+		result.Waste[i] = &pb.Dish{Name: dish.Name}
+	}
+
+	return result
+}
+
+func orderWithHistoryToPB(order domain.OrderWithStatusHistory) *pb.OrderWithStatusHistory {
+	history := make([]*pb.StatusChange, len(order.StatusHistory))
+
+	for i, change := range order.StatusHistory {
+		history[i] = &pb.StatusChange{
+			Status:    statuses[change.Status],
+			Timestamp: timeToPB(change.Timestamp),
+		}
+	}
+
+	return &pb.OrderWithStatusHistory{
+		Order:         orderToPB(order.Order),
+		StatusHistory: history,
+	}
+}
+
+func eventToPB(event domain.OrderEvent) *pb.OrderEvent {
+	return &pb.OrderEvent{
+		Type:      string(event.Type),
+		Order:     orderToPB(event.Order),
+		Timestamp: timestamppb.New(event.Timestamp),
+	}
+}
+
+func timeFromPB(timestamp *timestamppb.Timestamp) *time.Time {
+	if timestamp == nil {
+		return nil
+	}
+
+	result := timestamp.AsTime()
+	return &result
+}
+
+func timeToPB(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
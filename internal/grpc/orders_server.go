@@ -0,0 +1,193 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/danbrato999/yuno-gveloz/internal/grpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const watchEventsBuffer = 100
+
+type OrdersServer struct {
+	pb.UnimplementedOrderServiceServer
+	orderService services.OrderService
+	subscriber   services.EventSubscriber
+}
+
+func NewOrdersServer(orderService services.OrderService, subscriber services.EventSubscriber) *OrdersServer {
+	return &OrdersServer{
+		orderService: orderService,
+		subscriber:   subscriber,
+	}
+}
+
+func (s *OrdersServer) CreateOrder(ctx context.Context, request *pb.CreateOrderRequest) (*pb.Order, error) {
+	newOrder, ok := newOrderFromPB(request)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid order")
+	}
+
+	order, err := s.orderService.CreateOrder(ctx, newOrder)
+	if err != nil {
+		return nil, orderError(err)
+	}
+
+	return orderToPB(*order), nil
+}
+
+func (s *OrdersServer) GetOrder(ctx context.Context, request *pb.GetOrderRequest) (*pb.OrderWithStatusHistory, error) {
+	order, err := s.orderService.FindByID(ctx, uint(request.GetId()))
+	if err != nil {
+		return nil, orderError(err)
+	}
+
+	return orderWithHistoryToPB(*order), nil
+}
+
+func (s *OrdersServer) ListOrders(ctx context.Context, request *pb.ListOrdersRequest) (*pb.ListOrdersResponse, error) {
+	var filters []domain.OrderFilterFn
+
+	if request.GetActive() {
+		filters = append(filters, domain.FilterActive)
+	}
+
+	if request.GetScheduled() {
+		filters = append(filters, domain.FilterScheduled)
+	}
+
+	if request.GetLate() {
+		filters = append(filters, domain.FilterLate)
+	}
+
+	orders, err := s.orderService.FindMany(ctx, filters...)
+	if err != nil {
+		return nil, orderError(err)
+	}
+
+	response := &pb.ListOrdersResponse{
+		Orders: make([]*pb.Order, len(orders)),
+	}
+
+	for i, order := range orders {
+		response.Orders[i] = orderToPB(order)
+	}
+
+	return response, nil
+}
+
+func (s *OrdersServer) UpdateStatus(ctx context.Context, request *pb.UpdateStatusRequest) (*pb.Order, error) {
+	orderStatus, ok := statusFromPB(request.GetStatus())
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid status")
+	}
+
+	order, err := s.orderService.UpdateStatus(ctx, uint(request.GetId()), orderStatus)
+	if err != nil {
+		return nil, orderError(err)
+	}
+
+	return orderToPB(*order), nil
+}
+
+func (s *OrdersServer) CancelOrder(ctx context.Context, request *pb.CancelOrderRequest) (*pb.Order, error) {
+	reason, ok := cancellationReasonFromPB(request.GetReason())
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid cancellation reason")
+	}
+
+	cancel := domain.CancelOrder{Reason: reason, Note: request.GetNote()}
+
+	order, err := s.orderService.Cancel(ctx, uint(request.GetId()), cancel, services.StaffRoleFrom(ctx))
+	if err != nil {
+		return nil, orderError(err)
+	}
+
+	return orderToPB(*order), nil
+}
+
+func (s *OrdersServer) UpdateDishes(ctx context.Context, request *pb.UpdateDishesRequest) (*pb.Order, error) {
+	dishes, ok := dishesFromPB(request.GetDishes())
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid dishes")
+	}
+
+	order, err := s.orderService.UpdateDishes(ctx, uint(request.GetId()), dishes)
+	if err != nil {
+		return nil, orderError(err)
+	}
+
+	return orderToPB(*order), nil
+}
+
+func (s *OrdersServer) PrioritizeOrder(ctx context.Context, request *pb.PrioritizeOrderRequest) (*emptypb.Empty, error) {
+	if request.GetAfterId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "after_id is required")
+	}
+
+	if err := s.orderService.Prioritize(ctx, uint(request.GetId()), uint(request.GetAfterId())); err != nil {
+		return nil, orderError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *OrdersServer) WatchOrders(request *pb.WatchOrdersRequest, stream pb.OrderService_WatchOrdersServer) error {
+	types := make(map[domain.OrderEventType]bool)
+	for _, eventType := range request.GetTypes() {
+		types[domain.OrderEventType(eventType)] = true
+	}
+
+	events, unsubscribe := s.subscriber.Subscribe(watchEventsBuffer)
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+
+			if len(types) > 0 && !types[event.Type] {
+				continue
+			}
+
+			if err := stream.Send(eventToPB(event)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func orderError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrOrderNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrCompleteOrderUpdate),
+		errors.Is(err, domain.ErrIncorrectOrderQueueing),
+		errors.Is(err, domain.ErrAllergenConflict),
+		errors.Is(err, domain.ErrTableNotSeated):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrInvalidOrderUpdate),
+		errors.Is(err, domain.ErrUnknownOrderCustomer),
+		errors.Is(err, domain.ErrNotDeliveryOrder),
+		errors.Is(err, domain.ErrNotDineInOrder),
+		errors.Is(err, domain.ErrUnknownOrderTable),
+		errors.Is(err, domain.ErrInvalidCancellation),
+		errors.Is(err, domain.ErrCancellationWithoutReason),
+		errors.Is(err, domain.ErrInvalidOrderDiet),
+		errors.Is(err, domain.ErrTooManyDishes):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrCancellationNotAllowed):
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return status.Error(codes.Internal, "internal error")
+	}
+}
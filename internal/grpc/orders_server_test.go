@@ -0,0 +1,290 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
+	internalGrpc "github.com/danbrato999/yuno-gveloz/internal/grpc"
+	"github.com/danbrato999/yuno-gveloz/internal/grpc/pb"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ = Describe("OrdersServer", func() {
+	var (
+		mockService *mocks.MockOrderService
+		bus         *services.EventBus
+		client      pb.OrderServiceClient
+		ctx         context.Context
+		orderTime   time.Time
+	)
+
+	BeforeEach(func() {
+		mockService = mocks.NewMockOrderService(gomock.NewController(GinkgoT()))
+		bus = services.NewEventBus()
+		orderTime = time.Date(2025, 2, 10, 12, 0, 0, 0, time.UTC)
+
+		listener := bufconn.Listen(1024 * 1024)
+		server := internalGrpc.GetServer(mockService, bus, domain.StaffKeys{"c00k": domain.StaffRoleCook})
+		go server.Serve(listener)
+		DeferCleanup(server.Stop)
+
+		conn, err := grpc.NewClient(
+			"passthrough:///bufnet",
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return listener.DialContext(ctx)
+			}),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(conn.Close)
+
+		client = pb.NewOrderServiceClient(conn)
+
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+		DeferCleanup(cancel)
+	})
+
+	Describe("CreateOrder", func() {
+		It("should create the order", func() {
+			mockService.EXPECT().CreateOrder(gomock.Any(), domain.NewOrder{
+				Time:   orderTime,
+				Dishes: []domain.Dish{{Name: "Pizza"}},
+				Source: domain.OrderSourceInPerson,
+			}).DoAndReturn(func(_ context.Context, request domain.NewOrder) (*domain.Order, error) {
+				return &domain.Order{ID: 1, Status: domain.OrderStatusPending, NewOrder: request}, nil
+			})
+
+			order, err := client.CreateOrder(ctx, &pb.CreateOrderRequest{
+				Time:   timestamppb.New(orderTime),
+				Dishes: []*pb.Dish{{Name: "Pizza"}},
+				Source: pb.OrderSource_ORDER_SOURCE_IN_PERSON,
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order.GetId()).To(Equal(uint64(1)))
+			Expect(order.GetStatus()).To(Equal(pb.OrderStatus_ORDER_STATUS_PENDING))
+			Expect(order.GetTime().AsTime()).To(Equal(orderTime))
+		})
+
+		DescribeTable("request is incorrect", func(request *pb.CreateOrderRequest) {
+			_, err := client.CreateOrder(ctx, request)
+
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		},
+			Entry("when no dishes are provided", &pb.CreateOrderRequest{
+				Time:   timestamppb.Now(),
+				Source: pb.OrderSource_ORDER_SOURCE_PHONE,
+			}),
+			Entry("when a dish has no name", &pb.CreateOrderRequest{
+				Time:   timestamppb.Now(),
+				Dishes: []*pb.Dish{{}},
+				Source: pb.OrderSource_ORDER_SOURCE_PHONE,
+			}),
+			Entry("when no source is provided", &pb.CreateOrderRequest{
+				Time:   timestamppb.Now(),
+				Dishes: []*pb.Dish{{Name: "Pizza"}},
+			}),
+			Entry("when no time is provided", &pb.CreateOrderRequest{
+				Dishes: []*pb.Dish{{Name: "Pizza"}},
+				Source: pb.OrderSource_ORDER_SOURCE_PHONE,
+			}),
+		)
+	})
+
+	Describe("GetOrder", func() {
+		It("should return the order with its history", func() {
+			mockService.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&domain.OrderWithStatusHistory{
+				Order: domain.Order{ID: 1, Status: domain.OrderStatusPreparing},
+				StatusHistory: []domain.OrderStatusHistory{
+					{Status: domain.OrderStatusPending, Timestamp: &orderTime},
+					{Status: domain.OrderStatusPreparing, Timestamp: &orderTime},
+				},
+			}, nil)
+
+			order, err := client.GetOrder(ctx, &pb.GetOrderRequest{Id: 1})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order.GetOrder().GetStatus()).To(Equal(pb.OrderStatus_ORDER_STATUS_PREPARING))
+			Expect(order.GetStatusHistory()).To(HaveLen(2))
+		})
+
+		It("should return NotFound", func() {
+			mockService.EXPECT().FindByID(gomock.Any(), uint(1)).Return(nil, domain.ErrOrderNotFound)
+
+			_, err := client.GetOrder(ctx, &pb.GetOrderRequest{Id: 1})
+
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+	})
+
+	Describe("ListOrders", func() {
+		It("should return the filtered orders", func() {
+			mockService.EXPECT().FindMany(gomock.Any(), gomock.Len(2)).Return([]domain.Order{{ID: 1}, {ID: 2}}, nil)
+
+			response, err := client.ListOrders(ctx, &pb.ListOrdersRequest{Active: true, Late: true})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.GetOrders()).To(HaveLen(2))
+		})
+
+		It("should return Internal when the service fails", func() {
+			mockService.EXPECT().FindMany(gomock.Any()).Return(nil, errors.New("db error"))
+
+			_, err := client.ListOrders(ctx, &pb.ListOrdersRequest{})
+
+			Expect(status.Code(err)).To(Equal(codes.Internal))
+		})
+	})
+
+	Describe("UpdateStatus", func() {
+		It("should update the order status", func() {
+			mockService.EXPECT().UpdateStatus(gomock.Any(), uint(1), domain.OrderStatusReady).
+				Return(&domain.Order{ID: 1, Status: domain.OrderStatusReady}, nil)
+
+			order, err := client.UpdateStatus(ctx, &pb.UpdateStatusRequest{Id: 1, Status: pb.OrderStatus_ORDER_STATUS_READY})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order.GetStatus()).To(Equal(pb.OrderStatus_ORDER_STATUS_READY))
+		})
+
+		DescribeTable("errors", func(err error, code codes.Code) {
+			mockService.EXPECT().UpdateStatus(gomock.Any(), uint(1), domain.OrderStatusReady).Return(nil, err)
+
+			_, err = client.UpdateStatus(ctx, &pb.UpdateStatusRequest{Id: 1, Status: pb.OrderStatus_ORDER_STATUS_READY})
+
+			Expect(status.Code(err)).To(Equal(code))
+		},
+			Entry("invalid transitions", domain.ErrInvalidOrderUpdate, codes.InvalidArgument),
+			Entry("completed orders", domain.ErrCompleteOrderUpdate, codes.FailedPrecondition),
+		)
+
+		It("should reject unspecified statuses", func() {
+			_, err := client.UpdateStatus(ctx, &pb.UpdateStatusRequest{Id: 1})
+
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Describe("CancelOrder", func() {
+		request := domain.CancelOrder{Reason: domain.CancellationReasonOutOfStock}
+
+		It("should cancel the order with the role of the API key", func() {
+			cancelledAt := orderTime.Add(time.Minute)
+			mockService.EXPECT().Cancel(gomock.Any(), uint(1), request, domain.StaffRoleCook).Return(&domain.Order{
+				ID:     1,
+				Status: domain.OrderStatusCancelled,
+				Cancellation: &domain.Cancellation{
+					CancelOrder:    request,
+					CancelledBy:    domain.StaffRoleCook,
+					PreviousStatus: domain.OrderStatusPending,
+					CancelledAt:    cancelledAt,
+					Refund:         domain.Refund{Type: domain.RefundFull, DeliveryFeeCents: 500},
+				},
+			}, nil)
+
+			order, err := client.CancelOrder(
+				metadata.AppendToOutgoingContext(ctx, "x-api-key", "c00k"),
+				&pb.CancelOrderRequest{Id: 1, Reason: pb.CancellationReason_CANCELLATION_REASON_OUT_OF_STOCK},
+			)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order.GetStatus()).To(Equal(pb.OrderStatus_ORDER_STATUS_CANCELLED))
+			Expect(order.GetCancellation().GetCancelledBy()).To(Equal(pb.StaffRole_STAFF_ROLE_COOK))
+			Expect(order.GetCancellation().GetCancelledAt().AsTime()).To(Equal(cancelledAt))
+			Expect(order.GetCancellation().GetRefund().GetType()).To(Equal(pb.RefundType_REFUND_TYPE_FULL))
+			Expect(order.GetCancellation().GetRefund().GetDeliveryFeeCents()).To(Equal(uint32(500)))
+		})
+
+		It("should deny callers the policy doesn't allow", func() {
+			mockService.EXPECT().Cancel(gomock.Any(), uint(1), request, domain.StaffRole("")).Return(nil, domain.ErrCancellationNotAllowed)
+
+			_, err := client.CancelOrder(ctx, &pb.CancelOrderRequest{Id: 1, Reason: pb.CancellationReason_CANCELLATION_REASON_OUT_OF_STOCK})
+
+			Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+		})
+
+		It("should reject unspecified reasons", func() {
+			_, err := client.CancelOrder(ctx, &pb.CancelOrderRequest{Id: 1})
+
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Describe("UpdateDishes", func() {
+		It("should update the order dishes", func() {
+			dishes := []domain.Dish{{Name: "Soup"}}
+			mockService.EXPECT().UpdateDishes(gomock.Any(), uint(1), dishes).
+				Return(&domain.Order{ID: 1, NewOrder: domain.NewOrder{Dishes: dishes}}, nil)
+
+			order, err := client.UpdateDishes(ctx, &pb.UpdateDishesRequest{Id: 1, Dishes: []*pb.Dish{{Name: "Soup"}}})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order.GetDishes()[0].GetName()).To(Equal("Soup"))
+		})
+	})
+
+	Describe("PrioritizeOrder", func() {
+		It("should move the order after the other one", func() {
+			mockService.EXPECT().Prioritize(gomock.Any(), uint(3), uint(1)).Return(nil)
+
+			_, err := client.PrioritizeOrder(ctx, &pb.PrioritizeOrderRequest{Id: 3, AfterId: 1})
+
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should require the order to move after", func() {
+			_, err := client.PrioritizeOrder(ctx, &pb.PrioritizeOrderRequest{Id: 3})
+
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Describe("WatchOrders", func() {
+		It("should stream the requested order events", func() {
+			stream, err := client.WatchOrders(ctx, &pb.WatchOrdersRequest{Types: []string{string(domain.OrderEventStatusChanged)}})
+			Expect(err).ToNot(HaveOccurred())
+
+			received := make(chan *pb.OrderEvent)
+			go func() {
+				defer GinkgoRecover()
+
+				event, err := stream.Recv()
+				Expect(err).ToNot(HaveOccurred())
+				received <- event
+			}()
+
+			var event *pb.OrderEvent
+
+			// The stream subscribes to the bus once the call reaches the server
+			Eventually(func() bool {
+				bus.Publish(domain.OrderEvent{Type: domain.OrderEventCreated, Order: domain.Order{ID: 1}})
+				bus.Publish(domain.OrderEvent{Type: domain.OrderEventStatusChanged, Order: domain.Order{ID: 2}})
+
+				select {
+				case event = <-received:
+					return true
+				case <-time.After(10 * time.Millisecond):
+					return false
+				}
+			}).Should(BeTrue())
+
+			Expect(event.GetType()).To(Equal(string(domain.OrderEventStatusChanged)))
+			Expect(event.GetOrder().GetId()).To(Equal(uint64(2)))
+		})
+	})
+})
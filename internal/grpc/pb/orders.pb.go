@@ -0,0 +1,2373 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: internal/grpc/pb/orders.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type OrderSource int32
+
+const (
+	OrderSource_ORDER_SOURCE_UNSPECIFIED OrderSource = 0
+	OrderSource_ORDER_SOURCE_IN_PERSON   OrderSource = 1
+	OrderSource_ORDER_SOURCE_DELIVERY    OrderSource = 2
+	OrderSource_ORDER_SOURCE_PHONE       OrderSource = 3
+)
+
+// Enum value maps for OrderSource.
+var (
+	OrderSource_name = map[int32]string{
+		0: "ORDER_SOURCE_UNSPECIFIED",
+		1: "ORDER_SOURCE_IN_PERSON",
+		2: "ORDER_SOURCE_DELIVERY",
+		3: "ORDER_SOURCE_PHONE",
+	}
+	OrderSource_value = map[string]int32{
+		"ORDER_SOURCE_UNSPECIFIED": 0,
+		"ORDER_SOURCE_IN_PERSON":   1,
+		"ORDER_SOURCE_DELIVERY":    2,
+		"ORDER_SOURCE_PHONE":       3,
+	}
+)
+
+func (x OrderSource) Enum() *OrderSource {
+	p := new(OrderSource)
+	*p = x
+	return p
+}
+
+func (x OrderSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_grpc_pb_orders_proto_enumTypes[0].Descriptor()
+}
+
+func (OrderSource) Type() protoreflect.EnumType {
+	return &file_internal_grpc_pb_orders_proto_enumTypes[0]
+}
+
+func (x OrderSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderSource.Descriptor instead.
+func (OrderSource) EnumDescriptor() ([]byte, []int) {
+	return file_internal_grpc_pb_orders_proto_rawDescGZIP(), []int{0}
+}
+
+type OrderStatus int32
+
+const (
+	OrderStatus_ORDER_STATUS_UNSPECIFIED      OrderStatus = 0
+	OrderStatus_ORDER_STATUS_SCHEDULED        OrderStatus = 1
+	OrderStatus_ORDER_STATUS_PENDING          OrderStatus = 2
+	OrderStatus_ORDER_STATUS_PREPARING        OrderStatus = 3
+	OrderStatus_ORDER_STATUS_READY            OrderStatus = 4
+	OrderStatus_ORDER_STATUS_DONE             OrderStatus = 5
+	OrderStatus_ORDER_STATUS_CANCELLED        OrderStatus = 6
+	OrderStatus_ORDER_STATUS_AWAITING_COURIER OrderStatus = 7
+	OrderStatus_ORDER_STATUS_OUT_FOR_DELIVERY OrderStatus = 8
+	OrderStatus_ORDER_STATUS_DELIVERED        OrderStatus = 9
+	OrderStatus_ORDER_STATUS_DELIVERY_FAILED  OrderStatus = 10
+)
+
+// Enum value maps for OrderStatus.
+var (
+	OrderStatus_name = map[int32]string{
+		0:  "ORDER_STATUS_UNSPECIFIED",
+		1:  "ORDER_STATUS_SCHEDULED",
+		2:  "ORDER_STATUS_PENDING",
+		3:  "ORDER_STATUS_PREPARING",
+		4:  "ORDER_STATUS_READY",
+		5:  "ORDER_STATUS_DONE",
+		6:  "ORDER_STATUS_CANCELLED",
+		7:  "ORDER_STATUS_AWAITING_COURIER",
+		8:  "ORDER_STATUS_OUT_FOR_DELIVERY",
+		9:  "ORDER_STATUS_DELIVERED",
+		10: "ORDER_STATUS_DELIVERY_FAILED",
+	}
+	OrderStatus_value = map[string]int32{
+		"ORDER_STATUS_UNSPECIFIED":      0,
+		"ORDER_STATUS_SCHEDULED":        1,
+		"ORDER_STATUS_PENDING":          2,
+		"ORDER_STATUS_PREPARING":        3,
+		"ORDER_STATUS_READY":            4,
+		"ORDER_STATUS_DONE":             5,
+		"ORDER_STATUS_CANCELLED":        6,
+		"ORDER_STATUS_AWAITING_COURIER": 7,
+		"ORDER_STATUS_OUT_FOR_DELIVERY": 8,
+		"ORDER_STATUS_DELIVERED":        9,
+		"ORDER_STATUS_DELIVERY_FAILED":  10,
+	}
+)
+
+func (x OrderStatus) Enum() *OrderStatus {
+	p := new(OrderStatus)
+	*p = x
+	return p
+}
+
+func (x OrderStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_grpc_pb_orders_proto_enumTypes[1].Descriptor()
+}
+
+func (OrderStatus) Type() protoreflect.EnumType {
+	return &file_internal_grpc_pb_orders_proto_enumTypes[1]
+}
+
+func (x OrderStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderStatus.Descriptor instead.
+func (OrderStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_grpc_pb_orders_proto_rawDescGZIP(), []int{1}
+}
+
+type CancellationReason int32
+
+const (
+	CancellationReason_CANCELLATION_REASON_UNSPECIFIED      CancellationReason = 0
+	CancellationReason_CANCELLATION_REASON_CUSTOMER_REQUEST CancellationReason = 1
+	CancellationReason_CANCELLATION_REASON_OUT_OF_STOCK     CancellationReason = 2
+	CancellationReason_CANCELLATION_REASON_KITCHEN_ERROR    CancellationReason = 3
+	CancellationReason_CANCELLATION_REASON_DUPLICATE        CancellationReason = 4
+	CancellationReason_CANCELLATION_REASON_PAYMENT_FAILED   CancellationReason = 5
+	CancellationReason_CANCELLATION_REASON_OTHER            CancellationReason = 6
+)
+
+// Enum value maps for CancellationReason.
+var (
+	CancellationReason_name = map[int32]string{
+		0: "CANCELLATION_REASON_UNSPECIFIED",
+		1: "CANCELLATION_REASON_CUSTOMER_REQUEST",
+		2: "CANCELLATION_REASON_OUT_OF_STOCK",
+		3: "CANCELLATION_REASON_KITCHEN_ERROR",
+		4: "CANCELLATION_REASON_DUPLICATE",
+		5: "CANCELLATION_REASON_PAYMENT_FAILED",
+		6: "CANCELLATION_REASON_OTHER",
+	}
+	CancellationReason_value = map[string]int32{
+		"CANCELLATION_REASON_UNSPECIFIED":      0,
+		"CANCELLATION_REASON_CUSTOMER_REQUEST": 1,
+		"CANCELLATION_REASON_OUT_OF_STOCK":     2,
+		"CANCELLATION_REASON_KITCHEN_ERROR":    3,
+		"CANCELLATION_REASON_DUPLICATE":        4,
+		"CANCELLATION_REASON_PAYMENT_FAILED":   5,
+		"CANCELLATION_REASON_OTHER":            6,
+	}
+)
+
+func (x CancellationReason) Enum() *CancellationReason {
+	p := new(CancellationReason)
+	*p = x
+	return p
+}
+
+func (x CancellationReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CancellationReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_grpc_pb_orders_proto_enumTypes[2].Descriptor()
+}
+
+func (CancellationReason) Type() protoreflect.EnumType {
+	return &file_internal_grpc_pb_orders_proto_enumTypes[2]
+}
+
+func (x CancellationReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CancellationReason.Descriptor instead.
+func (CancellationReason) EnumDescriptor() ([]byte, []int) {
+	return file_internal_grpc_pb_orders_proto_rawDescGZIP(), []int{2}
+}
+
+type StaffRole int32
+
+const (
+	StaffRole_STAFF_ROLE_UNSPECIFIED StaffRole = 0
+	StaffRole_STAFF_ROLE_COOK        StaffRole = 1
+	StaffRole_STAFF_ROLE_MANAGER     StaffRole = 2
+)
+
+// Enum value maps for StaffRole.
+var (
+	StaffRole_name = map[int32]string{
+		0: "STAFF_ROLE_UNSPECIFIED",
+		1: "STAFF_ROLE_COOK",
+		2: "STAFF_ROLE_MANAGER",
+	}
+	StaffRole_value = map[string]int32{
+		"STAFF_ROLE_UNSPECIFIED": 0,
+		"STAFF_ROLE_COOK":        1,
+		"STAFF_ROLE_MANAGER":     2,
+	}
+)
+
+func (x StaffRole) Enum() *StaffRole {
+	p := new(StaffRole)
+	*p = x
+	return p
+}
+
+func (x StaffRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StaffRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_grpc_pb_orders_proto_enumTypes[3].Descriptor()
+}
+
+func (StaffRole) Type() protoreflect.EnumType {
+	return &file_internal_grpc_pb_orders_proto_enumTypes[3]
+}
+
+func (x StaffRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StaffRole.Descriptor instead.
+func (StaffRole) EnumDescriptor() ([]byte, []int) {
+	return file_internal_grpc_pb_orders_proto_rawDescGZIP(), []int{3}
+}
+
+type RefundType int32
+
+const (
+	RefundType_REFUND_TYPE_UNSPECIFIED RefundType = 0
+	RefundType_REFUND_TYPE_FULL        RefundType = 1
+	RefundType_REFUND_TYPE_PARTIAL     RefundType = 2
+	RefundType_REFUND_TYPE_NONE        RefundType = 3
+)
+
+// Enum value maps for RefundType.
+var (
+	RefundType_name = map[int32]string{
+		0: "REFUND_TYPE_UNSPECIFIED",
+		1: "REFUND_TYPE_FULL",
+		2: "REFUND_TYPE_PARTIAL",
+		3: "REFUND_TYPE_NONE",
+	}
+	RefundType_value = map[string]int32{
+		"REFUND_TYPE_UNSPECIFIED": 0,
+		"REFUND_TYPE_FULL":        1,
+		"REFUND_TYPE_PARTIAL":     2,
+		"REFUND_TYPE_NONE":        3,
+	}
+)
+
+func (x RefundType) Enum() *RefundType {
+	p := new(RefundType)
+	*p = x
+	return p
+}
+
+func (x RefundType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RefundType) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_grpc_pb_orders_proto_enumTypes[4].Descriptor()
+}
+
+func (RefundType) Type() protoreflect.EnumType {
+	return &file_internal_grpc_pb_orders_proto_enumTypes[4]
+}
+
+func (x RefundType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RefundType.Descriptor instead.
+func (RefundType) EnumDescriptor() ([]byte, []int) {
+	return file_internal_grpc_pb_orders_proto_rawDescGZIP(), []int{4}
+}
+
+type Allergen int32
+
+const (
+	Allergen_ALLERGEN_UNSPECIFIED Allergen = 0
+	Allergen_ALLERGEN_CELERY      Allergen = 1
+	Allergen_ALLERGEN_CRUSTACEANS Allergen = 2
+	Allergen_ALLERGEN_EGGS        Allergen = 3
+	Allergen_ALLERGEN_FISH        Allergen = 4
+	Allergen_ALLERGEN_GLUTEN      Allergen = 5
+	Allergen_ALLERGEN_LUPIN       Allergen = 6
+	Allergen_ALLERGEN_MILK        Allergen = 7
+	Allergen_ALLERGEN_MOLLUSCS    Allergen = 8
+	Allergen_ALLERGEN_MUSTARD     Allergen = 9
+	Allergen_ALLERGEN_PEANUTS     Allergen = 10
+	Allergen_ALLERGEN_SESAME      Allergen = 11
+	Allergen_ALLERGEN_SOY         Allergen = 12
+	Allergen_ALLERGEN_SULPHITES   Allergen = 13
+	Allergen_ALLERGEN_TREE_NUTS   Allergen = 14
+)
+
+// Enum value maps for Allergen.
+var (
+	Allergen_name = map[int32]string{
+		0:  "ALLERGEN_UNSPECIFIED",
+		1:  "ALLERGEN_CELERY",
+		2:  "ALLERGEN_CRUSTACEANS",
+		3:  "ALLERGEN_EGGS",
+		4:  "ALLERGEN_FISH",
+		5:  "ALLERGEN_GLUTEN",
+		6:  "ALLERGEN_LUPIN",
+		7:  "ALLERGEN_MILK",
+		8:  "ALLERGEN_MOLLUSCS",
+		9:  "ALLERGEN_MUSTARD",
+		10: "ALLERGEN_PEANUTS",
+		11: "ALLERGEN_SESAME",
+		12: "ALLERGEN_SOY",
+		13: "ALLERGEN_SULPHITES",
+		14: "ALLERGEN_TREE_NUTS",
+	}
+	Allergen_value = map[string]int32{
+		"ALLERGEN_UNSPECIFIED": 0,
+		"ALLERGEN_CELERY":      1,
+		"ALLERGEN_CRUSTACEANS": 2,
+		"ALLERGEN_EGGS":        3,
+		"ALLERGEN_FISH":        4,
+		"ALLERGEN_GLUTEN":      5,
+		"ALLERGEN_LUPIN":       6,
+		"ALLERGEN_MILK":        7,
+		"ALLERGEN_MOLLUSCS":    8,
+		"ALLERGEN_MUSTARD":     9,
+		"ALLERGEN_PEANUTS":     10,
+		"ALLERGEN_SESAME":      11,
+		"ALLERGEN_SOY":         12,
+		"ALLERGEN_SULPHITES":   13,
+		"ALLERGEN_TREE_NUTS":   14,
+	}
+)
+
+func (x Allergen) Enum() *Allergen {
+	p := new(Allergen)
+	*p = x
+	return p
+}
+
+func (x Allergen) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Allergen) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_grpc_pb_orders_proto_enumTypes[5].Descriptor()
+}
+
+func (Allergen) Type() protoreflect.EnumType {
+	return &file_internal_grpc_pb_orders_proto_enumTypes[5]
+}
+
+func (x Allergen) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Allergen.Descriptor instead.
+func (Allergen) EnumDescriptor() ([]byte, []int) {
+	return file_internal_grpc_pb_orders_proto_rawDescGZIP(), []int{5}
+}
+
+type DietaryFlag int32
+
+const (
+	DietaryFlag_DIETARY_FLAG_UNSPECIFIED DietaryFlag = 0
+	DietaryFlag_DIETARY_FLAG_VEGETARIAN  DietaryFlag = 1
+	DietaryFlag_DIETARY_FLAG_VEGAN       DietaryFlag = 2
+	DietaryFlag_DIETARY_FLAG_GLUTEN_FREE DietaryFlag = 3
+	DietaryFlag_DIETARY_FLAG_DAIRY_FREE  DietaryFlag = 4
+	DietaryFlag_DIETARY_FLAG_HALAL       DietaryFlag = 5
+	DietaryFlag_DIETARY_FLAG_KOSHER      DietaryFlag = 6
+)
+
+// Enum value maps for DietaryFlag.
+var (
+	DietaryFlag_name = map[int32]string{
+		0: "DIETARY_FLAG_UNSPECIFIED",
+		1: "DIETARY_FLAG_VEGETARIAN",
+		2: "DIETARY_FLAG_VEGAN",
+		3: "DIETARY_FLAG_GLUTEN_FREE",
+		4: "DIETARY_FLAG_DAIRY_FREE",
+		5: "DIETARY_FLAG_HALAL",
+		6: "DIETARY_FLAG_KOSHER",
+	}
+	DietaryFlag_value = map[string]int32{
+		"DIETARY_FLAG_UNSPECIFIED": 0,
+		"DIETARY_FLAG_VEGETARIAN":  1,
+		"DIETARY_FLAG_VEGAN":       2,
+		"DIETARY_FLAG_GLUTEN_FREE": 3,
+		"DIETARY_FLAG_DAIRY_FREE":  4,
+		"DIETARY_FLAG_HALAL":       5,
+		"DIETARY_FLAG_KOSHER":      6,
+	}
+)
+
+func (x DietaryFlag) Enum() *DietaryFlag {
+	p := new(DietaryFlag)
+	*p = x
+	return p
+}
+
+func (x DietaryFlag) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DietaryFlag) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_grpc_pb_orders_proto_enumTypes[6].Descriptor()
+}
+
+func (DietaryFlag) Type() protoreflect.EnumType {
+	return &file_internal_grpc_pb_orders_proto_enumTypes[6]
+}
+
+func (x DietaryFlag) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DietaryFlag.Descriptor instead.
+func (DietaryFlag) EnumDescriptor() ([]byte, []int) {
+	return file_internal_grpc_pb_orders_proto_rawDescGZIP(), []int{6}
+}
+
+type DishStatus int32
+
+const (
+	DishStatus_DISH_STATUS_UNSPECIFIED DishStatus = 0
+	DishStatus_DISH_STATUS_PENDING     DishStatus = 1
+	DishStatus_DISH_STATUS_READY       DishStatus = 2
+	DishStatus_DISH_STATUS_VOIDED      DishStatus = 3
+)
+
+// Enum value maps for DishStatus.
+var (
+	DishStatus_name = map[int32]string{
+		0: "DISH_STATUS_UNSPECIFIED",
+		1: "DISH_STATUS_PENDING",
+		2: "DISH_STATUS_READY",
+		3: "DISH_STATUS_VOIDED",
+	}
+	DishStatus_value = map[string]int32{
+		"DISH_STATUS_UNSPECIFIED": 0,
+		"DISH_STATUS_PENDING":     1,
+		"DISH_STATUS_READY":       2,
+		"DISH_STATUS_VOIDED":      3,
+	}
+)
+
+func (x DishStatus) Enum() *DishStatus {
+	p := new(DishStatus)
+	*p = x
+	return p
+}
+
+func (x DishStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DishStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_grpc_pb_orders_proto_enumTypes[7].Descriptor()
+}
+
+func (DishStatus) Type() protoreflect.EnumType {
+	return &file_internal_grpc_pb_orders_proto_enumTypes[7]
+}
+
+func (x DishStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DishStatus.Descriptor instead.
+func (DishStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_grpc_pb_orders_proto_rawDescGZIP(), []int{7}
+}
+
+type Allergy struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Allergen Allergen               `protobuf:"varint,1,opt,name=allergen,proto3,enum=gveloz.v1.Allergen" json:"allergen,omitempty"`
+	// Orders with dishes known to contain the allergen of a severe allergy are rejected
+	Severe        bool `protobuf:"varint,2,opt,name=severe,proto3" json:"severe,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Allergy) Reset() {
+	*x = Allergy{}
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Allergy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Allergy) ProtoMessage() {}
+
+func (x *Allergy) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Allergy.ProtoReflect.Descriptor instead.
+func (*Allergy) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_pb_orders_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Allergy) GetAllergen() Allergen {
+	if x != nil {
+		return x.Allergen
+	}
+	return Allergen_ALLERGEN_UNSPECIFIED
+}
+
+func (x *Allergy) GetSevere() bool {
+	if x != nil {
+		return x.Severe
+	}
+	return false
+}
+
+type DietaryWarning struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Dish  string                 `protobuf:"bytes,1,opt,name=dish,proto3" json:"dish,omitempty"`
+	// Both unspecified for dishes missing from the menu catalog
+	Allergen      Allergen    `protobuf:"varint,2,opt,name=allergen,proto3,enum=gveloz.v1.Allergen" json:"allergen,omitempty"`
+	Dietary       DietaryFlag `protobuf:"varint,3,opt,name=dietary,proto3,enum=gveloz.v1.DietaryFlag" json:"dietary,omitempty"`
+	Message       string      `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DietaryWarning) Reset() {
+	*x = DietaryWarning{}
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DietaryWarning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DietaryWarning) ProtoMessage() {}
+
+func (x *DietaryWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DietaryWarning.ProtoReflect.Descriptor instead.
+func (*DietaryWarning) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_pb_orders_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DietaryWarning) GetDish() string {
+	if x != nil {
+		return x.Dish
+	}
+	return ""
+}
+
+func (x *DietaryWarning) GetAllergen() Allergen {
+	if x != nil {
+		return x.Allergen
+	}
+	return Allergen_ALLERGEN_UNSPECIFIED
+}
+
+func (x *DietaryWarning) GetDietary() DietaryFlag {
+	if x != nil {
+		return x.Dietary
+	}
+	return DietaryFlag_DIETARY_FLAG_UNSPECIFIED
+}
+
+func (x *DietaryWarning) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type Dish struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Only set for the dishes of orders, not for wasted ones
+	Id            uint64     `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Status        DishStatus `protobuf:"varint,3,opt,name=status,proto3,enum=gveloz.v1.DishStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Dish) Reset() {
+	*x = Dish{}
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Dish) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dish) ProtoMessage() {}
+
+func (x *Dish) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dish.ProtoReflect.Descriptor instead.
+func (*Dish) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_pb_orders_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Dish) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Dish) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Dish) GetStatus() DishStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DishStatus_DISH_STATUS_UNSPECIFIED
+}
+
+type DeliveryDetails struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ContactName   string                 `protobuf:"bytes,2,opt,name=contact_name,json=contactName,proto3" json:"contact_name,omitempty"`
+	ContactPhone  string                 `protobuf:"bytes,3,opt,name=contact_phone,json=contactPhone,proto3" json:"contact_phone,omitempty"`
+	FeeCents      uint32                 `protobuf:"varint,4,opt,name=fee_cents,json=feeCents,proto3" json:"fee_cents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeliveryDetails) Reset() {
+	*x = DeliveryDetails{}
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliveryDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryDetails) ProtoMessage() {}
+
+func (x *DeliveryDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveryDetails.ProtoReflect.Descriptor instead.
+func (*DeliveryDetails) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_pb_orders_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeliveryDetails) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *DeliveryDetails) GetContactName() string {
+	if x != nil {
+		return x.ContactName
+	}
+	return ""
+}
+
+func (x *DeliveryDetails) GetContactPhone() string {
+	if x != nil {
+		return x.ContactPhone
+	}
+	return ""
+}
+
+func (x *DeliveryDetails) GetFeeCents() uint32 {
+	if x != nil {
+		return x.FeeCents
+	}
+	return 0
+}
+
+type Courier struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Phone         string                 `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+	AssignedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Courier) Reset() {
+	*x = Courier{}
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Courier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Courier) ProtoMessage() {}
+
+func (x *Courier) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Courier.ProtoReflect.Descriptor instead.
+func (*Courier) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_pb_orders_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Courier) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Courier) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Courier) GetAssignedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return nil
+}
+
+type Refund struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Type             RefundType             `protobuf:"varint,1,opt,name=type,proto3,enum=gveloz.v1.RefundType" json:"type,omitempty"`
+	DeliveryFeeCents uint32                 `protobuf:"varint,2,opt,name=delivery_fee_cents,json=deliveryFeeCents,proto3" json:"delivery_fee_cents,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Refund) Reset() {
+	*x = Refund{}
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Refund) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Refund) ProtoMessage() {}
+
+func (x *Refund) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Refund.ProtoReflect.Descriptor instead.
+func (*Refund) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_pb_orders_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Refund) GetType() RefundType {
+	if x != nil {
+		return x.Type
+	}
+	return RefundType_REFUND_TYPE_UNSPECIFIED
+}
+
+func (x *Refund) GetDeliveryFeeCents() uint32 {
+	if x != nil {
+		return x.DeliveryFeeCents
+	}
+	return 0
+}
+
+type Cancellation struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Reason         CancellationReason     `protobuf:"varint,1,opt,name=reason,proto3,enum=gveloz.v1.CancellationReason" json:"reason,omitempty"`
+	Note           string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	CancelledBy    StaffRole              `protobuf:"varint,3,opt,name=cancelled_by,json=cancelledBy,proto3,enum=gveloz.v1.StaffRole" json:"cancelled_by,omitempty"`
+	PreviousStatus OrderStatus            `protobuf:"varint,4,opt,name=previous_status,json=previousStatus,proto3,enum=gveloz.v1.OrderStatus" json:"previous_status,omitempty"`
+	CancelledAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	// Dishes thrown away because the kitchen had already started on them
+	Waste         []*Dish `protobuf:"bytes,6,rep,name=waste,proto3" json:"waste,omitempty"`
+	Refund        *Refund `protobuf:"bytes,7,opt,name=refund,proto3" json:"refund,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Cancellation) Reset() {
+	*x = Cancellation{}
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cancellation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cancellation) ProtoMessage() {}
+
+func (x *Cancellation) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cancellation.ProtoReflect.Descriptor instead.
+func (*Cancellation) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_pb_orders_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Cancellation) GetReason() CancellationReason {
+	if x != nil {
+		return x.Reason
+	}
+	return CancellationReason_CANCELLATION_REASON_UNSPECIFIED
+}
+
+func (x *Cancellation) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *Cancellation) GetCancelledBy() StaffRole {
+	if x != nil {
+		return x.CancelledBy
+	}
+	return StaffRole_STAFF_ROLE_UNSPECIFIED
+}
+
+func (x *Cancellation) GetPreviousStatus() OrderStatus {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *Cancellation) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+func (x *Cancellation) GetWaste() []*Dish {
+	if x != nil {
+		return x.Waste
+	}
+	return nil
+}
+
+func (x *Cancellation) GetRefund() *Refund {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+type Order struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status     OrderStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=gveloz.v1.OrderStatus" json:"status,omitempty"`
+	Time       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	Dishes     []*Dish                `protobuf:"bytes,4,rep,name=dishes,proto3" json:"dishes,omitempty"`
+	Source     OrderSource            `protobuf:"varint,5,opt,name=source,proto3,enum=gveloz.v1.OrderSource" json:"source,omitempty"`
+	CustomerId *uint64                `protobuf:"varint,6,opt,name=customer_id,json=customerId,proto3,oneof" json:"customer_id,omitempty"`
+	Delivery   *DeliveryDetails       `protobuf:"bytes,7,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	ReadyAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=ready_at,json=readyAt,proto3" json:"ready_at,omitempty"`
+	Courier    *Courier               `protobuf:"bytes,9,opt,name=courier,proto3" json:"courier,omitempty"`
+	ReleaseAt  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=release_at,json=releaseAt,proto3" json:"release_at,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Late       bool                   `protobuf:"varint,12,opt,name=late,proto3" json:"late,omitempty"`
+	// Only set for cancelled orders
+	Cancellation *Cancellation `protobuf:"bytes,13,opt,name=cancellation,proto3" json:"cancellation,omitempty"`
+	Notes        string        `protobuf:"bytes,14,opt,name=notes,proto3" json:"notes,omitempty"`
+	Allergies    []*Allergy    `protobuf:"bytes,15,rep,name=allergies,proto3" json:"allergies,omitempty"`
+	Dietary      []DietaryFlag `protobuf:"varint,16,rep,packed,name=dietary,proto3,enum=gveloz.v1.DietaryFlag" json:"dietary,omitempty"`
+	// The allergies, diet and notes of the order, for kitchen displays to highlight
+	Alerts []string `protobuf:"bytes,17,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	// Only set by the calls whose dishes were cross checked against the menu catalog
+	Warnings       []*DietaryWarning `protobuf:"bytes,18,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	TableId        *uint64           `protobuf:"varint,19,opt,name=table_id,json=tableId,proto3,oneof" json:"table_id,omitempty"`
+	TableSessionId *uint64           `protobuf:"varint,20,opt,name=table_session_id,json=tableSessionId,proto3,oneof" json:"table_session_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_pb_orders_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Order) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Order) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *Order) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *Order) GetDishes() []*Dish {
+	if x != nil {
+		return x.Dishes
+	}
+	return nil
+}
+
+func (x *Order) GetSource() OrderSource {
+	if x != nil {
+		return x.Source
+	}
+	return OrderSource_ORDER_SOURCE_UNSPECIFIED
+}
+
+func (x *Order) GetCustomerId() uint64 {
+	if x != nil && x.CustomerId != nil {
+		return *x.CustomerId
+	}
+	return 0
+}
+
+func (x *Order) GetDelivery() *DeliveryDetails {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+func (x *Order) GetReadyAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReadyAt
+	}
+	return nil
+}
+
+func (x *Order) GetCourier() *Courier {
+	if x != nil {
+		return x.Courier
+	}
+	return nil
+}
+
+func (x *Order) GetReleaseAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseAt
+	}
+	return nil
+}
+
+func (x *Order) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Order) GetLate() bool {
+	if x != nil {
+		return x.Late
+	}
+	return false
+}
+
+func (x *Order) GetCancellation() *Cancellation {
+	if x != nil {
+		return x.Cancellation
+	}
+	return nil
+}
+
+func (x *Order) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Order) GetAllergies() []*Allergy {
+	if x != nil {
+		return x.Allergies
+	}
+	return nil
+}
+
+func (x *Order) GetDietary() []DietaryFlag {
+	if x != nil {
+		return x.Dietary
+	}
+	return nil
+}
+
+func (x *Order) GetAlerts() []string {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+func (x *Order) GetWarnings() []*DietaryWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *Order) GetTableId() uint64 {
+	if x != nil && x.TableId != nil {
+		return *x.TableId
+	}
+	return 0
+}
+
+func (x *Order) GetTableSessionId() uint64 {
+	if x != nil && x.TableSessionId != nil {
+		return *x.TableSessionId
+	}
+	return 0
+}
+
+type StatusChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        OrderStatus            `protobuf:"varint,1,opt,name=status,proto3,enum=gveloz.v1.OrderStatus" json:"status,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusChange) Reset() {
+	*x = StatusChange{}
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusChange) ProtoMessage() {}
+
+func (x *StatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusChange.ProtoReflect.Descriptor instead.
+func (*StatusChange) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_pb_orders_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StatusChange) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *StatusChange) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type OrderWithStatusHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	StatusHistory []*StatusChange        `protobuf:"bytes,2,rep,name=status_history,json=statusHistory,proto3" json:"status_history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderWithStatusHistory) Reset() {
+	*x = OrderWithStatusHistory{}
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderWithStatusHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderWithStatusHistory) ProtoMessage() {}
+
+func (x *OrderWithStatusHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderWithStatusHistory.ProtoReflect.Descriptor instead.
+func (*OrderWithStatusHistory) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_pb_orders_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *OrderWithStatusHistory) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *OrderWithStatusHistory) GetStatusHistory() []*StatusChange {
+	if x != nil {
+		return x.StatusHistory
+	}
+	return nil
+}
+
+type CreateOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Dishes        []*Dish                `protobuf:"bytes,2,rep,name=dishes,proto3" json:"dishes,omitempty"`
+	Source        OrderSource            `protobuf:"varint,3,opt,name=source,proto3,enum=gveloz.v1.OrderSource" json:"source,omitempty"`
+	CustomerId    *uint64                `protobuf:"varint,4,opt,name=customer_id,json=customerId,proto3,oneof" json:"customer_id,omitempty"`
+	CustomerPhone string                 `protobuf:"bytes,5,opt,name=customer_phone,json=customerPhone,proto3" json:"customer_phone,omitempty"`
+	Delivery      *DeliveryDetails       `protobuf:"bytes,6,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	ReadyAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ready_at,json=readyAt,proto3" json:"ready_at,omitempty"`
+	Notes         string                 `protobuf:"bytes,8,opt,name=notes,proto3" json:"notes,omitempty"`
+	Allergies     []*Allergy             `protobuf:"bytes,9,rep,name=allergies,proto3" json:"allergies,omitempty"`
+	Dietary       []DietaryFlag          `protobuf:"varint,10,rep,packed,name=dietary,proto3,enum=gveloz.v1.DietaryFlag" json:"dietary,omitempty"`
+	// In person orders only, the table has to be seated
+	TableId       *uint64 `protobuf:"varint,11,opt,name=table_id,json=tableId,proto3,oneof" json:"table_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrderRequest) Reset() {
+	*x = CreateOrderRequest{}
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderRequest) ProtoMessage() {}
+
+func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_pb_orders_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateOrderRequest) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetDishes() []*Dish {
+	if x != nil {
+		return x.Dishes
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetSource() OrderSource {
+	if x != nil {
+		return x.Source
+	}
+	return OrderSource_ORDER_SOURCE_UNSPECIFIED
+}
+
+func (x *CreateOrderRequest) GetCustomerId() uint64 {
+	if x != nil && x.CustomerId != nil {
+		return *x.CustomerId
+	}
+	return 0
+}
+
+func (x *CreateOrderRequest) GetCustomerPhone() string {
+	if x != nil {
+		return x.CustomerPhone
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetDelivery() *DeliveryDetails {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetReadyAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReadyAt
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetAllergies() []*Allergy {
+	if x != nil {
+		return x.Allergies
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetDietary() []DietaryFlag {
+	if x != nil {
+		return x.Dietary
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetTableId() uint64 {
+	if x != nil && x.TableId != nil {
+		return *x.TableId
+	}
+	return 0
+}
+
+type GetOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderRequest) Reset() {
+	*x = GetOrderRequest{}
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderRequest) ProtoMessage() {}
+
+func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_pb_orders_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetOrderRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Active        bool                   `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	Scheduled     bool                   `protobuf:"varint,2,opt,name=scheduled,proto3" json:"scheduled,omitempty"`
+	Late          bool                   `protobuf:"varint,3,opt,name=late,proto3" json:"late,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersRequest) Reset() {
+	*x = ListOrdersRequest{}
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersRequest) ProtoMessage() {}
+
+func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_pb_orders_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListOrdersRequest) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *ListOrdersRequest) GetScheduled() bool {
+	if x != nil {
+		return x.Scheduled
+	}
+	return false
+}
+
+func (x *ListOrdersRequest) GetLate() bool {
+	if x != nil {
+		return x.Late
+	}
+	return false
+}
+
+type ListOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersResponse) Reset() {
+	*x = ListOrdersResponse{}
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersResponse) ProtoMessage() {}
+
+func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_pb_orders_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListOrdersResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type UpdateStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        OrderStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=gveloz.v1.OrderStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateStatusRequest) Reset() {
+	*x = UpdateStatusRequest{}
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStatusRequest) ProtoMessage() {}
+
+func (x *UpdateStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStatusRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_pb_orders_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateStatusRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateStatusRequest) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+type CancelOrderRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason CancellationReason     `protobuf:"varint,2,opt,name=reason,proto3,enum=gveloz.v1.CancellationReason" json:"reason,omitempty"`
+	// Required for the other reason
+	Note          string `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderRequest) ProtoMessage() {}
+
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_pb_orders_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CancelOrderRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CancelOrderRequest) GetReason() CancellationReason {
+	if x != nil {
+		return x.Reason
+	}
+	return CancellationReason_CANCELLATION_REASON_UNSPECIFIED
+}
+
+func (x *CancelOrderRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type UpdateDishesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Dishes        []*Dish                `protobuf:"bytes,2,rep,name=dishes,proto3" json:"dishes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDishesRequest) Reset() {
+	*x = UpdateDishesRequest{}
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDishesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDishesRequest) ProtoMessage() {}
+
+func (x *UpdateDishesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDishesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDishesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_pb_orders_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateDishesRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateDishesRequest) GetDishes() []*Dish {
+	if x != nil {
+		return x.Dishes
+	}
+	return nil
+}
+
+type PrioritizeOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AfterId       uint64                 `protobuf:"varint,2,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrioritizeOrderRequest) Reset() {
+	*x = PrioritizeOrderRequest{}
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrioritizeOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrioritizeOrderRequest) ProtoMessage() {}
+
+func (x *PrioritizeOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrioritizeOrderRequest.ProtoReflect.Descriptor instead.
+func (*PrioritizeOrderRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_pb_orders_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PrioritizeOrderRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PrioritizeOrderRequest) GetAfterId() uint64 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+type WatchOrdersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Event types to receive, e.g. order.created. All events are sent if empty
+	Types         []string `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchOrdersRequest) Reset() {
+	*x = WatchOrdersRequest{}
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOrdersRequest) ProtoMessage() {}
+
+func (x *WatchOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOrdersRequest.ProtoReflect.Descriptor instead.
+func (*WatchOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_pb_orders_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *WatchOrdersRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+type OrderEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Order         *Order                 `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderEvent) Reset() {
+	*x = OrderEvent{}
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderEvent) ProtoMessage() {}
+
+func (x *OrderEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_grpc_pb_orders_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderEvent.ProtoReflect.Descriptor instead.
+func (*OrderEvent) Descriptor() ([]byte, []int) {
+	return file_internal_grpc_pb_orders_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *OrderEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *OrderEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+var File_internal_grpc_pb_orders_proto protoreflect.FileDescriptor
+
+var file_internal_grpc_pb_orders_proto_rawDesc = string([]byte{
+	0x0a, 0x1d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f,
+	0x70, 0x62, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x09, 0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x52, 0x0a, 0x07, 0x41, 0x6c, 0x6c, 0x65,
+	0x72, 0x67, 0x79, 0x12, 0x2f, 0x0a, 0x08, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x52, 0x08, 0x61, 0x6c, 0x6c, 0x65,
+	0x72, 0x67, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x76, 0x65, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x65, 0x76, 0x65, 0x72, 0x65, 0x22, 0xa1, 0x01, 0x0a,
+	0x0e, 0x44, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x69, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64,
+	0x69, 0x73, 0x68, 0x12, 0x2f, 0x0a, 0x08, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x52, 0x08, 0x61, 0x6c, 0x6c, 0x65,
+	0x72, 0x67, 0x65, 0x6e, 0x12, 0x30, 0x0a, 0x07, 0x64, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x07, 0x64,
+	0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x59, 0x0a, 0x04, 0x44, 0x69, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2d, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x67,
+	0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x68, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x0f,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x50, 0x68, 0x6f, 0x6e,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x66, 0x65, 0x65, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x70,
+	0x0a, 0x07, 0x43, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68,
+	0x6f, 0x6e, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x61, 0x0a, 0x06, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x67, 0x76, 0x65, 0x6c, 0x6f,
+	0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x46, 0x65, 0x65, 0x43, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0xe4, 0x02, 0x0a, 0x0c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12,
+	0x37, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x61, 0x66, 0x66, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x0b, 0x63, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x42, 0x79, 0x12, 0x3f, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x16, 0x2e, 0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x12, 0x25, 0x0a, 0x05, 0x77, 0x61, 0x73, 0x74,
+	0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x68, 0x52, 0x05, 0x77, 0x61, 0x73, 0x74, 0x65, 0x12,
+	0x29, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x52, 0x06, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x22, 0xa4, 0x07, 0x0a, 0x05, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31,
+	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x64, 0x69, 0x73, 0x68, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x69, 0x73, 0x68, 0x52, 0x06, 0x64, 0x69, 0x73, 0x68, 0x65, 0x73, 0x12, 0x2e, 0x0a,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e,
+	0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x24, 0x0a,
+	0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x35, 0x0a, 0x08, 0x72,
+	0x65, 0x61, 0x64, 0x79, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x72, 0x65, 0x61, 0x64, 0x79,
+	0x41, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72,
+	0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x63, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x30, 0x0a,
+	0x09, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x69, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c,
+	0x65, 0x72, 0x67, 0x79, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x69, 0x65, 0x73, 0x12,
+	0x30, 0x0a, 0x07, 0x64, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x16, 0x2e, 0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x65,
+	0x74, 0x61, 0x72, 0x79, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x07, 0x64, 0x69, 0x65, 0x74, 0x61, 0x72,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x77, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x76,
+	0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x57,
+	0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x1e, 0x0a, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x04, 0x48, 0x01, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x2d, 0x0a, 0x10, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x48, 0x02, 0x52, 0x0e, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x42, 0x13, 0x0a, 0x11,
+	0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x22, 0x78, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x16, 0x2e, 0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x80, 0x01, 0x0a, 0x16,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76,
+	0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x3e,
+	0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x90,
+	0x04, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x64, 0x69, 0x73, 0x68, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x69, 0x73, 0x68, 0x52, 0x06, 0x64, 0x69, 0x73, 0x68, 0x65, 0x73, 0x12, 0x2e,
+	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16,
+	0x2e, 0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x24,
+	0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x5f, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x61, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x07, 0x72, 0x65, 0x61, 0x64, 0x79, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
+	0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73,
+	0x12, 0x30, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x69, 0x65, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x79, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x69,
+	0x65, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x64, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x07, 0x64, 0x69, 0x65,
+	0x74, 0x61, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49,
+	0x64, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69,
+	0x64, 0x22, 0x21, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x5d, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c,
+	0x61, 0x74, 0x65, 0x22, 0x3e, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x76, 0x65, 0x6c,
+	0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x22, 0x55, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x76, 0x65,
+	0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x6f, 0x0a, 0x12, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x35, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1d, 0x2e, 0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x4e, 0x0a, 0x13, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x27, 0x0a, 0x06, 0x64, 0x69, 0x73, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x69, 0x73, 0x68, 0x52, 0x06, 0x64, 0x69, 0x73, 0x68, 0x65, 0x73, 0x22, 0x43, 0x0a, 0x16, 0x50,
+	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x7a, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x66, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x2a, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0x82, 0x01, 0x0a,
+	0x0a, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x26, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2a, 0x7a, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x1c, 0x0a, 0x18, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a,
+	0x0a, 0x16, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x49,
+	0x4e, 0x5f, 0x50, 0x45, 0x52, 0x53, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x52,
+	0x44, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56,
+	0x45, 0x52, 0x59, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53,
+	0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x50, 0x48, 0x4f, 0x4e, 0x45, 0x10, 0x03, 0x2a, 0xcc, 0x02,
+	0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a,
+	0x18, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x4f,
+	0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x43, 0x48, 0x45,
+	0x44, 0x55, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x52, 0x44, 0x45, 0x52,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
+	0x02, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x50, 0x52, 0x45, 0x50, 0x41, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x16, 0x0a,
+	0x12, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45,
+	0x41, 0x44, 0x59, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x05, 0x12, 0x1a, 0x0a, 0x16,
+	0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e,
+	0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x06, 0x12, 0x21, 0x0a, 0x1d, 0x4f, 0x52, 0x44, 0x45,
+	0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e,
+	0x47, 0x5f, 0x43, 0x4f, 0x55, 0x52, 0x49, 0x45, 0x52, 0x10, 0x07, 0x12, 0x21, 0x0a, 0x1d, 0x4f,
+	0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x55, 0x54, 0x5f,
+	0x46, 0x4f, 0x52, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x10, 0x08, 0x12, 0x1a,
+	0x0a, 0x16, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44,
+	0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x45, 0x44, 0x10, 0x09, 0x12, 0x20, 0x0a, 0x1c, 0x4f, 0x52,
+	0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56,
+	0x45, 0x52, 0x59, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x0a, 0x2a, 0x9a, 0x02, 0x0a,
+	0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x1f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x28, 0x0a, 0x24, 0x43, 0x41, 0x4e, 0x43,
+	0x45, 0x4c, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
+	0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4f, 0x55, 0x54, 0x5f, 0x4f, 0x46,
+	0x5f, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x10, 0x02, 0x12, 0x25, 0x0a, 0x21, 0x43, 0x41, 0x4e, 0x43,
+	0x45, 0x4c, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x4b, 0x49, 0x54, 0x43, 0x48, 0x45, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x12,
+	0x21, 0x0a, 0x1d, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45,
+	0x10, 0x04, 0x12, 0x26, 0x0a, 0x22, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x41,
+	0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x06, 0x2a, 0x54, 0x0a, 0x09, 0x53, 0x74, 0x61,
+	0x66, 0x66, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x54, 0x41, 0x46, 0x46, 0x5f,
+	0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x46, 0x46, 0x5f, 0x52, 0x4f, 0x4c, 0x45,
+	0x5f, 0x43, 0x4f, 0x4f, 0x4b, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x46, 0x46,
+	0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x4d, 0x41, 0x4e, 0x41, 0x47, 0x45, 0x52, 0x10, 0x02, 0x2a,
+	0x6e, 0x0a, 0x0a, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a,
+	0x17, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45,
+	0x46, 0x55, 0x4e, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x01,
+	0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x46,
+	0x55, 0x4e, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x03, 0x2a,
+	0xcf, 0x02, 0x0a, 0x08, 0x41, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x14,
+	0x41, 0x4c, 0x4c, 0x45, 0x52, 0x47, 0x45, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x4c, 0x4c, 0x45, 0x52, 0x47,
+	0x45, 0x4e, 0x5f, 0x43, 0x45, 0x4c, 0x45, 0x52, 0x59, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x41,
+	0x4c, 0x4c, 0x45, 0x52, 0x47, 0x45, 0x4e, 0x5f, 0x43, 0x52, 0x55, 0x53, 0x54, 0x41, 0x43, 0x45,
+	0x41, 0x4e, 0x53, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x4c, 0x4c, 0x45, 0x52, 0x47, 0x45,
+	0x4e, 0x5f, 0x45, 0x47, 0x47, 0x53, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x4c, 0x4c, 0x45,
+	0x52, 0x47, 0x45, 0x4e, 0x5f, 0x46, 0x49, 0x53, 0x48, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x41,
+	0x4c, 0x4c, 0x45, 0x52, 0x47, 0x45, 0x4e, 0x5f, 0x47, 0x4c, 0x55, 0x54, 0x45, 0x4e, 0x10, 0x05,
+	0x12, 0x12, 0x0a, 0x0e, 0x41, 0x4c, 0x4c, 0x45, 0x52, 0x47, 0x45, 0x4e, 0x5f, 0x4c, 0x55, 0x50,
+	0x49, 0x4e, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x4c, 0x4c, 0x45, 0x52, 0x47, 0x45, 0x4e,
+	0x5f, 0x4d, 0x49, 0x4c, 0x4b, 0x10, 0x07, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x4c, 0x4c, 0x45, 0x52,
+	0x47, 0x45, 0x4e, 0x5f, 0x4d, 0x4f, 0x4c, 0x4c, 0x55, 0x53, 0x43, 0x53, 0x10, 0x08, 0x12, 0x14,
+	0x0a, 0x10, 0x41, 0x4c, 0x4c, 0x45, 0x52, 0x47, 0x45, 0x4e, 0x5f, 0x4d, 0x55, 0x53, 0x54, 0x41,
+	0x52, 0x44, 0x10, 0x09, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x4c, 0x4c, 0x45, 0x52, 0x47, 0x45, 0x4e,
+	0x5f, 0x50, 0x45, 0x41, 0x4e, 0x55, 0x54, 0x53, 0x10, 0x0a, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x4c,
+	0x4c, 0x45, 0x52, 0x47, 0x45, 0x4e, 0x5f, 0x53, 0x45, 0x53, 0x41, 0x4d, 0x45, 0x10, 0x0b, 0x12,
+	0x10, 0x0a, 0x0c, 0x41, 0x4c, 0x4c, 0x45, 0x52, 0x47, 0x45, 0x4e, 0x5f, 0x53, 0x4f, 0x59, 0x10,
+	0x0c, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x4c, 0x4c, 0x45, 0x52, 0x47, 0x45, 0x4e, 0x5f, 0x53, 0x55,
+	0x4c, 0x50, 0x48, 0x49, 0x54, 0x45, 0x53, 0x10, 0x0d, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x4c, 0x4c,
+	0x45, 0x52, 0x47, 0x45, 0x4e, 0x5f, 0x54, 0x52, 0x45, 0x45, 0x5f, 0x4e, 0x55, 0x54, 0x53, 0x10,
+	0x0e, 0x2a, 0xcc, 0x01, 0x0a, 0x0b, 0x44, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x46, 0x6c, 0x61,
+	0x67, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x49, 0x45, 0x54, 0x41, 0x52, 0x59, 0x5f, 0x46, 0x4c, 0x41,
+	0x47, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x1b, 0x0a, 0x17, 0x44, 0x49, 0x45, 0x54, 0x41, 0x52, 0x59, 0x5f, 0x46, 0x4c, 0x41, 0x47, 0x5f,
+	0x56, 0x45, 0x47, 0x45, 0x54, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12,
+	0x44, 0x49, 0x45, 0x54, 0x41, 0x52, 0x59, 0x5f, 0x46, 0x4c, 0x41, 0x47, 0x5f, 0x56, 0x45, 0x47,
+	0x41, 0x4e, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x49, 0x45, 0x54, 0x41, 0x52, 0x59, 0x5f,
+	0x46, 0x4c, 0x41, 0x47, 0x5f, 0x47, 0x4c, 0x55, 0x54, 0x45, 0x4e, 0x5f, 0x46, 0x52, 0x45, 0x45,
+	0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x44, 0x49, 0x45, 0x54, 0x41, 0x52, 0x59, 0x5f, 0x46, 0x4c,
+	0x41, 0x47, 0x5f, 0x44, 0x41, 0x49, 0x52, 0x59, 0x5f, 0x46, 0x52, 0x45, 0x45, 0x10, 0x04, 0x12,
+	0x16, 0x0a, 0x12, 0x44, 0x49, 0x45, 0x54, 0x41, 0x52, 0x59, 0x5f, 0x46, 0x4c, 0x41, 0x47, 0x5f,
+	0x48, 0x41, 0x4c, 0x41, 0x4c, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x49, 0x45, 0x54, 0x41,
+	0x52, 0x59, 0x5f, 0x46, 0x4c, 0x41, 0x47, 0x5f, 0x4b, 0x4f, 0x53, 0x48, 0x45, 0x52, 0x10, 0x06,
+	0x2a, 0x71, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b,
+	0x0a, 0x17, 0x44, 0x49, 0x53, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x44,
+	0x49, 0x53, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49,
+	0x4e, 0x47, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x49, 0x53, 0x48, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x44,
+	0x49, 0x53, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56, 0x4f, 0x49, 0x44, 0x45,
+	0x44, 0x10, 0x03, 0x32, 0xbd, 0x04, 0x0a, 0x0c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x10, 0x2e, 0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x49, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x12, 0x1a, 0x2e, 0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x67,
+	0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x57, 0x69,
+	0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x49, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x2e,
+	0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x76,
+	0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x2e, 0x67, 0x76, 0x65,
+	0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x67, 0x76, 0x65,
+	0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x0b,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x67, 0x76,
+	0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x67, 0x76, 0x65,
+	0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x0c,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x68, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x67,
+	0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44,
+	0x69, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x67,
+	0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x4c,
+	0x0a, 0x0f, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x7a, 0x65, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x12, 0x21, 0x2e, 0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x7a, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x0b,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x67, 0x76,
+	0x65, 0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x67, 0x76, 0x65,
+	0x6c, 0x6f, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x30, 0x01, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x64, 0x61, 0x6e, 0x62, 0x72, 0x61, 0x74, 0x6f, 0x39, 0x39, 0x39, 0x2f, 0x79, 0x75,
+	0x6e, 0x6f, 0x2d, 0x67, 0x76, 0x65, 0x6c, 0x6f, 0x7a, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+})
+
+var (
+	file_internal_grpc_pb_orders_proto_rawDescOnce sync.Once
+	file_internal_grpc_pb_orders_proto_rawDescData []byte
+)
+
+func file_internal_grpc_pb_orders_proto_rawDescGZIP() []byte {
+	file_internal_grpc_pb_orders_proto_rawDescOnce.Do(func() {
+		file_internal_grpc_pb_orders_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_internal_grpc_pb_orders_proto_rawDesc), len(file_internal_grpc_pb_orders_proto_rawDesc)))
+	})
+	return file_internal_grpc_pb_orders_proto_rawDescData
+}
+
+var file_internal_grpc_pb_orders_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_internal_grpc_pb_orders_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_internal_grpc_pb_orders_proto_goTypes = []any{
+	(OrderSource)(0),               // 0: gveloz.v1.OrderSource
+	(OrderStatus)(0),               // 1: gveloz.v1.OrderStatus
+	(CancellationReason)(0),        // 2: gveloz.v1.CancellationReason
+	(StaffRole)(0),                 // 3: gveloz.v1.StaffRole
+	(RefundType)(0),                // 4: gveloz.v1.RefundType
+	(Allergen)(0),                  // 5: gveloz.v1.Allergen
+	(DietaryFlag)(0),               // 6: gveloz.v1.DietaryFlag
+	(DishStatus)(0),                // 7: gveloz.v1.DishStatus
+	(*Allergy)(nil),                // 8: gveloz.v1.Allergy
+	(*DietaryWarning)(nil),         // 9: gveloz.v1.DietaryWarning
+	(*Dish)(nil),                   // 10: gveloz.v1.Dish
+	(*DeliveryDetails)(nil),        // 11: gveloz.v1.DeliveryDetails
+	(*Courier)(nil),                // 12: gveloz.v1.Courier
+	(*Refund)(nil),                 // 13: gveloz.v1.Refund
+	(*Cancellation)(nil),           // 14: gveloz.v1.Cancellation
+	(*Order)(nil),                  // 15: gveloz.v1.Order
+	(*StatusChange)(nil),           // 16: gveloz.v1.StatusChange
+	(*OrderWithStatusHistory)(nil), // 17: gveloz.v1.OrderWithStatusHistory
+	(*CreateOrderRequest)(nil),     // 18: gveloz.v1.CreateOrderRequest
+	(*GetOrderRequest)(nil),        // 19: gveloz.v1.GetOrderRequest
+	(*ListOrdersRequest)(nil),      // 20: gveloz.v1.ListOrdersRequest
+	(*ListOrdersResponse)(nil),     // 21: gveloz.v1.ListOrdersResponse
+	(*UpdateStatusRequest)(nil),    // 22: gveloz.v1.UpdateStatusRequest
+	(*CancelOrderRequest)(nil),     // 23: gveloz.v1.CancelOrderRequest
+	(*UpdateDishesRequest)(nil),    // 24: gveloz.v1.UpdateDishesRequest
+	(*PrioritizeOrderRequest)(nil), // 25: gveloz.v1.PrioritizeOrderRequest
+	(*WatchOrdersRequest)(nil),     // 26: gveloz.v1.WatchOrdersRequest
+	(*OrderEvent)(nil),             // 27: gveloz.v1.OrderEvent
+	(*timestamppb.Timestamp)(nil),  // 28: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),          // 29: google.protobuf.Empty
+}
+var file_internal_grpc_pb_orders_proto_depIdxs = []int32{
+	5,  // 0: gveloz.v1.Allergy.allergen:type_name -> gveloz.v1.Allergen
+	5,  // 1: gveloz.v1.DietaryWarning.allergen:type_name -> gveloz.v1.Allergen
+	6,  // 2: gveloz.v1.DietaryWarning.dietary:type_name -> gveloz.v1.DietaryFlag
+	7,  // 3: gveloz.v1.Dish.status:type_name -> gveloz.v1.DishStatus
+	28, // 4: gveloz.v1.Courier.assigned_at:type_name -> google.protobuf.Timestamp
+	4,  // 5: gveloz.v1.Refund.type:type_name -> gveloz.v1.RefundType
+	2,  // 6: gveloz.v1.Cancellation.reason:type_name -> gveloz.v1.CancellationReason
+	3,  // 7: gveloz.v1.Cancellation.cancelled_by:type_name -> gveloz.v1.StaffRole
+	1,  // 8: gveloz.v1.Cancellation.previous_status:type_name -> gveloz.v1.OrderStatus
+	28, // 9: gveloz.v1.Cancellation.cancelled_at:type_name -> google.protobuf.Timestamp
+	10, // 10: gveloz.v1.Cancellation.waste:type_name -> gveloz.v1.Dish
+	13, // 11: gveloz.v1.Cancellation.refund:type_name -> gveloz.v1.Refund
+	1,  // 12: gveloz.v1.Order.status:type_name -> gveloz.v1.OrderStatus
+	28, // 13: gveloz.v1.Order.time:type_name -> google.protobuf.Timestamp
+	10, // 14: gveloz.v1.Order.dishes:type_name -> gveloz.v1.Dish
+	0,  // 15: gveloz.v1.Order.source:type_name -> gveloz.v1.OrderSource
+	11, // 16: gveloz.v1.Order.delivery:type_name -> gveloz.v1.DeliveryDetails
+	28, // 17: gveloz.v1.Order.ready_at:type_name -> google.protobuf.Timestamp
+	12, // 18: gveloz.v1.Order.courier:type_name -> gveloz.v1.Courier
+	28, // 19: gveloz.v1.Order.release_at:type_name -> google.protobuf.Timestamp
+	28, // 20: gveloz.v1.Order.created_at:type_name -> google.protobuf.Timestamp
+	14, // 21: gveloz.v1.Order.cancellation:type_name -> gveloz.v1.Cancellation
+	8,  // 22: gveloz.v1.Order.allergies:type_name -> gveloz.v1.Allergy
+	6,  // 23: gveloz.v1.Order.dietary:type_name -> gveloz.v1.DietaryFlag
+	9,  // 24: gveloz.v1.Order.warnings:type_name -> gveloz.v1.DietaryWarning
+	1,  // 25: gveloz.v1.StatusChange.status:type_name -> gveloz.v1.OrderStatus
+	28, // 26: gveloz.v1.StatusChange.timestamp:type_name -> google.protobuf.Timestamp
+	15, // 27: gveloz.v1.OrderWithStatusHistory.order:type_name -> gveloz.v1.Order
+	16, // 28: gveloz.v1.OrderWithStatusHistory.status_history:type_name -> gveloz.v1.StatusChange
+	28, // 29: gveloz.v1.CreateOrderRequest.time:type_name -> google.protobuf.Timestamp
+	10, // 30: gveloz.v1.CreateOrderRequest.dishes:type_name -> gveloz.v1.Dish
+	0,  // 31: gveloz.v1.CreateOrderRequest.source:type_name -> gveloz.v1.OrderSource
+	11, // 32: gveloz.v1.CreateOrderRequest.delivery:type_name -> gveloz.v1.DeliveryDetails
+	28, // 33: gveloz.v1.CreateOrderRequest.ready_at:type_name -> google.protobuf.Timestamp
+	8,  // 34: gveloz.v1.CreateOrderRequest.allergies:type_name -> gveloz.v1.Allergy
+	6,  // 35: gveloz.v1.CreateOrderRequest.dietary:type_name -> gveloz.v1.DietaryFlag
+	15, // 36: gveloz.v1.ListOrdersResponse.orders:type_name -> gveloz.v1.Order
+	1,  // 37: gveloz.v1.UpdateStatusRequest.status:type_name -> gveloz.v1.OrderStatus
+	2,  // 38: gveloz.v1.CancelOrderRequest.reason:type_name -> gveloz.v1.CancellationReason
+	10, // 39: gveloz.v1.UpdateDishesRequest.dishes:type_name -> gveloz.v1.Dish
+	15, // 40: gveloz.v1.OrderEvent.order:type_name -> gveloz.v1.Order
+	28, // 41: gveloz.v1.OrderEvent.timestamp:type_name -> google.protobuf.Timestamp
+	18, // 42: gveloz.v1.OrderService.CreateOrder:input_type -> gveloz.v1.CreateOrderRequest
+	19, // 43: gveloz.v1.OrderService.GetOrder:input_type -> gveloz.v1.GetOrderRequest
+	20, // 44: gveloz.v1.OrderService.ListOrders:input_type -> gveloz.v1.ListOrdersRequest
+	22, // 45: gveloz.v1.OrderService.UpdateStatus:input_type -> gveloz.v1.UpdateStatusRequest
+	23, // 46: gveloz.v1.OrderService.CancelOrder:input_type -> gveloz.v1.CancelOrderRequest
+	24, // 47: gveloz.v1.OrderService.UpdateDishes:input_type -> gveloz.v1.UpdateDishesRequest
+	25, // 48: gveloz.v1.OrderService.PrioritizeOrder:input_type -> gveloz.v1.PrioritizeOrderRequest
+	26, // 49: gveloz.v1.OrderService.WatchOrders:input_type -> gveloz.v1.WatchOrdersRequest
+	15, // 50: gveloz.v1.OrderService.CreateOrder:output_type -> gveloz.v1.Order
+	17, // 51: gveloz.v1.OrderService.GetOrder:output_type -> gveloz.v1.OrderWithStatusHistory
+	21, // 52: gveloz.v1.OrderService.ListOrders:output_type -> gveloz.v1.ListOrdersResponse
+	15, // 53: gveloz.v1.OrderService.UpdateStatus:output_type -> gveloz.v1.Order
+	15, // 54: gveloz.v1.OrderService.CancelOrder:output_type -> gveloz.v1.Order
+	15, // 55: gveloz.v1.OrderService.UpdateDishes:output_type -> gveloz.v1.Order
+	29, // 56: gveloz.v1.OrderService.PrioritizeOrder:output_type -> google.protobuf.Empty
+	27, // 57: gveloz.v1.OrderService.WatchOrders:output_type -> gveloz.v1.OrderEvent
+	50, // [50:58] is the sub-list for method output_type
+	42, // [42:50] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
+}
+
+func init() { file_internal_grpc_pb_orders_proto_init() }
+func file_internal_grpc_pb_orders_proto_init() {
+	if File_internal_grpc_pb_orders_proto != nil {
+		return
+	}
+	file_internal_grpc_pb_orders_proto_msgTypes[7].OneofWrappers = []any{}
+	file_internal_grpc_pb_orders_proto_msgTypes[10].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_grpc_pb_orders_proto_rawDesc), len(file_internal_grpc_pb_orders_proto_rawDesc)),
+			NumEnums:      8,
+			NumMessages:   20,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_internal_grpc_pb_orders_proto_goTypes,
+		DependencyIndexes: file_internal_grpc_pb_orders_proto_depIdxs,
+		EnumInfos:         file_internal_grpc_pb_orders_proto_enumTypes,
+		MessageInfos:      file_internal_grpc_pb_orders_proto_msgTypes,
+	}.Build()
+	File_internal_grpc_pb_orders_proto = out.File
+	file_internal_grpc_pb_orders_proto_goTypes = nil
+	file_internal_grpc_pb_orders_proto_depIdxs = nil
+}
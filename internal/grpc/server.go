@@ -0,0 +1,39 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/danbrato999/yuno-gveloz/internal/grpc/pb"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Same key as the X-API-Key header of the REST API, gRPC metadata keys are lower case
+const apiKeyMetadata = "x-api-key"
+
+func GetServer(orderService services.OrderService, subscriber services.EventSubscriber, staffKeys domain.StaffKeys) *grpc.Server {
+	server := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.UnaryInterceptor(staffInterceptor(staffKeys)),
+	)
+	pb.RegisterOrderServiceServer(server, NewOrdersServer(orderService, subscriber))
+
+	return server
+}
+
+// staffInterceptor gives the calls sent with a staff API key the role of its owner
+func staffInterceptor(keys domain.StaffKeys) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		for _, key := range metadata.ValueFromIncomingContext(ctx, apiKeyMetadata) {
+			if role, ok := keys[key]; ok {
+				ctx = services.WithStaffRole(ctx, role)
+				break
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,195 @@
+// Package fooddash maps the orders of the FoodDash delivery marketplace
+package fooddash
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+)
+
+const ProviderName = "fooddash"
+const SignatureHeader = "X-FoodDash-Signature"
+
+// Items are expanded into a dish per unit, so the quantities are bounded before that
+const maxItemQuantity = 100
+
+type orderPayload struct {
+	OrderID      string     `json:"order_id"`
+	CreatedAt    time.Time  `json:"created_at"`
+	ScheduledFor *time.Time `json:"scheduled_for"`
+	Customer     struct {
+		Name  string `json:"name"`
+		Phone string `json:"phone"`
+	} `json:"customer"`
+	Dropoff struct {
+		Address string `json:"address"`
+	} `json:"dropoff"`
+	Items []struct {
+		Name     string `json:"name"`
+		Quantity int    `json:"quantity"`
+	} `json:"items"`
+	DeliveryFee struct {
+		Amount uint `json:"amount"`
+	} `json:"delivery_fee"`
+}
+
+type statusPayload struct {
+	Status    string    `json:"status"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+var statuses = map[domain.OrderStatus]string{
+	domain.OrderStatusScheduled:       "ACCEPTED",
+	domain.OrderStatusPending:         "ACCEPTED",
+	domain.OrderStatusPreparing:       "PREPARING",
+	domain.OrderStatusReady:           "READY",
+	domain.OrderStatusAwaitingCourier: "READY",
+	domain.OrderStatusOutForDelivery:  "PICKED_UP",
+	domain.OrderStatusDelivered:       "DELIVERED",
+	domain.OrderStatusDeliveryFailed:  "FAILED",
+	domain.OrderStatusCancelled:       "CANCELLED",
+}
+
+type Provider struct {
+	secret      string
+	callbackURL string
+}
+
+// New returns the FoodDash provider. Payloads are signed with the shared secret both ways,
+// and the status changes are sent to callbackURL
+func New(secret string, callbackURL string) services.IntegrationProvider {
+	return &Provider{
+		secret:      secret,
+		callbackURL: callbackURL,
+	}
+}
+
+func (p *Provider) Name() string {
+	return ProviderName
+}
+
+func (p *Provider) VerifySignature(payload []byte, headers http.Header) error {
+	signature, err := hex.DecodeString(headers.Get(SignatureHeader))
+	if err != nil || !hmac.Equal(signature, p.sign(payload)) {
+		return domain.ErrInvalidIntegrationSignature
+	}
+
+	return nil
+}
+
+func (p *Provider) ParseOrder(payload []byte) (*domain.NewOrder, error) {
+	var order orderPayload
+
+	if err := json.Unmarshal(payload, &order); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidIntegrationPayload, err.Error())
+	}
+
+	if err := validate(order); err != nil {
+		return nil, err
+	}
+
+	var dishes []domain.Dish
+
+	for _, item := range order.Items {
+		for range max(item.Quantity, 1) {
+			dishes = append(dishes, domain.Dish{Name: item.Name})
+		}
+	}
+
+	return &domain.NewOrder{
+		Time:   order.CreatedAt,
+		Dishes: dishes,
+		Source: domain.OrderSourceDelivery,
+		Delivery: &domain.DeliveryDetails{
+			Address:      order.Dropoff.Address,
+			ContactName:  order.Customer.Name,
+			ContactPhone: order.Customer.Phone,
+			FeeCents:     order.DeliveryFee.Amount,
+		},
+		ReadyAt: order.ScheduledFor,
+		External: &domain.ExternalReference{
+			Provider: ProviderName,
+			ID:       order.OrderID,
+		},
+	}, nil
+}
+
+func (p *Provider) StatusCallback(ctx context.Context, event domain.OrderEvent) (*http.Request, error) {
+	status, ok := statuses[event.Order.Status]
+	if !ok {
+		return nil, nil
+	}
+
+	body, err := json.Marshal(statusPayload{
+		Status:    status,
+		UpdatedAt: event.Timestamp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := url.JoinPath(p.callbackURL, "orders", event.Order.External.ID, "status")
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, hex.EncodeToString(p.sign(body)))
+
+	return req, nil
+}
+
+func (p *Provider) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(p.secret))
+	mac.Write(payload)
+
+	return mac.Sum(nil)
+}
+
+func validate(order orderPayload) error {
+	missing := ""
+
+	switch {
+	case order.OrderID == "":
+		missing = "order_id"
+	case order.CreatedAt.IsZero():
+		missing = "created_at"
+	case order.Customer.Name == "" || order.Customer.Phone == "":
+		missing = "customer"
+	case order.Dropoff.Address == "":
+		missing = "dropoff.address"
+	case len(order.Items) == 0:
+		missing = "items"
+	}
+
+	for _, item := range order.Items {
+		if missing == "" && item.Name == "" {
+			missing = "items.name"
+		}
+
+		if item.Quantity > maxItemQuantity {
+			return fmt.Errorf("%w: items.quantity over %d", domain.ErrInvalidIntegrationPayload, maxItemQuantity)
+		}
+	}
+
+	if missing != "" {
+		return fmt.Errorf("%w: missing %s", domain.ErrInvalidIntegrationPayload, missing)
+	}
+
+	return nil
+}
@@ -0,0 +1,13 @@
+package fooddash_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestFooddash(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "FoodDash Suite")
+}
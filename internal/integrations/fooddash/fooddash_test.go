@@ -0,0 +1,155 @@
+package fooddash_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/danbrato999/yuno-gveloz/internal/integrations/fooddash"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const secret = "fooddash-secret"
+
+func fixture(name string) []byte {
+	payload, err := os.ReadFile(filepath.Join("testdata", name))
+	Expect(err).ToNot(HaveOccurred())
+
+	return payload
+}
+
+func sign(payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+var _ = Describe("Provider", func() {
+	var provider services.IntegrationProvider
+
+	BeforeEach(func() {
+		provider = fooddash.New(secret, "https://api.fooddash.example/v2/")
+	})
+
+	Describe("VerifySignature", func() {
+		It("should accept payloads signed with the secret", func() {
+			payload := fixture("order.json")
+			headers := http.Header{}
+			headers.Set(fooddash.SignatureHeader, sign(payload))
+
+			Expect(provider.VerifySignature(payload, headers)).To(Succeed())
+		})
+
+		DescribeTable("invalid signatures", func(signature string) {
+			headers := http.Header{}
+			headers.Set(fooddash.SignatureHeader, signature)
+
+			err := provider.VerifySignature(fixture("order.json"), headers)
+			Expect(err).To(MatchError(domain.ErrInvalidIntegrationSignature))
+		},
+			Entry("when missing", ""),
+			Entry("when not hex encoded", "not-a-signature"),
+			Entry("when signing another payload", sign(fixture("order_scheduled.json"))),
+		)
+	})
+
+	Describe("ParseOrder", func() {
+		It("should map delivery orders", func() {
+			order, err := provider.ParseOrder(fixture("order.json"))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*order).To(Equal(domain.NewOrder{
+				Time: time.Date(2025, 2, 10, 12, 0, 0, 0, time.UTC),
+				Dishes: []domain.Dish{
+					{Name: "Pizza Margherita"},
+					{Name: "Pizza Margherita"},
+					{Name: "Tiramisu"},
+				},
+				Source: domain.OrderSourceDelivery,
+				Delivery: &domain.DeliveryDetails{
+					Address:      "Av. Corrientes 1234, 5B",
+					ContactName:  "Ana Perez",
+					ContactPhone: "+54 9 11 5555-0000",
+					FeeCents:     350,
+				},
+				External: &domain.ExternalReference{Provider: fooddash.ProviderName, ID: "FD-10023"},
+			}))
+		})
+
+		It("should map scheduled orders", func() {
+			order, err := provider.ParseOrder(fixture("order_scheduled.json"))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order.Dishes).To(HaveLen(12))
+			Expect(*order.ReadyAt).To(Equal(time.Date(2025, 2, 10, 20, 30, 0, 0, time.UTC)))
+			Expect(order.External.ID).To(Equal("FD-10024"))
+		})
+
+		DescribeTable("invalid payloads", func(name string) {
+			order, err := provider.ParseOrder(fixture(name))
+
+			Expect(order).To(BeNil())
+			Expect(err).To(MatchError(domain.ErrInvalidIntegrationPayload))
+		},
+			Entry("without items", "order_without_items.json"),
+			Entry("without address", "order_without_address.json"),
+			Entry("with malformed json", "malformed.json"),
+			Entry("with a huge quantity", "order_with_huge_quantity.json"),
+		)
+	})
+
+	Describe("StatusCallback", func() {
+		var event domain.OrderEvent
+
+		BeforeEach(func() {
+			event = domain.OrderEvent{
+				Type: domain.OrderEventStatusChanged,
+				Order: domain.Order{
+					ID:       1,
+					Status:   domain.OrderStatusOutForDelivery,
+					NewOrder: domain.NewOrder{External: &domain.ExternalReference{Provider: fooddash.ProviderName, ID: "FD-10023"}},
+				},
+				Timestamp: time.Date(2025, 2, 10, 12, 30, 0, 0, time.UTC),
+			}
+		})
+
+		It("should build a signed status update", func() {
+			req, err := provider.StatusCallback(context.Background(), event)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(req.Method).To(Equal(http.MethodPost))
+			Expect(req.URL.String()).To(Equal("https://api.fooddash.example/v2/orders/FD-10023/status"))
+
+			body, err := io.ReadAll(req.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(req.Header.Get(fooddash.SignatureHeader)).To(Equal(sign(body)))
+
+			var payload map[string]string
+			Expect(json.Unmarshal(body, &payload)).To(Succeed())
+			Expect(payload).To(Equal(map[string]string{
+				"status":     "PICKED_UP",
+				"updated_at": "2025-02-10T12:30:00Z",
+			}))
+		})
+
+		It("should skip statuses unknown to FoodDash", func() {
+			event.Order.Status = domain.OrderStatusDone
+
+			req, err := provider.StatusCallback(context.Background(), event)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(req).To(BeNil())
+		})
+	})
+})
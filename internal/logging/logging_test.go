@@ -0,0 +1,80 @@
+package logging_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/danbrato999/yuno-gveloz/internal/logging"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var _ = Describe("Logger", func() {
+	var (
+		output *bytes.Buffer
+		logger *slog.Logger
+	)
+
+	BeforeEach(func() {
+		output = &bytes.Buffer{}
+		logger = logging.New(output, slog.LevelInfo)
+	})
+
+	lastLine := func() map[string]any {
+		var line map[string]any
+		Expect(json.Unmarshal(output.Bytes(), &line)).To(Succeed())
+		return line
+	}
+
+	It("should add the request ID and trace of the context", func() {
+		spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: trace.TraceID{1},
+			SpanID:  trace.SpanID{2},
+		})
+
+		ctx := logging.WithRequestID(context.Background(), "req-1")
+		ctx = trace.ContextWithSpanContext(ctx, spanContext)
+
+		logger.With(slog.Int("order_id", 7)).InfoContext(ctx, "order created")
+
+		line := lastLine()
+		Expect(line).To(HaveKeyWithValue("msg", "order created"))
+		Expect(line).To(HaveKeyWithValue("order_id", BeNumerically("==", 7)))
+		Expect(line).To(HaveKeyWithValue("request_id", "req-1"))
+		Expect(line).To(HaveKeyWithValue("trace_id", spanContext.TraceID().String()))
+		Expect(line).To(HaveKeyWithValue("span_id", spanContext.SpanID().String()))
+	})
+
+	It("should leave out what the context doesn't have", func() {
+		logger.InfoContext(context.Background(), "started")
+
+		line := lastLine()
+		Expect(line).ToNot(HaveKey("request_id"))
+		Expect(line).ToNot(HaveKey("trace_id"))
+	})
+
+	It("should skip the lines below its level", func() {
+		logger.Debug("query")
+
+		Expect(output.Len()).To(BeZero())
+	})
+})
+
+var _ = Describe("ParseLevel", func() {
+	It("should default to info", func() {
+		Expect(logging.ParseLevel("")).To(Equal(slog.LevelInfo))
+	})
+
+	It("should parse the level names", func() {
+		Expect(logging.ParseLevel("debug")).To(Equal(slog.LevelDebug))
+		Expect(logging.ParseLevel("WARN")).To(Equal(slog.LevelWarn))
+	})
+
+	It("should reject unknown levels", func() {
+		_, err := logging.ParseLevel("verbose")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,79 @@
+package menu
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+)
+
+// Catalog is an in memory menu catalog, matching the dishes by name regardless of case and spacing
+type Catalog struct {
+	items map[string]domain.MenuItem
+}
+
+func NewCatalog(items []domain.MenuItem) (*Catalog, error) {
+	catalog := &Catalog{items: make(map[string]domain.MenuItem, len(items))}
+
+	for _, item := range items {
+		key := itemKey(item.Name)
+		if key == "" {
+			return nil, fmt.Errorf("menu item without a name")
+		}
+
+		if _, ok := catalog.items[key]; ok {
+			return nil, fmt.Errorf("menu item %q is listed twice", item.Name)
+		}
+
+		for _, allergen := range item.Allergens {
+			if !allergen.IsValid() {
+				return nil, fmt.Errorf("menu item %q: unknown allergen %q", item.Name, allergen)
+			}
+		}
+
+		for _, flag := range item.Dietary {
+			if !flag.IsValid() {
+				return nil, fmt.Errorf("menu item %q: unknown dietary flag %q", item.Name, flag)
+			}
+		}
+
+		catalog.items[key] = item
+	}
+
+	return catalog, nil
+}
+
+// LoadFile reads the catalog from a JSON array of menu items
+func LoadFile(path string) (*Catalog, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []domain.MenuItem
+	if err = json.Unmarshal(content, &items); err != nil {
+		return nil, fmt.Errorf("invalid menu file %s: %w", path, err)
+	}
+
+	return NewCatalog(items)
+}
+
+func (c *Catalog) FindItem(_ context.Context, name string) (*domain.MenuItem, error) {
+	item, ok := c.items[itemKey(name)]
+	if !ok {
+		return nil, nil
+	}
+
+	return &item, nil
+}
+
+func (c *Catalog) Len() int {
+	return len(c.items)
+}
+
+func itemKey(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
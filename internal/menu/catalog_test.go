@@ -0,0 +1,49 @@
+package menu_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/internal/menu"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Catalog", func() {
+	It("should find the items regardless of case and spacing", func() {
+		catalog, err := menu.NewCatalog([]domain.MenuItem{
+			{Name: "Pad Thai", Allergens: []domain.Allergen{domain.AllergenPeanuts}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		item, err := catalog.FindItem(context.Background(), "  pad   THAI ")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(item.Allergens).To(ConsistOf(domain.AllergenPeanuts))
+
+		item, err = catalog.FindItem(context.Background(), "Ajiaco")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(item).To(BeNil())
+	})
+
+	DescribeTable("rejecting invalid items", func(item domain.MenuItem, message string) {
+		_, err := menu.NewCatalog([]domain.MenuItem{{Name: "Arepa"}, item})
+		Expect(err).To(MatchError(ContainSubstring(message)))
+	},
+		Entry("without a name", domain.MenuItem{}, "without a name"),
+		Entry("listed twice", domain.MenuItem{Name: "arepa"}, "listed twice"),
+		Entry("with unknown allergens", domain.MenuItem{Name: "Soup", Allergens: []domain.Allergen{"dust"}}, "unknown allergen"),
+		Entry("with unknown diets", domain.MenuItem{Name: "Soup", Dietary: []domain.DietaryFlag{"keto"}}, "unknown dietary flag"),
+	)
+
+	It("should load the items from a JSON file", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "menu.json")
+		content := `[{"name": "Empanadas", "allergens": ["gluten"], "dietary": ["dairy_free"]}]`
+		Expect(os.WriteFile(path, []byte(content), 0o600)).To(Succeed())
+
+		catalog, err := menu.LoadFile(path)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(catalog.Len()).To(Equal(1))
+	})
+})
@@ -0,0 +1,13 @@
+package menu_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestMenu(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Menu Suite")
+}
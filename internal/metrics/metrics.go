@@ -0,0 +1,136 @@
+package metrics
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "gveloz"
+
+// Metrics collects the HTTP, orders and database metrics into its own registry, next to the
+// Go runtime and process ones
+type Metrics struct {
+	registry          *prometheus.Registry
+	httpRequests      *prometheus.HistogramVec
+	ordersCreated     *prometheus.CounterVec
+	statusTransitions *prometheus.CounterVec
+	cancellations     *prometheus.CounterVec
+	prioritizations   prometheus.Counter
+	dbQueries         *prometheus.HistogramVec
+	dbErrors          *prometheus.CounterVec
+}
+
+// New reports the kitchen queue length on every scrape
+func New(queue services.PriorityQueue, logger *slog.Logger) *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "Duration of the HTTP requests by route and response status.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		ordersCreated: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "orders_created_total",
+			Help:      "Orders created by source.",
+		}, []string{"source"}),
+		statusTransitions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "order_status_transitions_total",
+			Help:      "Order status changes by previous and new status.",
+		}, []string{"from", "to"}),
+		cancellations: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "orders_cancelled_total",
+			Help:      "Orders cancelled by source and reason.",
+		}, []string{"source", "reason"}),
+		prioritizations: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "orders_prioritized_total",
+			Help:      "Orders moved ahead in the kitchen queue.",
+		}),
+		dbQueries: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "db_query_duration_seconds",
+			Help:      "Duration of the database queries by operation and table.",
+			Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+		}, []string{"operation", "table"}),
+		dbErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "db_query_errors_total",
+			Help:      "Failed database queries by operation and table.",
+		}, []string{"operation", "table"}),
+	}
+
+	queueLength := prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "queue_length",
+		Help:      "Orders waiting in the kitchen queue.",
+	}, func() float64 {
+		length, err := queue.Length(context.Background())
+		if err != nil {
+			logger.Error("failed to get the queue length", slog.Any("error", err))
+			return math.NaN()
+		}
+
+		return float64(length)
+	})
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequests,
+		m.ordersCreated,
+		m.statusTransitions,
+		m.cancellations,
+		m.prioritizations,
+		m.dbQueries,
+		m.dbErrors,
+		queueLength,
+	)
+
+	return m
+}
+
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+func (m *Metrics) ObserveRequest(method, route string, status int, duration time.Duration) {
+	m.httpRequests.WithLabelValues(method, route, strconv.Itoa(status)).Observe(duration.Seconds())
+}
+
+func (m *Metrics) ObserveQuery(operation, table string, duration time.Duration, err error) {
+	m.dbQueries.WithLabelValues(operation, table).Observe(duration.Seconds())
+
+	if err != nil {
+		m.dbErrors.WithLabelValues(operation, table).Inc()
+	}
+}
+
+func (m *Metrics) OrderCreated(source domain.OrderSource) {
+	m.ordersCreated.WithLabelValues(string(source)).Inc()
+}
+
+func (m *Metrics) StatusChanged(from, to domain.OrderStatus) {
+	m.statusTransitions.WithLabelValues(string(from), string(to)).Inc()
+}
+
+func (m *Metrics) OrderCancelled(source domain.OrderSource, reason domain.CancellationReason) {
+	m.cancellations.WithLabelValues(string(source), string(reason)).Inc()
+}
+
+func (m *Metrics) OrderPrioritized() {
+	m.prioritizations.Inc()
+}
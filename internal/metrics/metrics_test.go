@@ -0,0 +1,76 @@
+package metrics_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services/mocks"
+	"github.com/danbrato999/yuno-gveloz/internal/metrics"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Metrics", func() {
+	var (
+		mockPriorityQueue *mocks.MockPriorityQueue
+		appMetrics        *metrics.Metrics
+	)
+
+	BeforeEach(func() {
+		mockPriorityQueue = mocks.NewMockPriorityQueue(gomock.NewController(GinkgoT()))
+		appMetrics = metrics.New(mockPriorityQueue, testLogger)
+	})
+
+	scrape := func() string {
+		recorder := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/metrics", nil)
+		appMetrics.Handler().ServeHTTP(recorder, req)
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		return recorder.Body.String()
+	}
+
+	It("should expose the order counters", func() {
+		mockPriorityQueue.EXPECT().Length(gomock.Any()).Return(int64(4), nil)
+
+		appMetrics.OrderCreated(domain.OrderSourcePhone)
+		appMetrics.OrderCreated(domain.OrderSourcePhone)
+		appMetrics.StatusChanged(domain.OrderStatusPending, domain.OrderStatusCancelled)
+		appMetrics.OrderCancelled(domain.OrderSourcePhone, domain.CancellationReasonOutOfStock)
+		appMetrics.OrderPrioritized()
+
+		body := scrape()
+
+		Expect(body).To(ContainSubstring(`gveloz_orders_created_total{source="phone"} 2`))
+		Expect(body).To(ContainSubstring(`gveloz_order_status_transitions_total{from="pending",to="cancelled"} 1`))
+		Expect(body).To(ContainSubstring(`gveloz_orders_cancelled_total{reason="out_of_stock",source="phone"} 1`))
+		Expect(body).To(ContainSubstring("gveloz_orders_prioritized_total 1"))
+		Expect(body).To(ContainSubstring("gveloz_queue_length 4"))
+		Expect(body).To(ContainSubstring("go_goroutines"))
+	})
+
+	It("should expose the request and query durations", func() {
+		mockPriorityQueue.EXPECT().Length(gomock.Any()).Return(int64(0), nil)
+
+		appMetrics.ObserveRequest(http.MethodGet, "/api/v1/orders/:id", http.StatusOK, 30*time.Millisecond)
+		appMetrics.ObserveQuery("query", "orders", 2*time.Millisecond, nil)
+		appMetrics.ObserveQuery("create", "orders", time.Millisecond, errors.New("database is locked"))
+
+		body := scrape()
+
+		Expect(body).To(ContainSubstring(`gveloz_http_request_duration_seconds_count{method="GET",route="/api/v1/orders/:id",status="200"} 1`))
+		Expect(body).To(ContainSubstring(`gveloz_db_query_duration_seconds_count{operation="query",table="orders"} 1`))
+		Expect(body).To(ContainSubstring(`gveloz_db_query_errors_total{operation="create",table="orders"} 1`))
+		Expect(body).ToNot(ContainSubstring(`gveloz_db_query_errors_total{operation="query"`))
+	})
+
+	It("should report an unknown queue length when it can't be read", func() {
+		mockPriorityQueue.EXPECT().Length(gomock.Any()).Return(int64(0), errors.New("database is locked"))
+
+		Expect(scrape()).To(ContainSubstring("gveloz_queue_length NaN"))
+	})
+})
@@ -0,0 +1,275 @@
+package orderio
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+)
+
+// Dishes and status history entries share a single column, separated by semicolons
+const listSeparator = ";"
+
+// The import columns are a subset of the export ones, so exported files can be imported back. The
+// created_at and status_history columns of the exports are read as well, keeping the order timeline
+var csvImportColumns = []string{
+	"time",
+	"source",
+	"status",
+	"dishes",
+	"customer_id",
+	"delivery_address",
+	"delivery_contact_name",
+	"delivery_contact_phone",
+	"delivery_fee_cents",
+	"ready_at",
+}
+
+var csvRequiredColumns = []string{"time", "source", "dishes"}
+
+var csvExportColumns = slices.Concat([]string{"id"}, csvImportColumns, []string{"created_at", "late", "status_history"})
+
+// CSVReader reads orders from a CSV file with a header row. Columns are matched by name and
+// unknown ones are ignored
+type CSVReader struct {
+	reader  *csv.Reader
+	columns map[string]int
+}
+
+func NewCSVReader(r io.Reader) (*CSVReader, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("missing CSV header")
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	for _, name := range csvRequiredColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("missing CSV column %s", name)
+		}
+	}
+
+	return &CSVReader{reader: reader, columns: columns}, nil
+}
+
+func (r *CSVReader) Next() (domain.ImportRow, error) {
+	record, err := r.reader.Read()
+
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return domain.ImportRow{Number: parseErr.Line, ParseError: err}, nil
+	}
+
+	if err != nil {
+		return domain.ImportRow{}, err
+	}
+
+	line, _ := r.reader.FieldPos(0)
+	row := domain.ImportRow{Number: line}
+	row.Order, row.ParseError = r.parse(record)
+
+	return row, nil
+}
+
+func (r *CSVReader) parse(record []string) (domain.ImportedOrder, error) {
+	var (
+		order domain.ImportedOrder
+		err   error
+	)
+
+	value := func(column string) string {
+		index, ok := r.columns[column]
+		if !ok || index >= len(record) {
+			return ""
+		}
+
+		return strings.TrimSpace(record[index])
+	}
+
+	if order.Time, err = time.Parse(time.RFC3339, value("time")); err != nil {
+		return order, fmt.Errorf("invalid time: %w", err)
+	}
+
+	order.Source = domain.OrderSource(value("source"))
+	order.Status = domain.OrderStatus(value("status"))
+
+	for _, name := range strings.Split(value("dishes"), listSeparator) {
+		if name = strings.TrimSpace(name); name != "" {
+			order.Dishes = append(order.Dishes, domain.Dish{Name: name})
+		}
+	}
+
+	if customerID := value("customer_id"); customerID != "" {
+		id, err := strconv.ParseUint(customerID, 10, 64)
+		if err != nil {
+			return order, fmt.Errorf("invalid customer_id %q", customerID)
+		}
+
+		parsed := uint(id)
+		order.CustomerID = &parsed
+	}
+
+	if readyAt := value("ready_at"); readyAt != "" {
+		parsed, err := time.Parse(time.RFC3339, readyAt)
+		if err != nil {
+			return order, fmt.Errorf("invalid ready_at: %w", err)
+		}
+
+		order.ReadyAt = &parsed
+	}
+
+	if createdAt := value("created_at"); createdAt != "" {
+		parsed, err := time.Parse(time.RFC3339, createdAt)
+		if err != nil {
+			return order, fmt.Errorf("invalid created_at: %w", err)
+		}
+
+		order.CreatedAt = &parsed
+	}
+
+	for _, entry := range strings.Split(value("status_history"), listSeparator) {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+
+		status, timestamp, found := strings.Cut(entry, "@")
+		parsed, err := time.Parse(time.RFC3339, timestamp)
+		if !found || err != nil {
+			return order, fmt.Errorf("invalid status_history entry %q", entry)
+		}
+
+		order.StatusHistory = append(order.StatusHistory, domain.OrderStatusHistory{
+			Status:    domain.OrderStatus(status),
+			Timestamp: &parsed,
+		})
+	}
+
+	if address := value("delivery_address"); address != "" {
+		order.Delivery = &domain.DeliveryDetails{
+			Address:      address,
+			ContactName:  value("delivery_contact_name"),
+			ContactPhone: value("delivery_contact_phone"),
+		}
+
+		if fee := value("delivery_fee_cents"); fee != "" {
+			cents, err := strconv.ParseUint(fee, 10, 64)
+			if err != nil {
+				return order, fmt.Errorf("invalid delivery_fee_cents %q", fee)
+			}
+
+			order.Delivery.FeeCents = uint(cents)
+		}
+	}
+
+	return order, nil
+}
+
+type CSVWriter struct {
+	writer        *csv.Writer
+	headerWritten bool
+}
+
+func NewCSVWriter(w io.Writer) *CSVWriter {
+	return &CSVWriter{writer: csv.NewWriter(w)}
+}
+
+func (w *CSVWriter) Write(batch []domain.OrderWithStatusHistory) error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+
+	for _, order := range batch {
+		if err := w.writer.Write(csvRecord(order)); err != nil {
+			return err
+		}
+	}
+
+	return w.writer.Error()
+}
+
+// Flush writes the header too, so an empty export is still a valid CSV file
+func (w *CSVWriter) Flush() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+func (w *CSVWriter) writeHeader() error {
+	if w.headerWritten {
+		return nil
+	}
+
+	w.headerWritten = true
+	return w.writer.Write(csvExportColumns)
+}
+
+func csvRecord(order domain.OrderWithStatusHistory) []string {
+	dishes := make([]string, len(order.Dishes))
+	for i, dish := range order.Dishes {
+		dishes[i] = dish.Name
+	}
+
+	history := make([]string, len(order.StatusHistory))
+	for i, change := range order.StatusHistory {
+		history[i] = string(change.Status) + "@" + formatTime(change.Timestamp)
+	}
+
+	customerID := ""
+	if order.CustomerID != nil {
+		customerID = strconv.FormatUint(uint64(*order.CustomerID), 10)
+	}
+
+	var delivery domain.DeliveryDetails
+	fee := ""
+
+	if order.Delivery != nil {
+		delivery = *order.Delivery
+		fee = strconv.FormatUint(uint64(delivery.FeeCents), 10)
+	}
+
+	return []string{
+		strconv.FormatUint(uint64(order.ID), 10),
+		formatTime(&order.Time),
+		string(order.Source),
+		string(order.Status),
+		strings.Join(dishes, listSeparator),
+		customerID,
+		delivery.Address,
+		delivery.ContactName,
+		delivery.ContactPhone,
+		fee,
+		formatTime(order.ReadyAt),
+		formatTime(order.CreatedAt),
+		strconv.FormatBool(order.Late),
+		strings.Join(history, listSeparator),
+	}
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,149 @@
+package orderio_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/internal/orderio"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CSV", func() {
+	orderTime := time.Date(2025, 2, 10, 12, 0, 0, 0, time.UTC)
+
+	Describe("CSVReader", func() {
+		It("should read the orders by column name", func() {
+			reader, err := orderio.NewCSVReader(strings.NewReader(
+				"Source,time,dishes,status,customer_id,delivery_address,delivery_contact_name,delivery_contact_phone,delivery_fee_cents,notes\n" +
+					"in_person,2025-02-10T12:00:00Z,Pizza;Salad,,,,,,,ignored\n" +
+					"\"delivery\",2025-02-10T12:00:00Z,Pasta,delivery_failed,7,Calle 10,Ana,555,250,\n",
+			))
+			Expect(err).ToNot(HaveOccurred())
+
+			row, err := reader.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(row.Number).To(Equal(2))
+			Expect(row.ParseError).ToNot(HaveOccurred())
+			Expect(row.Order.Time).To(Equal(orderTime))
+			Expect(row.Order.Source).To(Equal(domain.OrderSourceInPerson))
+			Expect(row.Order.Status).To(BeEmpty())
+			Expect(row.Order.Dishes).To(Equal([]domain.Dish{{Name: "Pizza"}, {Name: "Salad"}}))
+			Expect(row.Order.Delivery).To(BeNil())
+
+			row, err = reader.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(row.Number).To(Equal(3))
+			Expect(row.Order.Status).To(Equal(domain.OrderStatusDeliveryFailed))
+			Expect(*row.Order.CustomerID).To(Equal(uint(7)))
+			Expect(row.Order.Delivery).To(Equal(&domain.DeliveryDetails{
+				Address:      "Calle 10",
+				ContactName:  "Ana",
+				ContactPhone: "555",
+				FeeCents:     250,
+			}))
+
+			_, err = reader.Next()
+			Expect(err).To(Equal(io.EOF))
+		})
+
+		It("should report the rows that can't be parsed and keep reading", func() {
+			reader, err := orderio.NewCSVReader(strings.NewReader(
+				"time,source,dishes,customer_id\n" +
+					"yesterday,phone,Pizza,\n" +
+					"2025-02-10T12:00:00Z,phone,Pizza,abc\n" +
+					"2025-02-10T12:00:00Z,phone,\"Pizza,\n",
+			))
+			Expect(err).ToNot(HaveOccurred())
+
+			row, err := reader.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(row.Number).To(Equal(2))
+			Expect(row.ParseError).To(MatchError(ContainSubstring("invalid time")))
+
+			row, err = reader.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(row.Number).To(Equal(3))
+			Expect(row.ParseError).To(MatchError(`invalid customer_id "abc"`))
+
+			row, err = reader.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(row.Number).To(Equal(4))
+			Expect(row.ParseError).To(HaveOccurred())
+		})
+
+		DescribeTable("invalid headers", func(content, message string) {
+			_, err := orderio.NewCSVReader(strings.NewReader(content))
+			Expect(err).To(MatchError(message))
+		},
+			Entry("empty file", "", "missing CSV header"),
+			Entry("missing column", "time,source\n", "missing CSV column dishes"),
+		)
+	})
+
+	Describe("CSVWriter", func() {
+		It("should write the header even without orders", func() {
+			var out bytes.Buffer
+
+			writer := orderio.NewCSVWriter(&out)
+			Expect(writer.Flush()).To(Succeed())
+			Expect(out.String()).To(Equal(
+				"id,time,source,status,dishes,customer_id,delivery_address,delivery_contact_name," +
+					"delivery_contact_phone,delivery_fee_cents,ready_at,created_at,late,status_history\n",
+			))
+		})
+
+		It("should write files that can be imported back", func() {
+			var out bytes.Buffer
+			customerID := uint(3)
+			doneAt := orderTime.Add(20 * time.Minute)
+
+			writer := orderio.NewCSVWriter(&out)
+			Expect(writer.Write([]domain.OrderWithStatusHistory{{
+				Order: domain.Order{
+					ID: 12,
+					NewOrder: domain.NewOrder{
+						Time:       orderTime,
+						Source:     domain.OrderSourceDelivery,
+						Dishes:     []domain.Dish{{Name: "Pizza"}, {Name: "Pasta, with cheese"}},
+						CustomerID: &customerID,
+						Delivery:   &domain.DeliveryDetails{Address: "Calle 10", ContactName: "Ana", ContactPhone: "555", FeeCents: 250},
+					},
+					Status:    domain.OrderStatusDelivered,
+					CreatedAt: &orderTime,
+				},
+				StatusHistory: []domain.OrderStatusHistory{
+					{Status: domain.OrderStatusPending, Timestamp: &orderTime},
+					{Status: domain.OrderStatusDelivered, Timestamp: &doneAt},
+				},
+			}})).To(Succeed())
+			Expect(writer.Flush()).To(Succeed())
+
+			Expect(out.String()).To(ContainSubstring(
+				`12,2025-02-10T12:00:00Z,delivery,delivered,"Pizza;Pasta, with cheese",3,Calle 10,Ana,555,250,,2025-02-10T12:00:00Z,false,` +
+					"pending@2025-02-10T12:00:00Z;delivered@2025-02-10T12:20:00Z\n",
+			))
+
+			reader, err := orderio.NewCSVReader(&out)
+			Expect(err).ToNot(HaveOccurred())
+
+			row, err := reader.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(row.ParseError).ToNot(HaveOccurred())
+
+			order, err := row.Order.ToOrder()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order.Status).To(Equal(domain.OrderStatusDelivered))
+			Expect(order.Dishes).To(HaveLen(2))
+			Expect(order.Delivery.FeeCents).To(Equal(uint(250)))
+			Expect(order.CreatedAt).To(Equal(&orderTime))
+			Expect(order.StatusHistory).To(Equal([]domain.OrderStatusHistory{
+				{Status: domain.OrderStatusPending, Timestamp: &orderTime},
+				{Status: domain.OrderStatusDelivered, Timestamp: &doneAt},
+			}))
+		})
+	})
+})
@@ -0,0 +1,78 @@
+package orderio
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+)
+
+const maxNDJSONLine = 1024 * 1024
+
+// NDJSONReader reads one order per line, with the same fields the orders API accepts plus the status
+type NDJSONReader struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+func NewNDJSONReader(r io.Reader) *NDJSONReader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxNDJSONLine)
+
+	return &NDJSONReader{scanner: scanner}
+}
+
+func (r *NDJSONReader) Next() (domain.ImportRow, error) {
+	for r.scanner.Scan() {
+		r.line++
+
+		line := strings.TrimSpace(r.scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		row := domain.ImportRow{Number: r.line}
+		if err := json.Unmarshal([]byte(line), &row.Order); err != nil {
+			row.ParseError = fmt.Errorf("invalid JSON: %w", err)
+		}
+
+		return row, nil
+	}
+
+	if err := r.scanner.Err(); err != nil {
+		return domain.ImportRow{}, err
+	}
+
+	return domain.ImportRow{}, io.EOF
+}
+
+type NDJSONWriter struct {
+	writer  *bufio.Writer
+	encoder *json.Encoder
+}
+
+func NewNDJSONWriter(w io.Writer) *NDJSONWriter {
+	writer := bufio.NewWriter(w)
+
+	return &NDJSONWriter{
+		writer:  writer,
+		encoder: json.NewEncoder(writer),
+	}
+}
+
+func (w *NDJSONWriter) Write(batch []domain.OrderWithStatusHistory) error {
+	for _, order := range batch {
+		if err := w.encoder.Encode(order); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *NDJSONWriter) Flush() error {
+	return w.writer.Flush()
+}
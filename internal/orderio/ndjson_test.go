@@ -0,0 +1,96 @@
+package orderio_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/internal/orderio"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NDJSON", func() {
+	It("should read one order per line, skipping blank ones", func() {
+		reader := orderio.NewNDJSONReader(strings.NewReader(
+			`{"time": "2025-02-10T12:00:00Z", "source": "phone", "dishes": [{"name": "Pizza"}], "status": "cancelled"}` + "\n" +
+				"\n" +
+				`{"time": "2025-02-10T12:00:00Z", "source": ` + "\n",
+		))
+
+		row, err := reader.Next()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(row.Number).To(Equal(1))
+		Expect(row.ParseError).ToNot(HaveOccurred())
+		Expect(row.Order.Source).To(Equal(domain.OrderSourcePhone))
+		Expect(row.Order.Status).To(Equal(domain.OrderStatusCancelled))
+		Expect(row.Order.Dishes).To(Equal([]domain.Dish{{Name: "Pizza"}}))
+
+		row, err = reader.Next()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(row.Number).To(Equal(3))
+		Expect(row.ParseError).To(MatchError(ContainSubstring("invalid JSON")))
+
+		_, err = reader.Next()
+		Expect(err).To(Equal(io.EOF))
+	})
+
+	It("should write one order per line", func() {
+		var out bytes.Buffer
+		orderTime := time.Date(2025, 2, 10, 12, 0, 0, 0, time.UTC)
+
+		writer := orderio.NewNDJSONWriter(&out)
+		Expect(writer.Write([]domain.OrderWithStatusHistory{
+			{Order: domain.Order{
+				ID:       1,
+				NewOrder: domain.NewOrder{Time: orderTime, Source: domain.OrderSourcePhone},
+				Status:   domain.OrderStatusDone,
+			}},
+			{Order: domain.Order{
+				ID:       2,
+				NewOrder: domain.NewOrder{Time: orderTime, Source: domain.OrderSourceInPerson},
+				Status:   domain.OrderStatusCancelled,
+			}},
+		})).To(Succeed())
+		Expect(writer.Flush()).To(Succeed())
+
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		Expect(lines).To(HaveLen(2))
+		Expect(lines[0]).To(ContainSubstring(`"id":1`))
+		Expect(lines[1]).To(ContainSubstring(`"status":"cancelled"`))
+	})
+
+	It("should write files that can be imported back", func() {
+		var out bytes.Buffer
+		orderTime := time.Date(2025, 2, 10, 12, 0, 0, 0, time.UTC)
+		doneAt := orderTime.Add(20 * time.Minute)
+		history := []domain.OrderStatusHistory{
+			{Status: domain.OrderStatusPending, Timestamp: &orderTime},
+			{Status: domain.OrderStatusDone, Timestamp: &doneAt},
+		}
+
+		writer := orderio.NewNDJSONWriter(&out)
+		Expect(writer.Write([]domain.OrderWithStatusHistory{{
+			Order: domain.Order{
+				ID:        1,
+				NewOrder:  domain.NewOrder{Time: orderTime, Source: domain.OrderSourcePhone, Dishes: []domain.Dish{{Name: "Pizza"}}},
+				Status:    domain.OrderStatusDone,
+				CreatedAt: &orderTime,
+			},
+			StatusHistory: history,
+		}})).To(Succeed())
+		Expect(writer.Flush()).To(Succeed())
+
+		row, err := orderio.NewNDJSONReader(&out).Next()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(row.ParseError).ToNot(HaveOccurred())
+
+		order, err := row.Order.ToOrder()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(order.Status).To(Equal(domain.OrderStatusDone))
+		Expect(order.CreatedAt).To(Equal(&orderTime))
+		Expect(order.StatusHistory).To(Equal(history))
+	})
+})
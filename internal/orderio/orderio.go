@@ -0,0 +1,67 @@
+package orderio
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
+	"github.com/danbrato999/yuno-gveloz/domain/services"
+)
+
+const (
+	FormatCSV    = "csv"
+	FormatNDJSON = "ndjson"
+)
+
+var ErrUnknownFormat = errors.New("unknown format, expected csv or ndjson")
+
+// ExportWriter encodes the exported orders as they come, without holding them
+type ExportWriter interface {
+	Write(batch []domain.OrderWithStatusHistory) error
+	Flush() error
+}
+
+func NewImportReader(format string, r io.Reader) (services.OrderImportReader, error) {
+	switch format {
+	case FormatCSV:
+		return NewCSVReader(r)
+	case FormatNDJSON:
+		return NewNDJSONReader(r), nil
+	default:
+		return nil, ErrUnknownFormat
+	}
+}
+
+func NewExportWriter(format string, w io.Writer) (ExportWriter, error) {
+	switch format {
+	case FormatCSV:
+		return NewCSVWriter(w), nil
+	case FormatNDJSON:
+		return NewNDJSONWriter(w), nil
+	default:
+		return nil, ErrUnknownFormat
+	}
+}
+
+func ContentType(format string) string {
+	if format == FormatCSV {
+		return "text/csv"
+	}
+
+	return "application/x-ndjson"
+}
+
+// FormatFromContentType returns an empty format for the content types that aren't supported
+func FormatFromContentType(contentType string) string {
+	contentType, _, _ = strings.Cut(contentType, ";")
+
+	switch strings.TrimSpace(contentType) {
+	case "text/csv":
+		return FormatCSV
+	case "application/x-ndjson", "application/jsonl", "application/x-jsonlines":
+		return FormatNDJSON
+	default:
+		return ""
+	}
+}
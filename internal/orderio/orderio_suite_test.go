@@ -0,0 +1,13 @@
+package orderio_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestOrderio(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Orderio Suite")
+}
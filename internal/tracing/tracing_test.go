@@ -0,0 +1,69 @@
+package tracing_test
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/danbrato999/yuno-gveloz/internal/tracing"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var _ = Describe("Setup", func() {
+	It("should reject unknown exporters", func() {
+		_, err := tracing.Setup("jaeger", "")
+		Expect(err).To(MatchError(tracing.ErrUnknownExporter))
+	})
+
+	It("should not record anything without an exporter", func() {
+		shutdown, err := tracing.Setup(tracing.ExporterNone, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(shutdown(context.Background())).To(Succeed())
+	})
+
+	It("should write the spans to the file as OTLP JSON", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "traces", "traces.jsonl")
+
+		shutdown, err := tracing.Setup(tracing.ExporterFile, path)
+		Expect(err).ToNot(HaveOccurred())
+
+		ctx, parent := otel.Tracer("test").Start(context.Background(), "parent")
+		_, child := otel.Tracer("test").Start(ctx, "child")
+		child.End()
+		parent.End()
+
+		Expect(shutdown(context.Background())).To(Succeed())
+
+		file, err := os.Open(path)
+		Expect(err).ToNot(HaveOccurred())
+		defer file.Close()
+
+		spans := map[string]*tracepb.Span{}
+		scanner := bufio.NewScanner(file)
+
+		for scanner.Scan() {
+			var data tracepb.TracesData
+			Expect(protojson.Unmarshal(scanner.Bytes(), &data)).To(Succeed())
+
+			for _, resourceSpans := range data.ResourceSpans {
+				Expect(resourceSpans.Resource.Attributes).To(ContainElement(HaveField("Key", "service.name")))
+
+				for _, scopeSpans := range resourceSpans.ScopeSpans {
+					for _, span := range scopeSpans.Spans {
+						spans[span.Name] = span
+					}
+				}
+			}
+		}
+
+		Expect(spans).To(HaveKey("parent"))
+		Expect(spans).To(HaveKey("child"))
+		Expect(spans["child"].TraceId).To(Equal(spans["parent"].TraceId))
+		Expect(spans["child"].ParentSpanId).To(Equal(spans["parent"].SpanId))
+	})
+})
@@ -1,24 +1,396 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/danbrato999/yuno-gveloz/domain"
 	"github.com/danbrato999/yuno-gveloz/domain/services"
 	"github.com/danbrato999/yuno-gveloz/internal/gin"
 	dbAdapter "github.com/danbrato999/yuno-gveloz/internal/gorm"
+	"github.com/danbrato999/yuno-gveloz/internal/grpc"
+	"github.com/danbrato999/yuno-gveloz/internal/integrations/fooddash"
+	"github.com/danbrato999/yuno-gveloz/internal/logging"
+	"github.com/danbrato999/yuno-gveloz/internal/menu"
+	"github.com/danbrato999/yuno-gveloz/internal/metrics"
+	"github.com/danbrato999/yuno-gveloz/internal/tracing"
 )
 
-const dbName = "main"
+const (
+	dbName                    = "main"
+	defaultTracesFile         = "data/traces.jsonl"
+	defaultSlowQueryThreshold = 200 * time.Millisecond
+)
 
 func main() {
-	db, err := dbAdapter.GetDBConnection(dbName)
+	logLevel, err := logging.ParseLevel(os.Getenv("LOG_LEVEL"))
+	if err != nil {
+		panic(err.Error())
+	}
+
+	// Logs go to stderr, leaving stdout to the output of the commands
+	logger := logging.New(os.Stderr, logLevel)
+	slog.SetDefault(logger)
+
+	slowQueryThreshold := defaultSlowQueryThreshold
+	if threshold := os.Getenv("SLOW_QUERY_THRESHOLD"); threshold != "" {
+		if slowQueryThreshold, err = time.ParseDuration(threshold); err != nil {
+			panic("SLOW_QUERY_THRESHOLD must be a duration, e.g. 500ms")
+		}
+	}
+
+	db, err := dbAdapter.GetDBConnection(dbName, dbAdapter.NewLogger(logger, slowQueryThreshold))
+	if err != nil {
+		panic(err.Error())
+	}
+
+	clock := domain.SystemClock
+	queueChecker := dbAdapter.NewQueueIntegrityChecker(db, clock)
+
+	if len(os.Args) > 1 {
+		os.Exit(runCommand(queueChecker, os.Args[1:]))
+	}
+
+	tracesFile := os.Getenv("TRACES_FILE")
+	if tracesFile == "" {
+		tracesFile = defaultTracesFile
+	}
+
+	shutdownTracing, err := tracing.Setup(os.Getenv("TRACES_EXPORTER"), tracesFile)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	orderStore := dbAdapter.NewOrderStore(db, clock)
+	orderStatusStore := dbAdapter.NewOrderStatusStore(db, clock)
+	priorityQueue := dbAdapter.NewOrderPriorityStore(db, clock)
+	customerStore := dbAdapter.NewCustomerStore(db)
+	webhookStore := dbAdapter.NewWebhookStore(db)
+	archiveStore := dbAdapter.NewOrderArchiveStore(db, clock)
+	tableStore := dbAdapter.NewTableStore(db)
+	eventBus := services.NewEventBus()
+	appMetrics := metrics.New(priorityQueue, logger)
+
+	if err = db.Use(dbAdapter.NewMetricsPlugin(appMetrics)); err != nil {
+		panic(err.Error())
+	}
+
+	cancellationPolicy, err := domain.DefaultCancellationPolicy().Override(os.Getenv("CANCELLATION_ROLES"))
+	if err != nil {
+		panic(err.Error())
+	}
+
+	staffKeys, err := domain.ParseStaffKeys(os.Getenv("STAFF_KEYS"))
+	if err != nil {
+		panic(err.Error())
+	}
+
+	requestLimits, err := gin.DefaultRequestLimits().Override(os.Getenv("REQUEST_LIMITS"))
+	if err != nil {
+		panic(err.Error())
+	}
+
+	orderOptions := []services.OrderServiceOption{
+		services.WithCustomerStore(customerStore),
+		services.WithClock(clock),
+		services.WithEventPublisher(eventBus),
+		services.WithArchiveStore(archiveStore),
+		services.WithMetrics(appMetrics),
+		services.WithLogger(logger),
+		services.WithCancellationPolicy(cancellationPolicy),
+		services.WithTableStore(tableStore),
+		services.WithMaxDishes(requestLimits.MaxDishes),
+	}
+
+	menuItems := 0
+	if menuFile := os.Getenv("MENU_FILE"); menuFile != "" {
+		catalog, err := menu.LoadFile(menuFile)
+		if err != nil {
+			panic(err.Error())
+		}
+
+		menuItems = catalog.Len()
+		orderOptions = append(orderOptions, services.WithMenuCatalog(catalog))
+	}
+
+	orderService := services.NewOrderService(orderStore, priorityQueue, orderStatusStore, orderOptions...)
+	queueService := services.NewQueueService(orderStore, priorityQueue, clock)
+	customerService := services.NewCustomerService(customerStore, orderStore)
+	tableService := services.NewTableService(tableStore, orderStore, clock)
+	webhookDispatcher := services.NewWebhookDispatcher(
+		webhookStore,
+		eventBus,
+		&http.Client{Timeout: 10 * time.Second},
+		clock,
+		services.DefaultWebhookRetryPolicy,
+		logger,
+	)
+	webhookService := services.NewWebhookService(webhookStore, services.WithWebhooksChanged(webhookDispatcher.ReloadWebhooks))
+	transferService := services.NewOrderTransferService(
+		orderStore,
+		orderStatusStore,
+		priorityQueue,
+		customerStore,
+		services.WithImportMaxDishes(requestLimits.MaxDishes),
+	)
+
+	var integrationProviders []services.IntegrationProvider
+	if secret := os.Getenv("FOODDASH_SECRET"); secret != "" {
+		integrationProviders = append(integrationProviders, fooddash.New(secret, os.Getenv("FOODDASH_CALLBACK_URL")))
+	}
+
+	integrationService := services.NewIntegrationService(orderService, orderStore, integrationProviders...)
+
+	slaPolicy, err := domain.DefaultSLAPolicy().Override(os.Getenv("SLA_LIMITS"))
+	if err != nil {
+		panic(err.Error())
+	}
+
+	requestTimeouts, err := gin.DefaultRequestTimeouts().Override(os.Getenv("REQUEST_TIMEOUTS"))
+	if err != nil {
+		panic(err.Error())
+	}
+
+	rateLimits, err := gin.DefaultRateLimits().Override(os.Getenv("RATE_LIMITS"))
+	if err != nil {
+		panic(err.Error())
+	}
+
+	var trustedProxies []string
+	if proxies := os.Getenv("TRUSTED_PROXIES"); proxies != "" {
+		for _, proxy := range strings.Split(proxies, ",") {
+			trustedProxies = append(trustedProxies, strings.TrimSpace(proxy))
+		}
+	}
+
+	retentionPolicy := domain.DefaultRetentionPolicy()
+	if days := os.Getenv("ARCHIVE_AFTER_DAYS"); days != "" {
+		value, err := strconv.Atoi(days)
+		if err != nil || value < 1 {
+			panic("ARCHIVE_AFTER_DAYS must be a positive number of days")
+		}
+
+		retentionPolicy.ArchiveAfter = time.Duration(value) * 24 * time.Hour
+	}
+
+	webhookBacklogLimit := services.DefaultWebhookBacklogLimit
+	if limit := os.Getenv("WEBHOOK_BACKLOG_LIMIT"); limit != "" {
+		if webhookBacklogLimit, err = strconv.Atoi(limit); err != nil || webhookBacklogLimit < 0 {
+			panic("WEBHOOK_BACKLOG_LIMIT must be a positive number of events")
+		}
+	}
+
+	workers := services.NewWorkers(logger)
+
+	scheduler := services.NewOrderScheduler(orderService, services.DefaultSchedulerInterval, logger)
+	workers.Go(ctx, "order_scheduler", scheduler.Run)
+
+	lateOrderWatcher := services.NewLateOrderWatcher(
+		orderStore,
+		orderStatusStore,
+		eventBus,
+		slaPolicy,
+		clock,
+		services.DefaultLateOrderWatcherInterval,
+		logger,
+	)
+	workers.Go(ctx, "late_order_watcher", lateOrderWatcher.Run)
+
+	orderArchiver := services.NewOrderArchiver(archiveStore, retentionPolicy, clock, services.DefaultArchiverInterval, logger)
+	workers.Go(ctx, "order_archiver", orderArchiver.Run)
+
+	workers.Go(ctx, "webhook_dispatcher", webhookDispatcher.Run)
+
+	integrationNotifier := services.NewIntegrationStatusNotifier(
+		eventBus,
+		&http.Client{Timeout: 10 * time.Second},
+		logger,
+		integrationProviders...,
+	)
+	workers.Go(ctx, "integration_notifier", integrationNotifier.Run)
+
+	readinessChecker := services.NewReadinessChecker(
+		dbAdapter.NewDatabaseCheck(db),
+		dbAdapter.NewMigrationsCheck(db),
+		workers.ReadinessCheck(),
+		webhookDispatcher.BacklogCheck(webhookBacklogLimit),
+	)
+
+	var diagnostics *gin.Diagnostics
+	if token := os.Getenv("DEBUG_TOKEN"); token != "" {
+		sqlDB, err := db.DB()
+		if err != nil {
+			panic(err.Error())
+		}
+
+		slaSourceLimits := make(map[domain.OrderSource]map[domain.OrderStatus]string, len(slaPolicy.SourceLimits))
+		for source, limits := range slaPolicy.SourceLimits {
+			slaSourceLimits[source] = durationStrings(limits)
+		}
+
+		integrations := make([]string, len(integrationProviders))
+		for i, provider := range integrationProviders {
+			integrations[i] = provider.Name()
+		}
+
+		diagnostics = &gin.Diagnostics{
+			Token:   token,
+			DBStats: sqlDB.Stats,
+			Config: map[string]any{
+				"log_level":            logLevel.String(),
+				"slow_query_threshold": slowQueryThreshold.String(),
+				"traces_exporter":      os.Getenv("TRACES_EXPORTER"),
+				"traces_file":          tracesFile,
+				"request_timeouts": map[string]any{
+					"default": requestTimeouts.Default.String(),
+					"routes":  durationStrings(requestTimeouts.Routes),
+				},
+				"rate_limits":           rateLimitStrings(rateLimits),
+				"request_limits":        requestLimits,
+				"trusted_proxies":       trustedProxies,
+				"sla_limits":            durationStrings(slaPolicy.StatusLimits),
+				"sla_source_limits":     slaSourceLimits,
+				"archive_after":         retentionPolicy.ArchiveAfter.String(),
+				"webhook_backlog_limit": webhookBacklogLimit,
+				"integrations":          integrations,
+				"cancellation_roles":    cancellationPolicy,
+				"staff_key_count":       len(staffKeys),
+				"menu_items":            menuItems,
+			},
+		}
+	}
+
+	grpcListener, err := net.Listen("tcp", ":9002")
 	if err != nil {
 		panic(err.Error())
 	}
 
-	orderStore := dbAdapter.NewOrderStore(db)
-	orderStatusStore := dbAdapter.NewOrderStatusStore(db)
-	priorityQueue := dbAdapter.NewOrderPriorityStore(db)
-	orderService := services.NewOrderService(orderStore, priorityQueue, orderStatusStore)
+	grpcServer := grpc.GetServer(orderService, eventBus, staffKeys)
+	go func() {
+		if err := grpcServer.Serve(grpcListener); err != nil {
+			logger.Error("grpc server stopped", slog.Any("error", err))
+		}
+	}()
+
+	server := gin.GetServer(gin.Services{
+		Orders:         orderService,
+		Queue:          queueService,
+		QueueChecker:   queueChecker,
+		Customers:      customerService,
+		Tables:         tableService,
+		Events:         eventBus,
+		Webhooks:       webhookService,
+		Integrations:   integrationService,
+		Transfers:      transferService,
+		Readiness:      readinessChecker,
+		Metrics:        appMetrics,
+		Timeouts:       requestTimeouts,
+		RateLimits:     rateLimits,
+		Limits:         requestLimits,
+		StaffKeys:      staffKeys,
+		TrustedProxies: trustedProxies,
+		Logger:         logger,
+		Diagnostics:    diagnostics,
+	})
+	httpServer := &http.Server{Addr: ":9001", Handler: server}
+	go func() {
+		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("http server stopped", slog.Any("error", err))
+			stop()
+		}
+	}()
+
+	<-ctx.Done()
+
+	// Pending spans are only exported when the tracer provider is shut down
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		logger.Error("http server shutdown failed", slog.Any("error", err))
+	}
+
+	// Watch streams never finish on their own, so they are not waited for
+	grpcServer.Stop()
+
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		logger.Error("traces flush failed", slog.Any("error", err))
+	}
+}
+
+func durationStrings[K comparable](durations map[K]time.Duration) map[K]string {
+	result := make(map[K]string, len(durations))
+	for key, duration := range durations {
+		result[key] = duration.String()
+	}
+
+	return result
+}
+
+func rateLimitStrings(limits gin.RateLimits) map[string]string {
+	format := func(limit gin.RateLimit) string {
+		if limit.Requests == 0 {
+			return "unlimited"
+		}
+
+		return fmt.Sprintf("%d/%s", limit.Requests, limit.Period)
+	}
+
+	result := map[string]string{"default": format(limits.Default)}
+	for group, limit := range limits.Groups {
+		result[group] = format(limit)
+	}
+
+	return result
+}
+
+// Supported commands:
+//
+//	queue verify: prints the queue integrity report, exits with 1 if the queue is not healthy
+//	queue repair: renumbers the queue and prints the issues that were fixed
+func runCommand(queueChecker services.QueueIntegrityChecker, args []string) int {
+	if len(args) != 2 || args[0] != "queue" || (args[1] != "verify" && args[1] != "repair") {
+		fmt.Fprintln(os.Stderr, "usage: main [queue verify|queue repair]")
+		return 2
+	}
+
+	var (
+		report *domain.QueueIntegrityReport
+		err    error
+	)
+
+	if args[1] == "verify" {
+		report, err = queueChecker.Verify(context.Background())
+	} else {
+		report, err = queueChecker.Repair(context.Background())
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return 1
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	encoder.Encode(report)
+
+	if args[1] == "verify" && !report.Healthy {
+		return 1
+	}
 
-	server := gin.GetServer(orderService)
-	server.Run(":9001")
+	return 0
 }